@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+const accountBasePath = "v2/account"
+
+// Account represents a DigitalOcean Account
+type Account struct {
+	DropletLimit    int       `json:"droplet_limit,omitempty"`
+	FloatingIPLimit int       `json:"floating_ip_limit,omitempty"`
+	ReservedIPLimit int       `json:"reserved_ip_limit,omitempty"`
+	VolumeLimit     int       `json:"volume_limit,omitempty"`
+	Email           string    `json:"email,omitempty"`
+	UUID            string    `json:"uuid,omitempty"`
+	EmailVerified   bool      `json:"email_verified,omitempty"`
+	Status          string    `json:"status,omitempty"`
+	StatusMessage   string    `json:"status_message,omitempty"`
+	Team            *TeamInfo `json:"team,omitempty"`
+}
+
+// TeamInfo contains information about the currently team context.
+type TeamInfo struct {
+	Name string `json:"name,omitempty"`
+	UUID string `json:"uuid,omitempty"`
+}
+
+/* SERVICE */
+
+// AccountService is an interface for interfacing with the Account
+// endpoints of the DigitalOcean API
+type AccountService interface {
+	Get(context.Context) (*Account, *Response, error)
+}
+
+// AccountServiceOp handles communication with the Account related methods of
+// the DigitalOcean API.
+type AccountServiceOp struct {
+	client *Client
+}
+
+var _ AccountService = &AccountServiceOp{}
+
+// Get DigitalOcean account info
+func (s *AccountServiceOp) Get(ctx context.Context) (*Account, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, accountBasePath, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	account, resp, err := DoEnvelope[Account](ctx, s.client, req, "account")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return account, resp, err
+}
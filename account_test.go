@@ -0,0 +1,28 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestAccount_Get(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"account":{"droplet_limit":25,"email":"sammy@example.com","email_verified":true,"status":"active","team":{"name":"My Team","uuid":"5df3e3"}}}`)
+	})
+
+	account, _, err := c.Account.Get(context.Background())
+	if err != nil {
+		t.Fatalf("Account.Get returned error: %v", err)
+	}
+	if account.DropletLimit != 25 || account.Email != "sammy@example.com" || !account.EmailVerified || account.Status != "active" {
+		t.Errorf("got account %+v", account)
+	}
+	if account.Team == nil || account.Team.Name != "My Team" {
+		t.Errorf("got team %+v", account.Team)
+	}
+}
@@ -0,0 +1,163 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const actionsBasePath = "v2/actions"
+
+// Action statuses
+const (
+	// ActionInProgress is an in progress action status
+	ActionInProgress = "in-progress"
+
+	// ActionCompleted is a completed action status
+	ActionCompleted = "completed"
+
+	// ActionErrored is an errored action status
+	ActionErrored = "errored"
+)
+
+// Action represents a DigitalOcean Action
+type Action struct {
+	ID           int        `json:"id"`
+	Status       string     `json:"status"`
+	Type         string     `json:"type"`
+	StartedAt    *Timestamp `json:"started_at"`
+	CompletedAt  *Timestamp `json:"completed_at"`
+	ResourceID   int        `json:"resource_id"`
+	ResourceType string     `json:"resource_type"`
+	Region       *Region    `json:"region,omitempty"`
+	RegionSlug   string     `json:"region_slug,omitempty"`
+}
+
+// ActionError occurs when an action which was waited for errored.
+type ActionError struct {
+	Action *Action
+}
+
+func (e *ActionError) Error() string {
+	return fmt.Sprintf("action %d (%s) errored", e.Action.ID, e.Action.Type)
+}
+
+// ActionUpdate is delivered by Watch when the status of an action changed, or
+// when watching it failed. Err is an *ActionError if the action errored.
+type ActionUpdate struct {
+	Action *Action
+	Err    error
+}
+
+/* SERVICE */
+
+// ActionsService handles communication with action related methods of the
+// DigitalOcean API.
+type ActionsService interface {
+	List(context.Context, *ListOptions) ([]Action, *Response, error)
+	ListAll(context.Context, *ListAllOptions) ([]Action, *Response, error)
+	Get(context.Context, int) (*Action, *Response, error)
+	Watch(context.Context, int, WaitOptions) <-chan ActionUpdate
+}
+
+// ActionsServiceOp handles communication with the action related methods of
+// the DigitalOcean API.
+type ActionsServiceOp struct {
+	client *Client
+}
+
+var _ ActionsService = &ActionsServiceOp{}
+
+// List all actions
+func (s *ActionsServiceOp) List(ctx context.Context, opt *ListOptions) ([]Action, *Response, error) {
+	path, err := addOptions(actionsBasePath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	actions, resp, err := DoEnvelope[[]Action](ctx, s.client, req, "actions")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *actions, resp, err
+}
+
+// ListAll actions across all pages
+func (s *ActionsServiceOp) ListAll(ctx context.Context, opt *ListAllOptions) ([]Action, *Response, error) {
+	return ListAll[Action](ctx, s.List, opt)
+}
+
+// Get an action by ID.
+func (s *ActionsServiceOp) Get(ctx context.Context, id int) (*Action, *Response, error) {
+	if id < 1 {
+		return nil, nil, &ValidationError{Field: "id", Reason: "must be positive"}
+	}
+
+	path := fmt.Sprintf("%s/%d", actionsBasePath, id)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	action, resp, err := DoEnvelope[Action](ctx, s.client, req, "action")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return action, resp, err
+}
+
+// Watch polls an action as configured by opts and delivers an update each time
+// its status changes, starting with its current status. The channel is closed
+// once the action completed or errored, after an error polling it, e.g. a
+// *WaitTimeoutError once opts.Timeout is exceeded, or when ctx is done.
+func (s *ActionsServiceOp) Watch(ctx context.Context, id int, opts WaitOptions) <-chan ActionUpdate {
+	updates := make(chan ActionUpdate)
+
+	send := func(update ActionUpdate) error {
+		select {
+		case updates <- update:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	go func() {
+		defer close(updates)
+
+		var status string
+		_, err := Poll(ctx, opts, func(ctx context.Context) (*Action, error) {
+			action, _, err := s.Get(ctx, id)
+			return action, err
+		}, func(action *Action) (bool, string, error) {
+			if action.Status == status {
+				return false, status, nil
+			}
+			status = action.Status
+
+			update := ActionUpdate{Action: action}
+			if action.Status == ActionErrored {
+				update.Err = &ActionError{Action: action}
+			}
+			if err := send(update); err != nil {
+				return false, status, err
+			}
+			return action.Status != ActionInProgress, status, nil
+		})
+		if err != nil && ctx.Err() == nil {
+			// the send only fails if ctx is done meanwhile, and then the
+			// receiver is not expected to read the update
+			_ = send(ActionUpdate{Err: err})
+		}
+	}()
+
+	return updates
+}
@@ -0,0 +1,148 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestActions_List(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("page") != "2" {
+			t.Errorf("expected page 2, got %q", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"actions":[{"id":1,"status":"completed","type":"create","resource_id":3,"resource_type":"droplet"}]}`)
+	})
+
+	actions, _, err := c.Actions.List(context.Background(), &ListOptions{Page: 2})
+	if err != nil {
+		t.Fatalf("Actions.List returned error: %v", err)
+	}
+	if len(actions) != 1 || actions[0].ResourceID != 3 || actions[0].Status != ActionCompleted {
+		t.Errorf("got actions %+v", actions)
+	}
+}
+
+func TestActions_Get(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/actions/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"action":{"id":5,"status":"in-progress","started_at":"2024-01-02T03:04:05Z","region_slug":"nyc3"}}`)
+	})
+
+	action, _, err := c.Actions.Get(context.Background(), 5)
+	if err != nil {
+		t.Fatalf("Actions.Get returned error: %v", err)
+	}
+	if action.ID != 5 || action.Status != ActionInProgress || action.RegionSlug != "nyc3" {
+		t.Errorf("got action %+v", action)
+	}
+	if action.StartedAt == nil || action.StartedAt.Year() != 2024 {
+		t.Errorf("got started at %v", action.StartedAt)
+	}
+
+	var verr *ValidationError
+	if _, _, err := c.Actions.Get(context.Background(), 0); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for id 0, got %v", err)
+	}
+}
+
+func TestActions_Watch(t *testing.T) {
+	c, mux := setup(t)
+
+	var polls int32
+	mux.HandleFunc("/v2/actions/5", func(w http.ResponseWriter, r *http.Request) {
+		status := ActionInProgress
+		if atomic.AddInt32(&polls, 1) >= 3 {
+			status = ActionCompleted
+		}
+		fmt.Fprintf(w, `{"action":{"id":5,"status":%q}}`, status)
+	})
+
+	var statuses []string
+	for update := range c.Actions.Watch(context.Background(), 5, WaitOptions{PollInterval: time.Millisecond}) {
+		if update.Err != nil {
+			t.Fatalf("unexpected error: %v", update.Err)
+		}
+		statuses = append(statuses, update.Action.Status)
+	}
+
+	if fmt.Sprint(statuses) != fmt.Sprint([]string{ActionInProgress, ActionCompleted}) {
+		t.Errorf("expected one update per status, got %v", statuses)
+	}
+	if got := atomic.LoadInt32(&polls); got != 3 {
+		t.Errorf("expected 3 polls, got %d", got)
+	}
+}
+
+func TestActions_Watch_errored(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/actions/5", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"action":{"id":5,"status":"errored"}}`)
+	})
+
+	var updates []ActionUpdate
+	for update := range c.Actions.Watch(context.Background(), 5, WaitOptions{PollInterval: time.Millisecond}) {
+		updates = append(updates, update)
+	}
+
+	if len(updates) != 1 {
+		t.Fatalf("expected 1 update, got %d", len(updates))
+	}
+	var aerr *ActionError
+	if !errors.As(updates[0].Err, &aerr) || aerr.Action.ID != 5 {
+		t.Errorf("expected *ActionError, got %v", updates[0].Err)
+	}
+}
+
+func TestActions_Watch_timeout(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/actions/5", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"action":{"id":5,"status":"in-progress"}}`)
+	})
+
+	var updates []ActionUpdate
+	for update := range c.Actions.Watch(context.Background(), 5, WaitOptions{PollInterval: time.Millisecond, Timeout: 20 * time.Millisecond}) {
+		updates = append(updates, update)
+	}
+
+	if len(updates) != 2 {
+		t.Fatalf("expected a status and an error update, got %d", len(updates))
+	}
+	var terr *WaitTimeoutError
+	if !errors.As(updates[1].Err, &terr) || terr.LastStatus != ActionInProgress {
+		t.Errorf("expected *WaitTimeoutError, got %v", updates[1].Err)
+	}
+}
+
+func TestActions_Watch_canceled(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/actions/5", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"action":{"id":5,"status":"in-progress"}}`)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	updates := c.Actions.Watch(ctx, 5, WaitOptions{PollInterval: time.Millisecond})
+	<-updates
+	cancel()
+
+	select {
+	case _, ok := <-updates:
+		for ok {
+			_, ok = <-updates
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the channel to be closed after cancel")
+	}
+}
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// ActivityListOptions restricts account activity to the actions started in
+// [Since, Until). A zero Since or Until leaves that end of the range open.
+type ActivityListOptions struct {
+	ListOptions
+	Since time.Time
+	Until time.Time
+}
+
+// ActivityListAllOptions specifies the options of ActivityService.ListAll,
+// restricting the activity to a range as ActivityListOptions does.
+type ActivityListAllOptions struct {
+	ListAllOptions
+	Since time.Time
+	Until time.Time
+}
+
+// contains reports whether the action started within the range.
+func (o *ActivityListOptions) contains(action Action) bool {
+	if o.Since.IsZero() && o.Until.IsZero() {
+		return true
+	}
+	if action.StartedAt == nil {
+		return false
+	}
+	started := action.StartedAt.Time
+	return (o.Since.IsZero() || !started.Before(o.Since)) && (o.Until.IsZero() || started.Before(o.Until))
+}
+
+/* SERVICE */
+
+// ActivityService is an interface for reading the activity of the account
+// from the DigitalOcean API. The API has no separate security log, the
+// activity is the history of actions taken on the resources of the account.
+type ActivityService interface {
+	List(context.Context, *ActivityListOptions) ([]Action, *Response, error)
+	ListAll(context.Context, *ActivityListAllOptions) ([]Action, *Response, error)
+}
+
+// ActivityServiceOp handles communication with the account activity related
+// methods of the DigitalOcean API.
+type ActivityServiceOp struct {
+	client *Client
+}
+
+var _ ActivityService = &ActivityServiceOp{}
+
+// List lists a page of the account activity. The API can not filter actions
+// by time, so the actions of each page are filtered and a page may hold fewer
+// than PerPage actions.
+func (s *ActivityServiceOp) List(ctx context.Context, opt *ActivityListOptions) ([]Action, *Response, error) {
+	if opt == nil {
+		opt = &ActivityListOptions{}
+	}
+	if !opt.Since.IsZero() && !opt.Until.IsZero() && !opt.Since.Before(opt.Until) {
+		return nil, nil, &ValidationError{Field: "until", Value: opt.Until.Format(time.RFC3339), Reason: "must be after since"}
+	}
+
+	path, err := addOptions(actionsBasePath, &opt.ListOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	actions, resp, err := DoEnvelope[[]Action](ctx, s.client, req, "actions")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	activity := make([]Action, 0, len(*actions))
+	for _, action := range *actions {
+		if opt.contains(action) {
+			activity = append(activity, action)
+		}
+	}
+
+	return activity, resp, err
+}
+
+// ListAll lists the account activity across all pages. MaxItems counts the
+// actions within the range, MaxPages the pages fetched.
+func (s *ActivityServiceOp) ListAll(ctx context.Context, opt *ActivityListAllOptions) ([]Action, *Response, error) {
+	if opt == nil {
+		opt = &ActivityListAllOptions{}
+	}
+	return ListAll[Action](ctx, func(ctx context.Context, o *ListOptions) ([]Action, *Response, error) {
+		return s.List(ctx, &ActivityListOptions{ListOptions: *o, Since: opt.Since, Until: opt.Until})
+	}, &opt.ListAllOptions)
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestActivity_List(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("per_page") != "3" {
+			t.Errorf("got query %q", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"actions":[
+			{"id":1,"type":"create","started_at":"2024-01-01T23:59:59Z"},
+			{"id":2,"type":"reboot","started_at":"2024-01-02T00:00:00Z"},
+			{"id":3,"type":"shutdown","started_at":"2024-01-03T00:00:00Z"},
+			{"id":4,"type":"pending"}
+		]}`)
+	})
+
+	since := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	opt := &ActivityListOptions{ListOptions: ListOptions{PerPage: 3}, Since: since, Until: since.AddDate(0, 0, 1)}
+	actions, _, err := c.Activity.List(context.Background(), opt)
+	if err != nil {
+		t.Fatalf("Activity.List returned error: %v", err)
+	}
+	if len(actions) != 1 || actions[0].ID != 2 {
+		t.Errorf("expected only the action started within the range, got %+v", actions)
+	}
+
+	actions, _, err = c.Activity.List(context.Background(), &ActivityListOptions{ListOptions: ListOptions{PerPage: 3}})
+	if err != nil {
+		t.Fatalf("Activity.List returned error: %v", err)
+	}
+	if len(actions) != 4 {
+		t.Errorf("expected all actions without a range, got %d", len(actions))
+	}
+}
+
+func TestActivity_ListAll(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/actions", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"actions":[{"id":3,"started_at":"2024-01-01T00:00:00Z"}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"actions":[{"id":1,"started_at":"2024-01-03T00:00:00Z"},{"id":2,"started_at":"2024-01-02T00:00:00Z"}],"links":{"pages":{"next":"https://api.example.com/v2/actions?page=2"}}}`)
+	})
+
+	since := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	actions, _, err := c.Activity.ListAll(context.Background(), &ActivityListAllOptions{Since: since})
+	if err != nil {
+		t.Fatalf("Activity.ListAll returned error: %v", err)
+	}
+	if len(actions) != 2 || actions[0].ID != 1 || actions[1].ID != 2 {
+		t.Errorf("expected the actions since %v, got %+v", since, actions)
+	}
+
+	actions, _, err = c.Activity.ListAll(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Activity.ListAll returned error: %v", err)
+	}
+	if len(actions) != 3 {
+		t.Errorf("expected all actions without options, got %d", len(actions))
+	}
+}
+
+func TestActivity_List_invalidRange(t *testing.T) {
+	c, _ := setup(t)
+
+	since := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	for _, until := range []time.Time{since, since.Add(-time.Hour)} {
+		var verr *ValidationError
+		if _, _, err := c.Activity.List(context.Background(), &ActivityListOptions{Since: since, Until: until}); !errors.As(err, &verr) {
+			t.Errorf("until %v: expected a *ValidationError, got %v", until, err)
+		}
+	}
+}
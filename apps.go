@@ -0,0 +1,316 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const appsBasePath = "v2/apps"
+
+// appURNType is the type of app URNs.
+const appURNType ResourceType = "app"
+
+// DeploymentPhase is the phase of an app deployment.
+type DeploymentPhase string
+
+// Phases of app deployments.
+const (
+	DeploymentPhaseUnknown       DeploymentPhase = "UNKNOWN"
+	DeploymentPhasePendingBuild  DeploymentPhase = "PENDING_BUILD"
+	DeploymentPhaseBuilding      DeploymentPhase = "BUILDING"
+	DeploymentPhasePendingDeploy DeploymentPhase = "PENDING_DEPLOY"
+	DeploymentPhaseDeploying     DeploymentPhase = "DEPLOYING"
+	DeploymentPhaseActive        DeploymentPhase = "ACTIVE"
+	DeploymentPhaseSuperseded    DeploymentPhase = "SUPERSEDED"
+	DeploymentPhaseError         DeploymentPhase = "ERROR"
+	DeploymentPhaseCanceled      DeploymentPhase = "CANCELED"
+)
+
+// AppLogType is the type of logs of an app component.
+type AppLogType string
+
+// Types of app logs.
+const (
+	// AppLogTypeBuild represents build logs.
+	AppLogTypeBuild AppLogType = "BUILD"
+	// AppLogTypeDeploy represents deploy logs.
+	AppLogTypeDeploy AppLogType = "DEPLOY"
+	// AppLogTypeRun represents run logs.
+	AppLogTypeRun AppLogType = "RUN"
+	// AppLogTypeRunRestarted represents logs of crashed/restarted instances during runtime.
+	AppLogTypeRunRestarted AppLogType = "RUN_RESTARTED"
+)
+
+// App represents an app on App Platform.
+type App struct {
+	ID                   string      `json:"id,omitempty"`
+	OwnerUUID            string      `json:"owner_uuid,omitempty"`
+	ProjectID            string      `json:"project_id,omitempty"`
+	DefaultIngress       string      `json:"default_ingress,omitempty"`
+	LiveURL              string      `json:"live_url,omitempty"`
+	ActiveDeployment     *Deployment `json:"active_deployment,omitempty"`
+	InProgressDeployment *Deployment `json:"in_progress_deployment,omitempty"`
+	PendingDeployment    *Deployment `json:"pending_deployment,omitempty"`
+	CreatedAt            time.Time   `json:"created_at,omitempty"`
+	UpdatedAt            time.Time   `json:"updated_at,omitempty"`
+}
+
+// URN returns the app in a valid DO API URN form.
+func (a App) URN() string {
+	return Resource{ID: a.ID, Type: appURNType}.URN()
+}
+
+// Deployment represents a deployment of an app.
+type Deployment struct {
+	ID                 string              `json:"id,omitempty"`
+	Cause              string              `json:"cause,omitempty"`
+	Phase              DeploymentPhase     `json:"phase,omitempty"`
+	PhaseLastUpdatedAt time.Time           `json:"phase_last_updated_at,omitempty"`
+	Progress           *DeploymentProgress `json:"progress,omitempty"`
+	CreatedAt          time.Time           `json:"created_at,omitempty"`
+	UpdatedAt          time.Time           `json:"updated_at,omitempty"`
+}
+
+// DeploymentProgress counts the steps of a deployment by their status.
+type DeploymentProgress struct {
+	PendingSteps int32 `json:"pending_steps,omitempty"`
+	RunningSteps int32 `json:"running_steps,omitempty"`
+	SuccessSteps int32 `json:"success_steps,omitempty"`
+	ErrorSteps   int32 `json:"error_steps,omitempty"`
+	TotalSteps   int32 `json:"total_steps,omitempty"`
+}
+
+// DeploymentCreateRequest represents a request to create a deployment.
+type DeploymentCreateRequest struct {
+	ForceBuild bool `json:"force_build"`
+}
+
+// AppLogs represent app logs. LiveURL streams the logs as they are written
+// when they were requested with follow, HistoricURLs point to the logs
+// written so far.
+type AppLogs struct {
+	LiveURL      string   `json:"live_url"`
+	HistoricURLs []string `json:"historic_urls"`
+}
+
+// AppLogsRequest selects the logs returned by GetLogs. Component is the name
+// of an app component, the logs of all components are returned when it is
+// empty. TailLines limits the number of lines returned.
+type AppLogsRequest struct {
+	Component string     `url:"-"`
+	Type      AppLogType `url:"type"`
+	Follow    bool       `url:"follow"`
+	TailLines int        `url:"tail_lines,omitempty"`
+}
+
+/* SERVICE */
+
+// AppsService is an interface for interfacing with the App Platform endpoints
+// of the DigitalOcean API.
+// See: https://docs.digitalocean.com/reference/api/api-reference/#tag/Apps
+type AppsService interface {
+	List(ctx context.Context, opts *ListOptions) ([]*App, *Response, error)
+	ListAll(ctx context.Context, opts *ListAllOptions) ([]*App, *Response, error)
+	Get(ctx context.Context, appID string) (*App, *Response, error)
+
+	ListDeployments(ctx context.Context, appID string, opts *ListOptions) ([]*Deployment, *Response, error)
+	GetDeployment(ctx context.Context, appID, deploymentID string) (*Deployment, *Response, error)
+	CreateDeployment(ctx context.Context, appID string, create ...*DeploymentCreateRequest) (*Deployment, *Response, error)
+	CancelDeployment(ctx context.Context, appID, deploymentID string) (*Deployment, *Response, error)
+
+	GetLogs(ctx context.Context, appID, deploymentID string, logs *AppLogsRequest) (*AppLogs, *Response, error)
+}
+
+// AppsServiceOp handles communication with Apps methods of the DigitalOcean API.
+type AppsServiceOp struct {
+	client *Client
+}
+
+var _ AppsService = &AppsServiceOp{}
+
+// List apps.
+func (s *AppsServiceOp) List(ctx context.Context, opt *ListOptions) ([]*App, *Response, error) {
+	path, err := addOptions(appsBasePath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	apps, resp, err := DoEnvelope[[]*App](ctx, s.client, req, "apps")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *apps, resp, err
+}
+
+// ListAll apps across all pages
+func (s *AppsServiceOp) ListAll(ctx context.Context, opt *ListAllOptions) ([]*App, *Response, error) {
+	return ListAll[*App](ctx, s.List, opt)
+}
+
+// Get an app.
+func (s *AppsServiceOp) Get(ctx context.Context, appID string) (*App, *Response, error) {
+	path, err := appPath(appID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	app, resp, err := DoEnvelope[App](ctx, s.client, req, "app")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return app, resp, err
+}
+
+// ListDeployments lists an app deployments.
+func (s *AppsServiceOp) ListDeployments(ctx context.Context, appID string, opt *ListOptions) ([]*Deployment, *Response, error) {
+	path, err := appPath(appID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	path, err = addOptions(path+"/deployments", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deployments, resp, err := DoEnvelope[[]*Deployment](ctx, s.client, req, "deployments")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *deployments, resp, err
+}
+
+// GetDeployment gets an app deployment.
+func (s *AppsServiceOp) GetDeployment(ctx context.Context, appID, deploymentID string) (*Deployment, *Response, error) {
+	path, err := appDeploymentPath(appID, deploymentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doDeployment(ctx, req)
+}
+
+// CreateDeployment creates an app deployment. Pass a DeploymentCreateRequest
+// with ForceBuild set to rebuild components whose source did not change.
+func (s *AppsServiceOp) CreateDeployment(ctx context.Context, appID string, create ...*DeploymentCreateRequest) (*Deployment, *Response, error) {
+	path, err := appPath(appID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(create) > 1 {
+		return nil, nil, &ValidationError{Field: "create", Reason: "must not be given more than once"}
+	}
+
+	var body interface{}
+	if len(create) == 1 && create[0] != nil {
+		body = create[0]
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path+"/deployments", body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doDeployment(ctx, req)
+}
+
+// CancelDeployment cancels an app deployment which is not finished yet.
+func (s *AppsServiceOp) CancelDeployment(ctx context.Context, appID, deploymentID string) (*Deployment, *Response, error) {
+	path, err := appDeploymentPath(appID, deploymentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path+"/cancel", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doDeployment(ctx, req)
+}
+
+// GetLogs retrieves the URLs of the build, deploy or run logs of a
+// deployment.
+func (s *AppsServiceOp) GetLogs(ctx context.Context, appID, deploymentID string, logs *AppLogsRequest) (*AppLogs, *Response, error) {
+	path, err := appDeploymentPath(appID, deploymentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if logs == nil {
+		return nil, nil, &ValidationError{Field: "logs", Reason: "cannot be nil"}
+	}
+	switch logs.Type {
+	case AppLogTypeBuild, AppLogTypeDeploy, AppLogTypeRun, AppLogTypeRunRestarted:
+	default:
+		return nil, nil, &ValidationError{Field: "type", Value: string(logs.Type), Reason: "is not a known log type"}
+	}
+
+	if logs.Component != "" {
+		path = fmt.Sprintf("%s/components/%s", path, logs.Component)
+	}
+	path, err = addOptions(path+"/logs", logs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return Do[AppLogs](ctx, s.client, req)
+}
+
+func (s *AppsServiceOp) doDeployment(ctx context.Context, req *http.Request) (*Deployment, *Response, error) {
+	deployment, resp, err := DoEnvelope[Deployment](ctx, s.client, req, "deployment")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return deployment, resp, err
+}
+
+// appPath returns the path of an app.
+func appPath(appID string) (string, error) {
+	if appID == "" {
+		return "", &ValidationError{Field: "appID", Reason: "must not be empty"}
+	}
+	return fmt.Sprintf("%s/%s", appsBasePath, appID), nil
+}
+
+// appDeploymentPath returns the path of a deployment of an app.
+func appDeploymentPath(appID, deploymentID string) (string, error) {
+	path, err := appPath(appID)
+	if err != nil {
+		return "", err
+	}
+	if deploymentID == "" {
+		return "", &ValidationError{Field: "deploymentID", Reason: "must not be empty"}
+	}
+	return fmt.Sprintf("%s/deployments/%s", path, deploymentID), nil
+}
@@ -0,0 +1,174 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestApps_ListGet(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+
+	mux.HandleFunc("/v2/apps", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"apps":[{"id":"c2a93513","live_url":"https://sample.ondigitalocean.app"}]}`)
+	})
+	mux.HandleFunc("/v2/apps/c2a93513", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"app":{"id":"c2a93513","active_deployment":{"id":"b6bdf840","phase":"ACTIVE"}}}`)
+	})
+
+	apps, _, err := c.Apps.List(ctx, nil)
+	if err != nil {
+		t.Fatalf("Apps.List returned error: %v", err)
+	}
+	if len(apps) != 1 || apps[0].LiveURL != "https://sample.ondigitalocean.app" {
+		t.Errorf("got apps %+v", apps)
+	}
+
+	app, _, err := c.Apps.Get(ctx, "c2a93513")
+	if err != nil {
+		t.Fatalf("Apps.Get returned error: %v", err)
+	}
+	if app.ActiveDeployment == nil || app.ActiveDeployment.Phase != DeploymentPhaseActive {
+		t.Errorf("got app %+v", app)
+	}
+	if app.URN() != "do:app:c2a93513" {
+		t.Errorf("got URN %s", app.URN())
+	}
+}
+
+func TestApps_deployments(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+
+	var bodies []string
+	mux.HandleFunc("/v2/apps/c2a93513/deployments", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `{"deployments":[{"id":"b6bdf840","phase":"ACTIVE","progress":{"success_steps":6,"total_steps":6}}]}`)
+			return
+		}
+		testMethod(t, r, http.MethodPost)
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		fmt.Fprint(w, `{"deployment":{"id":"b6bdf840","phase":"PENDING_BUILD"}}`)
+	})
+	mux.HandleFunc("/v2/apps/c2a93513/deployments/b6bdf840", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"deployment":{"id":"b6bdf840","phase":"BUILDING"}}`)
+	})
+	mux.HandleFunc("/v2/apps/c2a93513/deployments/b6bdf840/cancel", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"deployment":{"id":"b6bdf840","phase":"CANCELED"}}`)
+	})
+
+	deployments, _, err := c.Apps.ListDeployments(ctx, "c2a93513", nil)
+	if err != nil {
+		t.Fatalf("Apps.ListDeployments returned error: %v", err)
+	}
+	if len(deployments) != 1 || deployments[0].Progress == nil || deployments[0].Progress.TotalSteps != 6 {
+		t.Errorf("got deployments %+v", deployments)
+	}
+
+	if _, _, err := c.Apps.CreateDeployment(ctx, "c2a93513"); err != nil {
+		t.Fatalf("Apps.CreateDeployment returned error: %v", err)
+	}
+	deployment, _, err := c.Apps.CreateDeployment(ctx, "c2a93513", &DeploymentCreateRequest{ForceBuild: true})
+	if err != nil {
+		t.Fatalf("Apps.CreateDeployment returned error: %v", err)
+	}
+	if deployment.Phase != DeploymentPhasePendingBuild {
+		t.Errorf("got deployment %+v", deployment)
+	}
+	if len(bodies) != 2 || bodies[0] != "" {
+		t.Fatalf("expected an empty body without a create request, got %q", bodies)
+	}
+	var req map[string]interface{}
+	if err := json.Unmarshal([]byte(bodies[1]), &req); err != nil || req["force_build"] != true {
+		t.Errorf("got request %s", bodies[1])
+	}
+
+	deployment, _, err = c.Apps.GetDeployment(ctx, "c2a93513", "b6bdf840")
+	if err != nil {
+		t.Fatalf("Apps.GetDeployment returned error: %v", err)
+	}
+	if deployment.Phase != DeploymentPhaseBuilding {
+		t.Errorf("got deployment %+v", deployment)
+	}
+
+	deployment, _, err = c.Apps.CancelDeployment(ctx, "c2a93513", "b6bdf840")
+	if err != nil {
+		t.Fatalf("Apps.CancelDeployment returned error: %v", err)
+	}
+	if deployment.Phase != DeploymentPhaseCanceled {
+		t.Errorf("got deployment %+v", deployment)
+	}
+}
+
+func TestApps_GetLogs(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+
+	var paths []string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		paths = append(paths, r.URL.Path+"?"+r.URL.RawQuery)
+		fmt.Fprint(w, `{"live_url":"https://logs.example.com/live","historic_urls":["https://logs.example.com/1"]}`)
+	}
+	mux.HandleFunc("/v2/apps/c2a93513/deployments/b6bdf840/logs", handler)
+	mux.HandleFunc("/v2/apps/c2a93513/deployments/b6bdf840/components/web/logs", handler)
+
+	logs, _, err := c.Apps.GetLogs(ctx, "c2a93513", "b6bdf840", &AppLogsRequest{Type: AppLogTypeBuild})
+	if err != nil {
+		t.Fatalf("Apps.GetLogs returned error: %v", err)
+	}
+	if logs.LiveURL != "https://logs.example.com/live" || len(logs.HistoricURLs) != 1 {
+		t.Errorf("got logs %+v", logs)
+	}
+	if _, _, err := c.Apps.GetLogs(ctx, "c2a93513", "b6bdf840", &AppLogsRequest{Component: "web", Type: AppLogTypeRun, Follow: true, TailLines: 100}); err != nil {
+		t.Fatalf("Apps.GetLogs returned error: %v", err)
+	}
+
+	want := fmt.Sprint([]string{
+		"/v2/apps/c2a93513/deployments/b6bdf840/logs?follow=false&type=BUILD",
+		"/v2/apps/c2a93513/deployments/b6bdf840/components/web/logs?follow=true&tail_lines=100&type=RUN",
+	})
+	if fmt.Sprint(paths) != want {
+		t.Errorf("expected %s, got %v", want, paths)
+	}
+}
+
+func TestApps_validation(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+	s := c.Apps
+
+	calls := map[string]func() error{
+		"get empty id":             func() error { _, _, err := s.Get(ctx, ""); return err },
+		"deployments empty app id": func() error { _, _, err := s.ListDeployments(ctx, "", nil); return err },
+		"get empty deployment id":  func() error { _, _, err := s.GetDeployment(ctx, "c2a93513", ""); return err },
+		"cancel empty app id":      func() error { _, _, err := s.CancelDeployment(ctx, "", "b6bdf840"); return err },
+		"create empty app id":      func() error { _, _, err := s.CreateDeployment(ctx, ""); return err },
+		"create two requests":      func() error { _, _, err := s.CreateDeployment(ctx, "c2a93513", nil, nil); return err },
+		"logs nil":                 func() error { _, _, err := s.GetLogs(ctx, "c2a93513", "b6bdf840", nil); return err },
+		"logs unknown type": func() error {
+			_, _, err := s.GetLogs(ctx, "c2a93513", "b6bdf840", &AppLogsRequest{Type: "AUDIT"})
+			return err
+		},
+		"logs empty deployment id": func() error {
+			_, _, err := s.GetLogs(ctx, "c2a93513", "", &AppLogsRequest{Type: AppLogTypeRun})
+			return err
+		},
+	}
+	for name, call := range calls {
+		var verr *ValidationError
+		if err := call(); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
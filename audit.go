@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+)
+
+// AuditRecord describes a state changing call made through the client.
+type AuditRecord struct {
+	Time     time.Time
+	Method   string
+	Path     string
+	Duration time.Duration
+
+	// BodyDigest is the hex encoded SHA-256 digest of the request body after
+	// secrets have been redacted, empty when the request had no body.
+	BodyDigest string
+
+	// RequestID is the ID the API assigned to the request, if a response was
+	// received.
+	RequestID string
+
+	// StatusCode is the status of the final response, zero when none was
+	// received, and Err the error returned to the caller.
+	StatusCode int
+	Err        error
+}
+
+// AuditSink receives a record of every state changing call, i.e. any request
+// not using GET, HEAD or OPTIONS.
+type AuditSink interface {
+	Audit(ctx context.Context, record AuditRecord)
+}
+
+// AuditSinkFunc is an adapter to allow the use of ordinary functions as
+// AuditSink.
+type AuditSinkFunc func(ctx context.Context, record AuditRecord)
+
+// Audit calls f(ctx, record).
+func (f AuditSinkFunc) Audit(ctx context.Context, record AuditRecord) {
+	f(ctx, record)
+}
+
+// SetAuditSink is a client option for recording state changing calls to sink,
+// so infrastructure changes made through the client leave an audit trail.
+func SetAuditSink(sink AuditSink) ClientOpt {
+	return func(c *Client) error {
+		if sink == nil {
+			return errors.New("audit sink is nil")
+		}
+		c.auditSink = sink
+		return nil
+	}
+}
+
+func isMutation(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return true
+}
+
+// audit records the outcome of a call with req, started at start, to the
+// audit sink of the client.
+func (c *Client) audit(ctx context.Context, req *http.Request, start time.Time, resp *Response, err error) {
+	if c.auditSink == nil || !isMutation(req.Method) {
+		return
+	}
+
+	record := AuditRecord{
+		Time:       start,
+		Method:     req.Method,
+		Path:       req.URL.Path,
+		Duration:   time.Since(start),
+		BodyDigest: c.bodyDigest(req),
+		Err:        err,
+	}
+	if resp != nil && resp.Response != nil {
+		record.RequestID = resp.Header.Get(headerRequestID)
+		record.StatusCode = resp.StatusCode
+	}
+	c.auditSink.Audit(ctx, record)
+}
+
+// bodyDigest returns the digest of the redacted body of req.
+func (c *Client) bodyDigest(req *http.Request) string {
+	if req.GetBody == nil {
+		return ""
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return ""
+	}
+	defer body.Close()
+
+	b, err := io.ReadAll(body)
+	if err != nil || len(b) == 0 {
+		return ""
+	}
+	sum := sha256.Sum256(c.redactor.Redact(b))
+	return hex.EncodeToString(sum[:])
+}
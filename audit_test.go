@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestSetAuditSink(t *testing.T) {
+	var records []AuditRecord
+	c, mux := setup(t, SetAuditSink(AuditSinkFunc(func(ctx context.Context, record AuditRecord) {
+		records = append(records, record)
+	})))
+
+	mux.HandleFunc("/v2/tags", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			w.Header().Set("X-Request-ID", "req-1")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"tag":{"name":"prod"}}`)
+		default:
+			fmt.Fprint(w, `{"tags":[]}`)
+		}
+	})
+	mux.HandleFunc("/v2/tags/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"id":"not_found","message":"not found"}`)
+	})
+
+	if _, _, err := c.Tags.List(context.Background(), nil); err != nil {
+		t.Fatalf("Tags.List returned error: %v", err)
+	}
+	if _, _, err := c.Tags.Create(context.Background(), &TagCreateRequest{Name: "prod"}); err != nil {
+		t.Fatalf("Tags.Create returned error: %v", err)
+	}
+	_, deleteErr := c.Tags.Delete(context.Background(), "missing")
+	if deleteErr == nil {
+		t.Fatal("expected an error")
+	}
+
+	if len(records) != 2 {
+		t.Fatalf("expected the 2 mutations recorded, got %+v", records)
+	}
+	created, deleted := records[0], records[1]
+	if created.Method != http.MethodPost || created.Path != "/v2/tags" || created.StatusCode != http.StatusCreated ||
+		created.RequestID != "req-1" || created.Err != nil {
+		t.Errorf("got create record %+v", created)
+	}
+	if created.BodyDigest == "" || created.Time.IsZero() || created.Duration <= 0 {
+		t.Errorf("expected the body digest, time and duration set, got %+v", created)
+	}
+	if deleted.Method != http.MethodDelete || deleted.Path != "/v2/tags/missing" || deleted.StatusCode != http.StatusNotFound ||
+		deleted.Err != deleteErr || deleted.BodyDigest != "" {
+		t.Errorf("got delete record %+v", deleted)
+	}
+}
+
+func TestClient_bodyDigest_redacted(t *testing.T) {
+	c, err := New(nil)
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+
+	digest := func(body interface{}) string {
+		req, err := c.NewRequest(context.Background(), http.MethodPost, "v2/things", body)
+		if err != nil {
+			t.Fatalf("NewRequest returned error: %v", err)
+		}
+		return c.bodyDigest(req)
+	}
+
+	a := digest(map[string]string{"name": "db", "password": "hunter2"})
+	b := digest(map[string]string{"name": "db", "password": "swordfish"})
+	other := digest(map[string]string{"name": "cache", "password": "hunter2"})
+	if a != b {
+		t.Error("expected bodies differing only in secrets to have the same digest")
+	}
+	if a == other {
+		t.Error("expected different bodies to have different digests")
+	}
+}
+
+func TestSetAuditSink_nil(t *testing.T) {
+	if _, err := New(nil, SetAuditSink(nil)); err == nil {
+		t.Error("expected an error for a nil sink")
+	}
+}
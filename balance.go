@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const balancePath = "v2/customers/my/balance"
+
+// Amount is an amount of money in US dollars as returned by the API, e.g.
+// "-12.34". It is kept as the decimal string to avoid the rounding errors of
+// floating point numbers.
+type Amount string
+
+// Cents returns the amount in cents.
+func (a Amount) Cents() (int64, error) {
+	s := strings.TrimSpace(string(a))
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+
+	whole, frac, _ := strings.Cut(s, ".")
+	if whole == "" && frac == "" || !isDigits(whole) || !isDigits(frac) {
+		return 0, fmt.Errorf("invalid amount %q", string(a))
+	}
+	if whole == "" {
+		whole = "0"
+	}
+	if len(frac) > 2 {
+		return 0, fmt.Errorf("amount %q has more than two decimal places", string(a))
+	}
+	frac += strings.Repeat("0", 2-len(frac))
+
+	cents, err := strconv.ParseInt(whole+frac, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", string(a))
+	}
+	if negative {
+		cents = -cents
+	}
+	return cents, nil
+}
+
+// isDigits reports whether s consists of decimal digits only.
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Balance represents a DigitalOcean Balance
+type Balance struct {
+	// MonthToDateBalance is the balance as of GeneratedAt, i.e.
+	// AccountBalance plus MonthToDateUsage.
+	MonthToDateBalance Amount `json:"month_to_date_balance"`
+	// AccountBalance is the balance as of the last invoice, negative for
+	// credit.
+	AccountBalance Amount `json:"account_balance"`
+	// MonthToDateUsage is the amount used in the current billing period as
+	// of GeneratedAt.
+	MonthToDateUsage Amount    `json:"month_to_date_usage"`
+	GeneratedAt      time.Time `json:"generated_at"`
+}
+
+/* SERVICE */
+
+// BalanceService is an interface for interfacing with the Balance
+// endpoints of the DigitalOcean API
+// See: https://docs.digitalocean.com/reference/api/api-reference/#operation/balance_get
+type BalanceService interface {
+	Get(context.Context) (*Balance, *Response, error)
+}
+
+// BalanceServiceOp handles communication with the Balance related methods of
+// the DigitalOcean API.
+type BalanceServiceOp struct {
+	client *Client
+}
+
+var _ BalanceService = &BalanceServiceOp{}
+
+// Get DigitalOcean balance info
+func (s *BalanceServiceOp) Get(ctx context.Context) (*Balance, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, balancePath, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return Do[Balance](ctx, s.client, req)
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestBalance_Get(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/customers/my/balance", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"month_to_date_balance":"23.44","account_balance":"12.23","month_to_date_usage":"11.21","generated_at":"2019-07-09T15:01:12Z"}`)
+	})
+
+	balance, _, err := c.Balance.Get(context.Background())
+	if err != nil {
+		t.Fatalf("Balance.Get returned error: %v", err)
+	}
+	if balance.MonthToDateBalance != "23.44" || balance.AccountBalance != "12.23" || balance.MonthToDateUsage != "11.21" {
+		t.Errorf("got balance %+v", balance)
+	}
+	if want := time.Date(2019, 7, 9, 15, 1, 12, 0, time.UTC); !balance.GeneratedAt.Equal(want) {
+		t.Errorf("expected generated at %v, got %v", want, balance.GeneratedAt)
+	}
+}
+
+func TestAmount_Cents(t *testing.T) {
+	valid := map[Amount]int64{
+		"12.34":  1234,
+		"-12.34": -1234,
+		"0":      0,
+		"5":      500,
+		"5.1":    510,
+		"-.5":    -50,
+		" 1.00 ": 100,
+	}
+	for amount, want := range valid {
+		got, err := amount.Cents()
+		if err != nil {
+			t.Errorf("%q.Cents() returned error: %v", amount, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("%q.Cents() = %d, want %d", amount, got, want)
+		}
+	}
+
+	for _, amount := range []Amount{"", "-", ".", "--5", "-+5", "+5", "1.-5", "1.234", "1,00", "abc"} {
+		if got, err := amount.Cents(); err == nil {
+			t.Errorf("%q.Cents() = %d, expected an error", amount, got)
+		}
+	}
+}
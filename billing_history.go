@@ -0,0 +1,75 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+const billingHistoryBasePath = "v2/customers/my/billing_history"
+
+// Types of billing history entries.
+const (
+	// BillingHistoryInvoice is a charge billed with an invoice.
+	BillingHistoryInvoice = "Invoice"
+	// BillingHistoryPayment is a payment made towards the balance.
+	BillingHistoryPayment = "Payment"
+	// BillingHistoryCredit is a credit applied to the balance.
+	BillingHistoryCredit = "Credit"
+)
+
+// BillingHistoryEntry represents an entry in a customer's Billing History.
+// Amount is negative for payments and credits. Entries of type
+// BillingHistoryInvoice reference the invoice by ID and UUID, the UUID can be
+// used with the Invoices service.
+type BillingHistoryEntry struct {
+	Description string    `json:"description"`
+	Amount      Amount    `json:"amount"`
+	InvoiceID   *string   `json:"invoice_id"`
+	InvoiceUUID *string   `json:"invoice_uuid"`
+	Date        time.Time `json:"date"`
+	Type        string    `json:"type"`
+}
+
+/* SERVICE */
+
+// BillingHistoryService is an interface for interfacing with the BillingHistory
+// endpoints of the DigitalOcean API
+// See: https://docs.digitalocean.com/reference/api/api-reference/#operation/billingHistory_list
+type BillingHistoryService interface {
+	List(context.Context, *ListOptions) ([]BillingHistoryEntry, *Response, error)
+	ListAll(context.Context, *ListAllOptions) ([]BillingHistoryEntry, *Response, error)
+}
+
+// BillingHistoryServiceOp handles communication with the BillingHistory related methods of
+// the DigitalOcean API.
+type BillingHistoryServiceOp struct {
+	client *Client
+}
+
+var _ BillingHistoryService = &BillingHistoryServiceOp{}
+
+// List the Billing History for a customer, most recent entries first.
+func (s *BillingHistoryServiceOp) List(ctx context.Context, opt *ListOptions) ([]BillingHistoryEntry, *Response, error) {
+	path, err := addOptions(billingHistoryBasePath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entries, resp, err := DoEnvelope[[]BillingHistoryEntry](ctx, s.client, req, "billing_history")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *entries, resp, err
+}
+
+// ListAll billing history entries across all pages
+func (s *BillingHistoryServiceOp) ListAll(ctx context.Context, opt *ListAllOptions) ([]BillingHistoryEntry, *Response, error) {
+	return ListAll[BillingHistoryEntry](ctx, s.List, opt)
+}
@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestBillingHistory_List(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/customers/my/billing_history", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("page") != "2" {
+			t.Errorf("got query %q", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"billing_history":[
+			{"description":"Invoice for May 2018","amount":"12.34","invoice_id":"123","invoice_uuid":"example-uuid","date":"2018-06-01T08:44:38Z","type":"Invoice"},
+			{"description":"Payment (MC 2018)","amount":"-12.34","date":"2018-06-02T08:44:38Z","type":"Payment"}
+		]}`)
+	})
+
+	entries, _, err := c.BillingHistory.List(context.Background(), &ListOptions{Page: 2})
+	if err != nil {
+		t.Fatalf("BillingHistory.List returned error: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+	invoice, payment := entries[0], entries[1]
+	if invoice.Type != BillingHistoryInvoice || invoice.InvoiceUUID == nil || *invoice.InvoiceUUID != "example-uuid" {
+		t.Errorf("got invoice entry %+v", invoice)
+	}
+	if payment.Type != BillingHistoryPayment || payment.InvoiceID != nil {
+		t.Errorf("got payment entry %+v", payment)
+	}
+	if cents, err := payment.Amount.Cents(); err != nil || cents != -1234 {
+		t.Errorf("expected a payment of -1234 cents, got %d, %v", cents, err)
+	}
+}
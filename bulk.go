@@ -0,0 +1,45 @@
+package client
+
+import (
+	"context"
+	"sync"
+)
+
+// bulkConcurrency is the number of requests bulk helpers send at once.
+const bulkConcurrency = 4
+
+// runBounded calls fn for 0 <= i < n with at most limit calls running at
+// once. Calls not yet started when ctx is done are skipped and the context
+// error is passed to skip instead.
+func runBounded(ctx context.Context, n, limit int, fn func(i int), skip func(i int, err error)) {
+	sem := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			skip(i, ctx.Err())
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer func() { <-sem; wg.Done() }()
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
+}
+
+// batches splits items into consecutive slices of at most size items.
+func batches[T any](items []T, size int) [][]T {
+	var b [][]T
+	for len(items) > size {
+		b = append(b, items[:size])
+		items = items[size:]
+	}
+	if len(items) > 0 {
+		b = append(b, items)
+	}
+	return b
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+)
+
+func TestBatches(t *testing.T) {
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{n: 0, want: "[]"},
+		{n: 3, want: "[[0 1 2]]"},
+		{n: 6, want: "[[0 1 2] [3 4 5]]"},
+		{n: 7, want: "[[0 1 2] [3 4 5] [6]]"},
+	}
+
+	for _, tt := range tests {
+		items := make([]int, tt.n)
+		for i := range items {
+			items[i] = i
+		}
+		if got := fmt.Sprint(batches(items, 3)); got != tt.want {
+			t.Errorf("%d items: expected %s, got %s", tt.n, tt.want, got)
+		}
+	}
+}
+
+func TestRunBounded(t *testing.T) {
+	var running, peak int32
+	done := make([]bool, 20)
+	runBounded(context.Background(), len(done), 3, func(i int) {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		done[i] = true
+		atomic.AddInt32(&running, -1)
+	}, func(i int, err error) {
+		t.Errorf("call %d skipped: %v", i, err)
+	})
+
+	if peak > 3 {
+		t.Errorf("expected at most 3 calls at once, got %d", peak)
+	}
+	for i, ok := range done {
+		if !ok {
+			t.Errorf("call %d not made", i)
+		}
+	}
+}
+
+func TestRunBounded_canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the first call holds the only slot until the remaining calls have been
+	// skipped
+	release := make(chan struct{})
+	var called, skipped []int
+	runBounded(ctx, 3, 1, func(i int) {
+		called = append(called, i)
+		cancel()
+		<-release
+	}, func(i int, err error) {
+		if err != context.Canceled {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+		skipped = append(skipped, i)
+		if i == 2 {
+			close(release)
+		}
+	})
+
+	if fmt.Sprint(called) != "[0]" || fmt.Sprint(skipped) != "[1 2]" {
+		t.Errorf("expected call 0 made and 1 and 2 skipped, got %v and %v", called, skipped)
+	}
+}
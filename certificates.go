@@ -0,0 +1,223 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const certificatesBasePath = "v2/certificates"
+
+// certificateIssuancePollInterval is how often CreateAndWait checks the state
+// of a Let's Encrypt certificate unless WaitOptions.PollInterval is set.
+const certificateIssuancePollInterval = 10 * time.Second
+
+// Certificate types
+const (
+	// CertificateTypeCustom is a certificate uploaded by the user.
+	CertificateTypeCustom = "custom"
+	// CertificateTypeLetsEncrypt is a certificate issued by Let's Encrypt
+	// for domains managed with DigitalOcean DNS.
+	CertificateTypeLetsEncrypt = "lets_encrypt"
+)
+
+// Certificate states
+const (
+	// CertificatePending is the state of a certificate which is being issued.
+	CertificatePending = "pending"
+	// CertificateVerified is the state of a certificate which can be used.
+	CertificateVerified = "verified"
+	// CertificateError is the state of a certificate whose issuance failed.
+	CertificateError = "error"
+)
+
+// Certificate represents a DigitalOcean certificate configuration.
+type Certificate struct {
+	ID              string   `json:"id,omitempty"`
+	Name            string   `json:"name,omitempty"`
+	DNSNames        []string `json:"dns_names,omitempty"`
+	NotAfter        string   `json:"not_after,omitempty"`
+	SHA1Fingerprint string   `json:"sha1_fingerprint,omitempty"`
+	Created         string   `json:"created_at,omitempty"`
+	State           string   `json:"state,omitempty"`
+	Type            string   `json:"type,omitempty"`
+}
+
+// CertificateRequest represents configuration for a new certificate. Custom
+// certificates are uploaded with PrivateKey, LeafCertificate and optionally
+// CertificateChain, Let's Encrypt certificates are issued for DNSNames.
+type CertificateRequest struct {
+	Name             string   `json:"name,omitempty"`
+	DNSNames         []string `json:"dns_names,omitempty"`
+	PrivateKey       string   `json:"private_key,omitempty"`
+	LeafCertificate  string   `json:"leaf_certificate,omitempty"`
+	CertificateChain string   `json:"certificate_chain,omitempty"`
+	Type             string   `json:"type,omitempty"`
+}
+
+// CertificateIssuanceError occurs when Let's Encrypt fails to issue a
+// certificate.
+type CertificateIssuanceError struct {
+	Certificate *Certificate
+}
+
+func (e *CertificateIssuanceError) Error() string {
+	return fmt.Sprintf("issuing certificate %s for %v failed", e.Certificate.ID, e.Certificate.DNSNames)
+}
+
+/* SERVICE */
+
+// CertificatesService is an interface for managing certificates with the DigitalOcean API.
+// See: https://docs.digitalocean.com/reference/api/api-reference/#tag/Certificates
+type CertificatesService interface {
+	List(context.Context, *ListOptions) ([]Certificate, *Response, error)
+	ListAll(context.Context, *ListAllOptions) ([]Certificate, *Response, error)
+	Get(context.Context, string) (*Certificate, *Response, error)
+	Create(context.Context, *CertificateRequest) (*Certificate, *Response, error)
+	CreateAndWait(context.Context, *CertificateRequest, WaitOptions) (*Certificate, *Response, error)
+	Delete(context.Context, string) (*Response, error)
+}
+
+// CertificatesServiceOp handles communication with certificates methods of the DigitalOcean API.
+type CertificatesServiceOp struct {
+	client *Client
+}
+
+var _ CertificatesService = &CertificatesServiceOp{}
+
+// List all certificates.
+func (s *CertificatesServiceOp) List(ctx context.Context, opt *ListOptions) ([]Certificate, *Response, error) {
+	path, err := addOptions(certificatesBasePath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	certificates, resp, err := DoEnvelope[[]Certificate](ctx, s.client, req, "certificates")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *certificates, resp, err
+}
+
+// ListAll certificates across all pages
+func (s *CertificatesServiceOp) ListAll(ctx context.Context, opt *ListAllOptions) ([]Certificate, *Response, error) {
+	return ListAll[Certificate](ctx, s.List, opt)
+}
+
+// Get an existing certificate by its identifier.
+func (s *CertificatesServiceOp) Get(ctx context.Context, certificateID string) (*Certificate, *Response, error) {
+	if certificateID == "" {
+		return nil, nil, &ValidationError{Field: "certificateID", Reason: "must not be empty"}
+	}
+
+	path := fmt.Sprintf("%s/%s", certificatesBasePath, certificateID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doCertificate(ctx, req)
+}
+
+// Create a new certificate with provided configuration. Let's Encrypt
+// certificates are pending until issued, use CreateAndWait to wait for them.
+func (s *CertificatesServiceOp) Create(ctx context.Context, cr *CertificateRequest) (*Certificate, *Response, error) {
+	if err := validateCertificateRequest(cr); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, certificatesBasePath, cr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doCertificate(ctx, req)
+}
+
+// CreateAndWait creates a certificate and waits until it is verified, polling
+// as configured by opts. A failed issuance is reported as a
+// *CertificateIssuanceError, and exceeding opts.Timeout as a
+// *WaitTimeoutError with the last state seen.
+func (s *CertificatesServiceOp) CreateAndWait(ctx context.Context, cr *CertificateRequest, opts WaitOptions) (*Certificate, *Response, error) {
+	certificate, resp, err := s.Create(ctx, cr)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	certificateID := certificate.ID
+	certificate, err = pollFrom(ctx, opts.withDefaultPollInterval(certificateIssuancePollInterval), certificate, func(ctx context.Context) (*Certificate, error) {
+		latest, latestResp, err := s.Get(ctx, certificateID)
+		resp = latestResp
+		return latest, err
+	}, func(certificate *Certificate) (bool, string, error) {
+		switch certificate.State {
+		case CertificateError:
+			return false, certificate.State, &CertificateIssuanceError{Certificate: certificate}
+		case CertificateVerified:
+			return true, certificate.State, nil
+		}
+		return false, certificate.State, nil
+	})
+	return certificate, resp, err
+}
+
+// Delete a certificate by its identifier.
+func (s *CertificatesServiceOp) Delete(ctx context.Context, certificateID string) (*Response, error) {
+	if certificateID == "" {
+		return nil, &ValidationError{Field: "certificateID", Reason: "must not be empty"}
+	}
+
+	path := fmt.Sprintf("%s/%s", certificatesBasePath, certificateID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *CertificatesServiceOp) doCertificate(ctx context.Context, req *http.Request) (*Certificate, *Response, error) {
+	certificate, resp, err := DoEnvelope[Certificate](ctx, s.client, req, "certificate")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return certificate, resp, err
+}
+
+// validateCertificateRequest checks that the fields required by the type of
+// the certificate are set.
+func validateCertificateRequest(cr *CertificateRequest) error {
+	if cr == nil {
+		return &ValidationError{Field: "certificateRequest", Reason: "cannot be nil"}
+	}
+	if cr.Name == "" {
+		return &ValidationError{Field: "name", Reason: "must not be empty"}
+	}
+
+	switch cr.Type {
+	case CertificateTypeLetsEncrypt:
+		if len(cr.DNSNames) == 0 {
+			return &ValidationError{Field: "dns_names", Reason: "must not be empty for Let's Encrypt certificates"}
+		}
+	case CertificateTypeCustom, "":
+		if cr.PrivateKey == "" {
+			return &ValidationError{Field: "private_key", Reason: "must not be empty for custom certificates"}
+		}
+		if cr.LeafCertificate == "" {
+			return &ValidationError{Field: "leaf_certificate", Reason: "must not be empty for custom certificates"}
+		}
+	default:
+		return &ValidationError{Field: "type", Value: cr.Type, Reason: fmt.Sprintf("must be %s or %s", CertificateTypeCustom, CertificateTypeLetsEncrypt)}
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCertificates_List(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/certificates", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"certificates":[{"id":"892071a0","name":"web","dns_names":["example.com"],"state":"verified","type":"lets_encrypt"}]}`)
+	})
+
+	certificates, _, err := c.Certificates.List(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Certificates.List returned error: %v", err)
+	}
+	if len(certificates) != 1 || certificates[0].State != CertificateVerified || certificates[0].Type != CertificateTypeLetsEncrypt {
+		t.Errorf("got certificates %+v", certificates)
+	}
+}
+
+func TestCertificates_Create(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/certificates", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var req CertificateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if req.Type != CertificateTypeCustom || req.PrivateKey != "key" || req.LeafCertificate != "leaf" {
+			t.Errorf("got request %+v", req)
+		}
+		fmt.Fprint(w, `{"certificate":{"id":"892071a0","state":"verified","type":"custom"}}`)
+	})
+
+	cert, _, err := c.Certificates.Create(context.Background(), &CertificateRequest{Name: "web", Type: CertificateTypeCustom, PrivateKey: "key", LeafCertificate: "leaf"})
+	if err != nil {
+		t.Fatalf("Certificates.Create returned error: %v", err)
+	}
+	if cert.ID != "892071a0" {
+		t.Errorf("got certificate %+v", cert)
+	}
+}
+
+func TestCertificates_GetDelete(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/certificates/892071a0", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"certificate":{"id":"892071a0","sha1_fingerprint":"dfcc9f57"}}`)
+	})
+
+	cert, _, err := c.Certificates.Get(context.Background(), "892071a0")
+	if err != nil {
+		t.Fatalf("Certificates.Get returned error: %v", err)
+	}
+	if cert.SHA1Fingerprint != "dfcc9f57" {
+		t.Errorf("got certificate %+v", cert)
+	}
+	if _, err := c.Certificates.Delete(context.Background(), "892071a0"); err != nil {
+		t.Errorf("Certificates.Delete returned error: %v", err)
+	}
+}
+
+func TestCertificates_validation(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+
+	requests := map[string]*CertificateRequest{
+		"nil":                 nil,
+		"no name":             {Type: CertificateTypeLetsEncrypt, DNSNames: []string{"example.com"}},
+		"lets encrypt no dns": {Name: "web", Type: CertificateTypeLetsEncrypt},
+		"custom no key":       {Name: "web", Type: CertificateTypeCustom, LeafCertificate: "leaf"},
+		"untyped no leaf":     {Name: "web", PrivateKey: "key"},
+		"unknown type":        {Name: "web", Type: "self_signed"},
+	}
+	for name, cr := range requests {
+		var verr *ValidationError
+		if _, _, err := c.Certificates.Create(ctx, cr); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+
+	var verr *ValidationError
+	if _, _, err := c.Certificates.Get(ctx, ""); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for an empty id, got %v", err)
+	}
+	if _, err := c.Certificates.Delete(ctx, ""); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for an empty id, got %v", err)
+	}
+}
+
+func TestCertificates_CreateAndWait(t *testing.T) {
+	tests := []struct {
+		name    string
+		states  []string
+		opts    WaitOptions
+		checkFn func(*testing.T, *Certificate, error)
+	}{
+		{
+			name:   "verified",
+			states: []string{CertificatePending, CertificatePending, CertificateVerified},
+			opts:   WaitOptions{PollInterval: time.Millisecond},
+			checkFn: func(t *testing.T, cert *Certificate, err error) {
+				if err != nil || cert.State != CertificateVerified {
+					t.Errorf("got %+v, %v", cert, err)
+				}
+			},
+		},
+		{
+			name:   "error",
+			states: []string{CertificatePending, CertificateError},
+			opts:   WaitOptions{PollInterval: time.Millisecond},
+			checkFn: func(t *testing.T, cert *Certificate, err error) {
+				var ierr *CertificateIssuanceError
+				if !errors.As(err, &ierr) {
+					t.Errorf("expected *CertificateIssuanceError, got %v", err)
+				}
+			},
+		},
+		{
+			name:   "timeout",
+			states: []string{CertificatePending},
+			opts:   WaitOptions{PollInterval: time.Millisecond, Timeout: 20 * time.Millisecond},
+			checkFn: func(t *testing.T, cert *Certificate, err error) {
+				var terr *WaitTimeoutError
+				if !errors.As(err, &terr) || terr.LastStatus != CertificatePending {
+					t.Errorf("expected *WaitTimeoutError with last status pending, got %v", err)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, mux := setup(t)
+
+			polls := 0
+			state := func() string {
+				if polls >= len(tt.states) {
+					return tt.states[len(tt.states)-1]
+				}
+				return tt.states[polls]
+			}
+			mux.HandleFunc("/v2/certificates", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{"certificate":{"id":"cert-1","state":%q}}`, state())
+			})
+			mux.HandleFunc("/v2/certificates/cert-1", func(w http.ResponseWriter, r *http.Request) {
+				polls++
+				fmt.Fprintf(w, `{"certificate":{"id":"cert-1","state":%q}}`, state())
+			})
+
+			cert, _, err := c.Certificates.CreateAndWait(context.Background(), &CertificateRequest{
+				Name:     "web",
+				Type:     CertificateTypeLetsEncrypt,
+				DNSNames: []string{"example.com"},
+			}, tt.opts)
+			tt.checkFn(t, cert, err)
+		})
+	}
+}
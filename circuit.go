@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// CircuitBreaker configures the circuit breaker of the client.
+//
+// The circuit of an endpoint opens after Failures consecutive requests to it
+// failed, with a 5xx response or without a response at all. While open,
+// requests to the endpoint fail fast with a *CircuitOpenError. Once Cooldown
+// has passed a single trial request is sent, which closes the circuit if it
+// succeeds and opens it again if it fails.
+type CircuitBreaker struct {
+	// Failures is the number of consecutive failures opening a circuit.
+	Failures int
+
+	// Cooldown is how long an open circuit rejects requests.
+	Cooldown time.Duration
+}
+
+// SetCircuitBreaker is a client option enabling circuit breaking per endpoint
+// prefix, e.g. "/v2/droplets", so that an endpoint which is down is not
+// hammered with requests. A CircuitOpened event is emitted whenever a circuit
+// opens.
+func SetCircuitBreaker(breaker CircuitBreaker) ClientOpt {
+	return func(c *Client) error {
+		if breaker.Failures < 1 || breaker.Cooldown <= 0 {
+			return fmt.Errorf("invalid circuit breaker %+v", breaker)
+		}
+		c.circuits = &circuitBreaker{cfg: breaker, circuits: make(map[string]*circuit)}
+		return nil
+	}
+}
+
+// CircuitOpenError occurs when a request is not sent because the circuit of
+// its endpoint is open.
+type CircuitOpenError struct {
+	// Endpoint is the prefix used by RatesSnapshot.
+	Endpoint string
+
+	// Until is when a trial request may be sent again.
+	Until time.Time
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit of %s open until %s", e.Endpoint, e.Until.Format(time.RFC3339))
+}
+
+// circuitBreaker implements the circuit breaker configured by CircuitBreaker.
+type circuitBreaker struct {
+	cfg CircuitBreaker
+
+	mu       sync.Mutex
+	circuits map[string]*circuit
+}
+
+// circuit is the state of the circuit of one endpoint.
+type circuit struct {
+	// consecutive failures since the last success
+	failures int
+
+	// zero while the circuit is closed
+	openUntil time.Time
+
+	// whether the trial request of an open circuit is in flight
+	trial bool
+}
+
+// allow reports whether a request to endpoint may be sent, returning a
+// *CircuitOpenError if not, and whether the request is the trial of an open
+// circuit.
+func (b *circuitBreaker) allow(endpoint string) (trial bool, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c, ok := b.circuits[endpoint]
+	if !ok {
+		c = &circuit{}
+		b.circuits[endpoint] = c
+	}
+	if c.openUntil.IsZero() {
+		return false, nil
+	}
+	if c.trial || time.Now().Before(c.openUntil) {
+		return false, &CircuitOpenError{Endpoint: endpoint, Until: c.openUntil}
+	}
+	c.trial = true
+	return true, nil
+}
+
+// done records the outcome of a request allowed by allow, given the status
+// of its response or zero and the error of a request without one, and
+// reports whether it opened the circuit.
+func (b *circuitBreaker) done(endpoint string, trial bool, status int, err error) (opened bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c := b.circuits[endpoint]
+	if trial {
+		c.trial = false
+	}
+	if status == 0 && (errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)) {
+		// given up by the caller, which says nothing about the endpoint
+		return false
+	}
+	if status != 0 && status < http.StatusInternalServerError {
+		c.failures = 0
+		c.openUntil = time.Time{}
+		return false
+	}
+
+	c.failures++
+	if trial || (c.openUntil.IsZero() && c.failures >= b.cfg.Failures) {
+		c.openUntil = time.Now().Add(b.cfg.Cooldown)
+		return true
+	}
+	return false
+}
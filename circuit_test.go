@@ -0,0 +1,127 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSetCircuitBreaker(t *testing.T) {
+	var opened []*CircuitOpened
+	c, mux := setup(t,
+		SetCircuitBreaker(CircuitBreaker{Failures: 3, Cooldown: 50 * time.Millisecond}),
+		AddEventListener(func(ctx context.Context, e Event) {
+			if e, ok := e.(*CircuitOpened); ok {
+				opened = append(opened, e)
+			}
+		}))
+
+	var requests, healthy int32
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if atomic.LoadInt32(&healthy) == 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, `{"id":"service_unavailable","message":"down"}`)
+			return
+		}
+		fmt.Fprint(w, `{"account":{}}`)
+	})
+	mux.HandleFunc("/v2/sizes", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"sizes":[]}`)
+	})
+
+	get := func() error {
+		_, _, err := c.Account.Get(context.Background())
+		return err
+	}
+
+	for i := 0; i < 3; i++ {
+		var eerr *ErrorResponse
+		if err := get(); !errors.As(err, &eerr) {
+			t.Fatalf("request %d: expected *ErrorResponse, got %v", i, err)
+		}
+	}
+	if len(opened) != 1 || opened[0].Endpoint != "/v2/account" {
+		t.Fatalf("expected one CircuitOpened event for /v2/account, got %v", opened)
+	}
+	var eerr *ErrorResponse
+	if !errors.As(opened[0].Err, &eerr) {
+		t.Errorf("expected the event to carry the 503 error, got %v", opened[0].Err)
+	}
+
+	var cerr *CircuitOpenError
+	if err := get(); !errors.As(err, &cerr) || cerr.Endpoint != "/v2/account" {
+		t.Fatalf("expected *CircuitOpenError, got %v", err)
+	}
+	if got := atomic.LoadInt32(&requests); got != 3 {
+		t.Errorf("expected no request sent while the circuit is open, got %d sent", got)
+	}
+	if _, _, err := c.Sizes.List(context.Background(), nil); err != nil {
+		t.Errorf("expected other endpoints unaffected, got %v", err)
+	}
+
+	// a failed trial opens the circuit again
+	time.Sleep(60 * time.Millisecond)
+	if err := get(); !errors.As(err, &eerr) {
+		t.Fatalf("expected the trial request sent, got %v", err)
+	}
+	if len(opened) != 2 {
+		t.Errorf("expected the failed trial to open the circuit, got %d events", len(opened))
+	}
+	if err := get(); !errors.As(err, &cerr) {
+		t.Fatalf("expected *CircuitOpenError after the failed trial, got %v", err)
+	}
+
+	// a successful trial closes it
+	time.Sleep(60 * time.Millisecond)
+	atomic.StoreInt32(&healthy, 1)
+	for i := 0; i < 2; i++ {
+		if err := get(); err != nil {
+			t.Fatalf("expected the circuit closed, got %v", err)
+		}
+	}
+}
+
+func TestCircuitBreaker_clientErrorsAreNotFailures(t *testing.T) {
+	c, mux := setup(t, SetCircuitBreaker(CircuitBreaker{Failures: 1, Cooldown: time.Hour}))
+
+	mux.HandleFunc("/v2/droplets/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"id":"not_found","message":"not found"}`)
+	})
+
+	for i := 0; i < 3; i++ {
+		if _, _, err := c.Droplets.Get(context.Background(), 1); !errors.Is(err, ErrNotFound) {
+			t.Fatalf("request %d: expected ErrNotFound, got %v", i, err)
+		}
+	}
+}
+
+func TestCircuitBreaker_canceledIsNotFailure(t *testing.T) {
+	b := &circuitBreaker{cfg: CircuitBreaker{Failures: 1, Cooldown: time.Hour}, circuits: make(map[string]*circuit)}
+
+	if _, err := b.allow("/v2/account"); err != nil {
+		t.Fatalf("allow returned error: %v", err)
+	}
+	if b.done("/v2/account", false, 0, context.Canceled) {
+		t.Error("expected a canceled request not to open the circuit")
+	}
+	if _, err := b.allow("/v2/account"); err != nil {
+		t.Errorf("expected the circuit closed, got %v", err)
+	}
+	if !b.done("/v2/account", false, 0, errors.New("connection refused")) {
+		t.Error("expected a transport error to open the circuit")
+	}
+}
+
+func TestSetCircuitBreaker_invalid(t *testing.T) {
+	for _, breaker := range []CircuitBreaker{{}, {Failures: 1}, {Cooldown: time.Second}} {
+		if _, err := New(nil, SetCircuitBreaker(breaker)); err == nil {
+			t.Errorf("expected an error for %+v", breaker)
+		}
+	}
+}
@@ -4,9 +4,20 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log/slog"
 	"net/http"
 	"net/url"
+	"reflect"
+	"strconv"
 	"sync"
+	"time"
+
+	"github.com/google/go-querystring/query"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -35,19 +46,189 @@ type Client struct {
 	Rate    Rate
 	ratemtx sync.Mutex
 
+	// Latest rate limit observed for each endpoint prefix.
+	rateStore RateStore
+
+	// Number of rate limited responses received for each endpoint prefix,
+	// guarded by ratemtx.
+	rateLimited map[string]int
+
 	// Services used for communicating with the API
-	Tags TagsService
+	Account             AccountService
+	Actions             ActionsService
+	Activity            ActivityService
+	Apps                AppsService
+	Balance             BalanceService
+	BillingHistory      BillingHistoryService
+	Certificates        CertificatesService
+	Databases           DatabasesService
+	Domains             DomainsService
+	DomainRecords       DomainRecordsService
+	Droplets            DropletsService
+	DropletActions      DropletActionsService
+	Functions           FunctionsService
+	Images              ImagesService
+	ImageActions        ImageActionsService
+	Invoices            InvoicesService
+	Keys                KeysService
+	Kubernetes          KubernetesService
+	Limits              LimitsService
+	LoadBalancers       LoadBalancersService
+	Monitoring          MonitoringService
+	OneClick            OneClickService
+	PartnerAttachment   PartnerAttachmentService
+	Projects            ProjectsService
+	Regions             RegionsService
+	Registry            RegistryService
+	ReservedIPs         ReservedIPsService
+	ReservedIPActions   ReservedIPActionsService
+	ReservedIPV6s       ReservedIPV6sService
+	ReservedIPV6Actions ReservedIPV6ActionsService
+	Sizes               SizesService
+	Snapshots           SnapshotsService
+	SpacesKeys          SpacesKeysService
+	Storage             StorageService
+	StorageActions      StorageActionsService
+	Tags                TagsService
+	UptimeChecks        UptimeChecksService
+	VPCs                VPCsService
 
 	// Optional extra HTTP headers to set on every request to the API.
 	headers map[string]string
+
+	// Maximum number of bytes read from a response body. Zero means no limit.
+	maxResponseBodySize int64
+
+	// Block requests until the rate limit resets once it has been exhausted.
+	waitForRateLimitReset bool
+
+	// Optional FIFO queue holding requests while the rate limit is exhausted.
+	rateLimitQueue *rateLimitQueue
+
+	// Optional client-side limiter throttling outgoing requests.
+	limiter *rate.Limiter
+
+	// Callbacks fired as rate limits are observed.
+	rateLimitHooks RateLimitHooks
+
+	// How requests rejected with 429 are retried.
+	rateLimitStrategy RateLimitStrategy
+
+	// Optional limiter adapting the number of requests in flight.
+	concurrency *adaptiveLimiter
+
+	// Optional circuit breaker failing fast for endpoints which are down.
+	circuits *circuitBreaker
+
+	// Optional function called after every successful request made to the API
+	onRequestCompleted RequestCompletionCallback
+
+	// Middleware wrapped around the HTTP client, outermost first.
+	middleware []Middleware
+
+	// Optional logger, the client is silent without one.
+	logger *slog.Logger
+
+	// Dump requests and responses to debugWriter.
+	debug       bool
+	debugWriter io.Writer
+
+	// Removes secrets from logs and debug dumps.
+	redactor *Redactor
+
+	// Latency and error statistics per endpoint.
+	stats statsRecorder
+
+	// Request counts since creation or the last ResetSnapshot.
+	counters counters
+
+	// Where correlation IDs are sent and read from.
+	correlationHeader string
+	correlationKey    any
+
+	// Listeners of request lifecycle events.
+	listeners []EventListener
+
+	// Receives a record of every state changing call.
+	auditSink AuditSink
+
+	// Whether connection timings of requests are recorded.
+	connTrace bool
 }
 
+// RequestCompletionCallback defines the type of the request callback function
+type RequestCompletionCallback func(*http.Request, *http.Response)
+
+// ClientOpt are options for New.
+type ClientOpt func(*Client) error
+
 type ListOptions struct {
 	// For paginated result sets, page of results to retrieve.
 	Page int `url:"page,omitempty"`
 
 	// For paginated result sets, the number of results to include per page.
 	PerPage int `url:"per_page,omitempty"`
+
+	// For cursor paginated result sets, the opaque token of the page to
+	// retrieve, as returned in Meta.NextPageToken or the next page link.
+	PageToken string `url:"page_token,omitempty"`
+
+	// Fields restricts the attributes returned for each item, where the
+	// endpoint supports sparse fieldsets. Omitted attributes are left at their
+	// zero value when decoded.
+	Fields []string `url:"fields,comma,omitempty"`
+
+	// Name filters results by name, where the endpoint supports it.
+	Name string `url:"name,omitempty"`
+
+	// TagName filters results by tag, where the endpoint supports it.
+	TagName string `url:"tag_name,omitempty"`
+
+	// Type filters results by resource type, where the endpoint supports it.
+	Type string `url:"type,omitempty"`
+
+	// SortBy orders results by the given field, where the endpoint supports
+	// it. Each endpoint validates it against the fields it can sort by.
+	SortBy string `url:"sort,omitempty"`
+
+	// SortDirection is the order results are sorted in.
+	SortDirection SortDirection `url:"sort_direction,omitempty"`
+}
+
+// SortDirection is the order of sorted results.
+type SortDirection string
+
+const (
+	// SortAscending sorts results in ascending order.
+	SortAscending SortDirection = "asc"
+	// SortDescending sorts results in descending order.
+	SortDescending SortDirection = "desc"
+)
+
+// validateSort checks the sort options of o against the fields an endpoint can
+// sort by.
+func (o *ListOptions) validateSort(fields ...string) error {
+	if o == nil {
+		return nil
+	}
+
+	switch o.SortDirection {
+	case "", SortAscending, SortDescending:
+	default:
+		return fmt.Errorf("invalid sort direction %q, expected %q or %q", o.SortDirection, SortAscending, SortDescending)
+	}
+	if o.SortBy == "" {
+		if o.SortDirection != "" {
+			return errors.New("sort direction given without a sort field")
+		}
+		return nil
+	}
+	for _, field := range fields {
+		if o.SortBy == field {
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot sort by %q, expected one of %q", o.SortBy, fields)
 }
 
 // Rate contains the rate limit for the current client.
@@ -66,9 +247,51 @@ type Rate struct {
 type Response struct {
 	*http.Response
 
+	// Links that were returned with the response. These are parsed from
+	// request body and not the header.
+	Links *Links
+
+	// Meta describes generic information about the response.
+	Meta *Meta
+
+	// QueueTime is how long the request waited in the rate limit queue
+	// before it was sent.
+	QueueTime time.Duration
+
+	// RequestID and CorrelationID are the IDs the server returned for the
+	// request, useful to trace it across services.
+	RequestID     string
+	CorrelationID string
+
+	// ConnTrace holds the connection timings of the request when enabled
+	// with SetConnTrace.
+	ConnTrace *ConnTrace
+
 	Rate
 }
 
+// Meta describes generic information about a response.
+type Meta struct {
+	// Total is the number of items of a list across all pages.
+	Total int `json:"total"`
+
+	// NextPageToken is the opaque token of the next page of cursor
+	// paginated endpoints. It is empty on the last page.
+	NextPageToken string `json:"next_page_token,omitempty"`
+}
+
+// An ErrorResponse reports the error caused by an API request
+type ErrorResponse struct {
+	// HTTP response that caused this error
+	Response *http.Response
+
+	// Error message
+	Message string `json:"message"`
+
+	// RequestID returned from the API, useful to contact support.
+	RequestID string `json:"request_id"`
+}
+
 /* NEW CLIENT */
 
 // NewClient returns a new DigitalOcean API client, using the given
@@ -84,19 +307,87 @@ func NewClient(httpClient *http.Client) *Client {
 
 	baseURL, _ := url.Parse(defaultBaseURL)
 
-	c := &Client{client: httpClient, BaseURL: baseURL, UserAgent: userAgent}
+	c := &Client{client: httpClient, BaseURL: baseURL, UserAgent: userAgent, rateStore: NewMemoryRateStore(), redactor: NewRedactor()}
+	c.Account = &AccountServiceOp{client: c}
+	c.Actions = &ActionsServiceOp{client: c}
+	c.Activity = &ActivityServiceOp{client: c}
+	c.Apps = &AppsServiceOp{client: c}
+	c.Balance = &BalanceServiceOp{client: c}
+	c.BillingHistory = &BillingHistoryServiceOp{client: c}
+	c.Certificates = &CertificatesServiceOp{client: c}
+	c.Databases = &DatabasesServiceOp{client: c}
+	c.Domains = &DomainsServiceOp{client: c}
+	c.DomainRecords = &DomainRecordsServiceOp{client: c}
+	c.Droplets = &DropletsServiceOp{client: c}
+	c.DropletActions = &DropletActionsServiceOp{client: c}
+	c.Functions = &FunctionsServiceOp{client: c}
+	c.Images = &ImagesServiceOp{client: c}
+	c.ImageActions = &ImageActionsServiceOp{client: c}
+	c.Invoices = &InvoicesServiceOp{client: c}
+	c.Keys = &KeysServiceOp{client: c}
+	c.Kubernetes = &KubernetesServiceOp{client: c}
+	c.Limits = &LimitsServiceOp{client: c}
+	c.LoadBalancers = &LoadBalancersServiceOp{client: c}
+	c.Monitoring = &MonitoringServiceOp{client: c}
+	c.OneClick = &OneClickServiceOp{client: c}
+	c.PartnerAttachment = &PartnerAttachmentServiceOp{client: c}
+	c.Projects = &ProjectsServiceOp{client: c}
+	c.Regions = &RegionsServiceOp{client: c}
+	c.Registry = &RegistryServiceOp{client: c}
+	c.ReservedIPs = &ReservedIPsServiceOp{client: c}
+	c.ReservedIPActions = &ReservedIPActionsServiceOp{client: c}
+	c.ReservedIPV6s = &ReservedIPV6sServiceOp{client: c}
+	c.ReservedIPV6Actions = &ReservedIPV6ActionsServiceOp{client: c}
+	c.Sizes = &SizesServiceOp{client: c}
+	c.Snapshots = &SnapshotsServiceOp{client: c}
+	c.SpacesKeys = &SpacesKeysServiceOp{client: c}
+	c.Storage = &StorageServiceOp{client: c}
+	c.StorageActions = &StorageActionsServiceOp{client: c}
 	c.Tags = &TagsServiceOp{client: c}
+	c.UptimeChecks = &UptimeChecksServiceOp{client: c}
+	c.VPCs = &VPCsServiceOp{client: c}
 
 	return c
 }
 
 // New returns a new DigitalOcean API client instance.
-func New(httpClient *http.Client) (*Client, error) {
+func New(httpClient *http.Client, opts ...ClientOpt) (*Client, error) {
 	c := NewClient(httpClient)
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
 
 	return c, nil
 }
 
+// SetMaxResponseBodySize is a client option for limiting the number of bytes
+// read from a response body. Responses exceeding the limit fail with a
+// ResponseTooLargeError.
+func SetMaxResponseBodySize(n int64) ClientOpt {
+	return func(c *Client) error {
+		if n < 0 {
+			return fmt.Errorf("max response body size must not be negative, got %d", n)
+		}
+		c.maxResponseBodySize = n
+		return nil
+	}
+}
+
+// OnRequestCompleted sets the API request completion callback
+func (c *Client) OnRequestCompleted(rc RequestCompletionCallback) {
+	c.onRequestCompleted = rc
+}
+
+// GetRate returns the current rate limit for the client as determined by the most recent
+// API call. It is thread-safe.
+func (c *Client) GetRate() Rate {
+	c.ratemtx.Lock()
+	defer c.ratemtx.Unlock()
+	return c.Rate
+}
+
 /* NEW REQUEST */
 
 func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body interface{}) (*http.Request, error) {
@@ -104,6 +395,7 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body int
 	if err != nil {
 		return nil, err
 	}
+	addFields(ctx, u)
 
 	var req *http.Request
 	switch method {
@@ -112,7 +404,7 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body int
 		if err != nil {
 			return nil, err
 		}
-	case http.MethodPost:
+	default:
 		buf := new(bytes.Buffer)
 		if body != nil {
 			err = json.NewEncoder(buf).Encode(body)
@@ -126,8 +418,6 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body int
 			return nil, err
 		}
 		req.Header.Set("Content-Type", mediaType)
-	default:
-		//
 	}
 	// add headers
 	req.Header.Set("Accept", mediaType)
@@ -136,6 +426,376 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body int
 	return req, nil
 }
 
+/* DO */
+
+// newResponse creates a new Response for the provided http.Response
+func newResponse(r *http.Response) *Response {
+	response := Response{Response: r}
+	response.Rate = parseRate(r)
+
+	return &response
+}
+
+// parseRate parses the rate related headers.
+func parseRate(r *http.Response) Rate {
+	var rate Rate
+	if limit := r.Header.Get(headerRateLimit); limit != "" {
+		rate.Limit, _ = strconv.Atoi(limit)
+	}
+	if remaining := r.Header.Get(headerRateRemaining); remaining != "" {
+		rate.Remaining, _ = strconv.Atoi(remaining)
+	}
+	if reset := r.Header.Get(headerRateReset); reset != "" {
+		if v, _ := strconv.ParseInt(reset, 10, 64); v != 0 {
+			rate.Reset = Timestamp{time.Unix(v, 0)}
+		}
+	}
+	return rate
+}
+
+// Do sends an API request and returns the API response. The API response is JSON decoded and stored in the value
+// pointed to by v, or returned as an error if an API error has occurred. If v implements the io.Writer interface,
+// the raw response will be written to v, without attempting to decode it.
+//
+// Requests rejected with 429 Too Many Requests are retried according to the
+// RateLimitStrategy of the client, or the one set on ctx with
+// WithRateLimitStrategy. Requests not sent because ctx expires before the rate
+// limit resets are not retried.
+func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (response *Response, err error) {
+	if c.auditSink != nil {
+		start := time.Now()
+		defer func() { c.audit(ctx, req, start, response, err) }()
+	}
+
+	strategy := c.rateLimitStrategyFor(ctx)
+	for attempt := 0; ; attempt++ {
+		response, err = c.do(withAttempt(ctx, attempt), req, v)
+		rerr, ok := err.(*RateLimitError)
+		if !ok || rerr.unsent || attempt >= strategy.MaxRetries {
+			return response, err
+		}
+
+		delay := strategy.delay(attempt, rerr)
+		c.logInfo(ctx, "retrying rate limited request",
+			"method", req.Method, "url", c.redactor.RedactURL(req.URL), "attempt", attempt+1, "delay", delay)
+		c.Emit(ctx, &RetryScheduled{Request: req, Attempt: attempt + 1, Delay: delay, Err: rerr})
+		slept := time.Now()
+		err = sleep(ctx, delay)
+		c.counters.throttle(time.Since(slept))
+		if err != nil {
+			return response, err
+		}
+		if req.Body != nil {
+			if req.GetBody == nil {
+				// the body has been consumed and can not be sent again
+				return response, rerr
+			}
+			body, berr := req.GetBody()
+			if berr != nil {
+				return response, rerr
+			}
+			req.Body = body
+		}
+	}
+}
+
+// do sends a single attempt of an API request, see Do.
+func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (response *Response, err error) {
+	// time spent waiting for rate and concurrency limits before sending
+	var sent time.Time
+	waiting := time.Now()
+	defer func() {
+		if sent.IsZero() {
+			sent = time.Now()
+		}
+		c.counters.throttle(sent.Sub(waiting))
+	}()
+
+	var queueTime time.Duration
+	if c.rateLimitQueue != nil {
+		queueTime, err = c.queueForRateLimit(ctx, req)
+	} else if c.waitForRateLimitReset {
+		err = c.waitForRateLimit(ctx, req)
+	}
+	if err != nil {
+		if rerr, ok := err.(*RateLimitError); ok {
+			return &Response{Response: rerr.Response, Rate: rerr.Rate, QueueTime: queueTime}, err
+		}
+		return nil, err
+	}
+
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	var status int
+	if c.concurrency != nil {
+		if err := c.concurrency.acquire(ctx); err != nil {
+			return nil, err
+		}
+		start := time.Now()
+		defer func() { c.concurrency.release(time.Since(start), status) }()
+	}
+
+	if c.circuits != nil {
+		endpoint := c.rateKey(req)
+		trial, cerr := c.circuits.allow(endpoint)
+		if cerr != nil {
+			return nil, cerr
+		}
+		defer func() {
+			if c.circuits.done(endpoint, trial, status, err) {
+				c.logInfo(ctx, "circuit opened", "endpoint", endpoint, "cooldown", c.circuits.cfg.Cooldown)
+				c.Emit(ctx, &CircuitOpened{Endpoint: endpoint, Err: err})
+			}
+		}()
+	}
+
+	var tracer *connTracer
+	if c.connTrace {
+		ctx, tracer = withConnTrace(ctx)
+	}
+	req = req.WithContext(ctx)
+	c.setCorrelationID(ctx, req)
+	c.logDebug(ctx, "request started", "method", req.Method, "url", c.redactor.RedactURL(req.URL))
+	c.dumpRequest(req)
+	sent = time.Now()
+	resp, err := c.doer().Do(req)
+	latency := time.Since(sent)
+	c.counters.record(RequestAttempt(req), resp)
+	if err != nil {
+		c.stats.record(req, latency, 0)
+		c.logDebug(ctx, "request failed",
+			"method", req.Method, "url", c.redactor.RedactURL(req.URL), "duration", latency,
+			"error", string(c.redactor.Redact([]byte(err.Error()))))
+		return nil, err
+	}
+	c.stats.record(req, latency, resp.StatusCode)
+	c.dumpResponse(resp)
+	c.logDebug(ctx, "request finished",
+		"method", req.Method, "url", c.redactor.RedactURL(req.URL), "status", resp.StatusCode, "duration", latency)
+	status = resp.StatusCode
+	if c.onRequestCompleted != nil {
+		c.onRequestCompleted(req, resp)
+	}
+
+	defer func() {
+		// to reuse the connection read the body before closing
+		const maxBodySlurpSize = 2 << 10
+		if resp.ContentLength == -1 || resp.ContentLength <= maxBodySlurpSize {
+			io.CopyN(ioutil.Discard, resp.Body, maxBodySlurpSize)
+		}
+
+		if rerr := resp.Body.Close(); err == nil {
+			err = rerr
+		}
+	}()
+
+	response = newResponse(resp)
+	response.QueueTime = queueTime
+	response.RequestID = resp.Header.Get(headerRequestID)
+	response.CorrelationID = resp.Header.Get(c.correlationIDHeader())
+	if tracer != nil {
+		trace := tracer.trace()
+		response.ConnTrace = &trace
+	}
+	c.recordRate(req, response.Rate)
+
+	if limit := c.maxResponseBodySize; limit > 0 {
+		if resp.ContentLength > limit {
+			return response, &ResponseTooLargeError{Response: resp, Limit: limit}
+		}
+		resp.Body = &limitedBody{
+			ReadCloser: resp.Body,
+			r:          io.LimitReader(resp.Body, limit+1),
+			limit:      limit,
+			response:   resp,
+		}
+	}
+
+	err = CheckResponse(resp)
+	if err != nil {
+		if _, ok := err.(*RateLimitError); ok {
+			c.recordRateLimited(req, response)
+		}
+		return response, err
+	}
+
+	if v != nil {
+		if w, ok := v.(io.Writer); ok {
+			_, err = io.Copy(w, resp.Body)
+			if err != nil {
+				return response, err
+			}
+		} else if d, ok := v.(bodyDecoder); ok {
+			err = d.decodeBody(resp.Body, response)
+			if err != nil {
+				return response, err
+			}
+		} else {
+			err = json.NewDecoder(resp.Body).Decode(v)
+			if err == io.EOF {
+				err = nil
+			}
+			if err != nil {
+				return response, err
+			}
+		}
+		c.Emit(ctx, &ResponseDecoded{Request: req, Response: response})
+	}
+
+	return response, err
+}
+
+// Do sends an API request and decodes the JSON response body into a newly
+// allocated value of type T. It is a typed alternative to Client.Do which
+// saves callers from allocating the destination value themselves.
+func Do[T any](ctx context.Context, c *Client, req *http.Request) (*T, *Response, error) {
+	v := new(T)
+	resp, err := c.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+/* ERRORS */
+
+// ErrNotFound is matched by errors.Is for API errors responding 404 Not Found.
+var ErrNotFound = errors.New("resource not found")
+
+// Is reports whether the error response matches target, allowing callers to
+// test for ErrNotFound with errors.Is.
+func (r *ErrorResponse) Is(target error) bool {
+	return target == ErrNotFound && r.Response != nil && r.Response.StatusCode == http.StatusNotFound
+}
+
+func (r *ErrorResponse) Error() string {
+	if r.RequestID != "" {
+		return fmt.Sprintf("%v %v: %d (request %q) %v",
+			r.Response.Request.Method, r.Response.Request.URL, r.Response.StatusCode, r.RequestID, r.Message)
+	}
+	return fmt.Sprintf("%v %v: %d %v",
+		r.Response.Request.Method, r.Response.Request.URL, r.Response.StatusCode, r.Message)
+}
+
+// ValidationError occurs when an argument is rejected before a request is
+// sent to the API.
+type ValidationError struct {
+	// Field is the name of the invalid argument or request field.
+	Field string
+
+	// Value is the rejected value, if it can be shown.
+	Value string
+
+	// Reason describes the rule the value breaks.
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Value != "" {
+		return fmt.Sprintf("invalid %s %q: %s", e.Field, e.Value, e.Reason)
+	}
+	return fmt.Sprintf("invalid %s: %s", e.Field, e.Reason)
+}
+
+// ResponseTooLargeError occurs when a response body exceeds the maximum size
+// configured with SetMaxResponseBodySize.
+type ResponseTooLargeError struct {
+	// HTTP response that caused this error
+	Response *http.Response
+
+	// Limit is the maximum number of bytes allowed
+	Limit int64
+}
+
+func (r *ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("%v %v: %d response body exceeds limit of %d bytes",
+		r.Response.Request.Method, r.Response.Request.URL, r.Response.StatusCode, r.Limit)
+}
+
+// limitedBody wraps a response body and fails with a ResponseTooLargeError
+// once more than limit bytes have been read.
+type limitedBody struct {
+	io.ReadCloser
+
+	r        io.Reader
+	read     int64
+	limit    int64
+	response *http.Response
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	n, err := b.r.Read(p)
+	b.read += int64(n)
+	if b.read > b.limit {
+		return n - int(b.read-b.limit), &ResponseTooLargeError{Response: b.response, Limit: b.limit}
+	}
+	return n, err
+}
+
+// CheckResponse checks the API response for errors, and returns them if present. A response is considered an
+// error if it has a status code outside the 200 range. API error responses are expected to have either no response
+// body, or a JSON response body that maps to ErrorResponse. Any other response body will be silently ignored.
+func CheckResponse(r *http.Response) error {
+	if c := r.StatusCode; c >= 200 && c <= 299 {
+		return nil
+	}
+
+	errorResponse := &ErrorResponse{Response: r}
+	data, err := ioutil.ReadAll(r.Body)
+	if err == nil && len(data) > 0 {
+		err := json.Unmarshal(data, errorResponse)
+		if err != nil {
+			errorResponse.Message = string(data)
+		}
+	}
+
+	if IsRateLimited(r) {
+		return &RateLimitError{
+			Rate:     parseRate(r),
+			Response: r,
+			Message:  errorResponse.Message,
+		}
+	}
+	return errorResponse
+}
+
+// IsRateLimited reports whether r rejected its request because of the rate
+// limit, with 429 Too Many Requests or with 403 Forbidden and no requests
+// remaining.
+func IsRateLimited(r *http.Response) bool {
+	return r.StatusCode == http.StatusTooManyRequests ||
+		r.StatusCode == http.StatusForbidden && r.Header.Get(headerRateRemaining) == "0"
+}
+
+/* OPTIONS */
+
+// addOptions adds the parameters in opts as URL query parameters to s. opts
+// must be a struct whose fields may contain "url" tags.
+func addOptions(s string, opts interface{}) (string, error) {
+	v := reflect.ValueOf(opts)
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		return s, nil
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return s, err
+	}
+
+	qs, err := query.Values(opts)
+	if err != nil {
+		return s, err
+	}
+
+	u.RawQuery = qs.Encode()
+	return u.String(), nil
+}
+
 type Pagination struct {
 	Page    int `json:"page"`
 	PerPage int `json:"per_page"`
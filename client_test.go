@@ -0,0 +1,378 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// setup returns a client sending its requests to a test server, and the mux
+// the test registers its handlers with.
+func setup(t *testing.T, opts ...ClientOpt) (*Client, *http.ServeMux) {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	c, err := New(nil, opts...)
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+	c.BaseURL, _ = url.Parse(server.URL + "/")
+	return c, mux
+}
+
+// testMethod checks that r was sent with the HTTP method want.
+func testMethod(t *testing.T, r *http.Request, want string) {
+	t.Helper()
+	if r.Method != want {
+		t.Errorf("expected %s %s, got %s", want, r.URL.Path, r.Method)
+	}
+}
+
+func TestDo_rateLimitNotSentIsNotRetried(t *testing.T) {
+	c, mux := setup(t,
+		SetWaitForRateLimitReset(true),
+		SetRateLimitStrategy(RateLimitRetryWithBackoff(3, 10*time.Second)))
+
+	var requests int32
+	reset := time.Now().Add(time.Hour).Unix()
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set(headerRateLimit, "5000")
+		w.Header().Set(headerRateRemaining, "0")
+		w.Header().Set(headerRateReset, strconv.FormatInt(reset, 10))
+		fmt.Fprint(w, `{"account":{}}`)
+	})
+
+	if _, _, err := c.Account.Get(context.Background()); err != nil {
+		t.Fatalf("Account.Get returned error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, _, err := c.Account.Get(ctx)
+
+	var rerr *RateLimitError
+	if !errors.As(err, &rerr) {
+		t.Fatalf("expected *RateLimitError, got %v", err)
+	}
+	if ctx.Err() != nil {
+		t.Errorf("expected the error before the context expired")
+	}
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Errorf("expected 1 request sent, got %d", got)
+	}
+}
+
+func TestDo_rateLimitedIsRetried(t *testing.T) {
+	c, mux := setup(t, SetRateLimitStrategy(RateLimitRetryWithBackoff(2, time.Millisecond)))
+
+	var requests int32
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) < 3 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"id":"too_many_requests","message":"slow down"}`)
+			return
+		}
+		fmt.Fprint(w, `{"account":{}}`)
+	})
+
+	if _, _, err := c.Account.Get(context.Background()); err != nil {
+		t.Fatalf("Account.Get returned error: %v", err)
+	}
+	if got := atomic.LoadInt32(&requests); got != 3 {
+		t.Errorf("expected 3 requests sent, got %d", got)
+	}
+}
+
+func TestDo_responseTooLargeKeepsResponse(t *testing.T) {
+	c, mux := setup(t, SetMaxResponseBodySize(16))
+
+	mux.HandleFunc("/v2/large", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRequestID, "req-1")
+		w.Header().Set(headerRateLimit, "5000")
+		w.Header().Set(headerRateRemaining, "4999")
+		fmt.Fprint(w, `{"value":"`)
+		// flush so the body is sent without a Content-Length
+		w.(http.Flusher).Flush()
+		fmt.Fprint(w, `0123456789012345678901234567890123456789"}`)
+	})
+
+	for name, v := range map[string]interface{}{
+		"json":   new(map[string]string),
+		"writer": new(bytes.Buffer),
+	} {
+		t.Run(name, func(t *testing.T) {
+			req, err := c.NewRequest(context.Background(), http.MethodGet, "v2/large", nil)
+			if err != nil {
+				t.Fatalf("NewRequest returned error: %v", err)
+			}
+
+			resp, err := c.Do(context.Background(), req, v)
+			var terr *ResponseTooLargeError
+			if !errors.As(err, &terr) {
+				t.Fatalf("expected *ResponseTooLargeError, got %v", err)
+			}
+			if resp == nil {
+				t.Fatal("expected the response with the error")
+			}
+			if resp.RequestID != "req-1" || resp.Rate.Remaining != 4999 {
+				t.Errorf("got request ID %q and rate %+v", resp.RequestID, resp.Rate)
+			}
+		})
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+type failingCloseBody struct {
+	io.Reader
+	err error
+}
+
+func (b failingCloseBody) Close() error {
+	return b.err
+}
+
+func TestDo_bodyCloseError(t *testing.T) {
+	closeErr := errors.New("close failed")
+	c := NewClient(&http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       failingCloseBody{Reader: strings.NewReader(`{"account":{}}`), err: closeErr},
+			Request:    req,
+		}, nil
+	})})
+
+	_, resp, err := c.Account.Get(context.Background())
+	if !errors.Is(err, closeErr) {
+		t.Fatalf("expected the close error, got %v", err)
+	}
+	if resp == nil {
+		t.Error("expected the response with the error")
+	}
+}
+
+func TestDo_typed(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"account":{"email":"sammy@example.com"}}`)
+	})
+	mux.HandleFunc("/v2/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"id":"not_found","message":"not found"}`)
+	})
+
+	req, err := c.NewRequest(context.Background(), http.MethodGet, "v2/account", nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	root, resp, err := Do[struct{ Account Account }](context.Background(), c, req)
+	if err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if root.Account.Email != "sammy@example.com" {
+		t.Errorf("expected the account decoded, got %+v", root.Account)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200, got %d", resp.StatusCode)
+	}
+
+	req, err = c.NewRequest(context.Background(), http.MethodGet, "v2/missing", nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	v, resp, err := Do[Account](context.Background(), c, req)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+	if v != nil {
+		t.Errorf("expected no value with the error, got %+v", v)
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected the 404 response with the error, got %v", resp)
+	}
+}
+
+func TestSetMaxResponseBodySize(t *testing.T) {
+	c, mux := setup(t, SetMaxResponseBodySize(64))
+
+	mux.HandleFunc("/v2/small", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"value":"fits"}`)
+	})
+	mux.HandleFunc("/v2/large", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"value":%q}`, strings.Repeat("x", 128))
+	})
+
+	for path, tooLarge := range map[string]bool{"v2/small": false, "v2/large": true} {
+		req, err := c.NewRequest(context.Background(), http.MethodGet, path, nil)
+		if err != nil {
+			t.Fatalf("NewRequest returned error: %v", err)
+		}
+		var v map[string]string
+		_, err = c.Do(context.Background(), req, &v)
+
+		var terr *ResponseTooLargeError
+		if got := errors.As(err, &terr); got != tooLarge {
+			t.Errorf("%s: expected too large %v, got error %v", path, tooLarge, err)
+		}
+		if tooLarge && terr.Limit != 64 {
+			t.Errorf("%s: expected limit 64, got %d", path, terr.Limit)
+		}
+	}
+
+	if _, err := New(nil, SetMaxResponseBodySize(-1)); err == nil {
+		t.Error("expected an error for a negative size")
+	}
+}
+
+func TestClient_GetRate(t *testing.T) {
+	c, mux := setup(t)
+
+	var remaining int32 = 5000
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "5000")
+		w.Header().Set(headerRateRemaining, strconv.Itoa(int(atomic.AddInt32(&remaining, -1))))
+		w.Header().Set(headerRateReset, "1700000000")
+		fmt.Fprint(w, `{"account":{}}`)
+	})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 10; i++ {
+			if _, _, err := c.Account.Get(context.Background()); err != nil {
+				t.Errorf("Account.Get returned error: %v", err)
+			}
+		}
+	}()
+	// read concurrently with the updates, for the race detector
+	for i := 0; i < 10; i++ {
+		c.GetRate()
+	}
+	<-done
+
+	rate := c.GetRate()
+	if rate.Limit != 5000 || rate.Remaining != 4990 || rate.Reset.Unix() != 1700000000 {
+		t.Errorf("expected the rate of the last response, got %+v", rate)
+	}
+}
+
+func TestListOptions_filters(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  *ListOptions
+		want string
+	}{
+		{name: "none", opt: &ListOptions{}, want: ""},
+		{name: "name", opt: &ListOptions{Name: "web"}, want: "name=web"},
+		{name: "tag", opt: &ListOptions{TagName: "prod"}, want: "tag_name=prod"},
+		{name: "type", opt: &ListOptions{Type: "distribution"}, want: "type=distribution"},
+		{
+			name: "combined with paging",
+			opt:  &ListOptions{Page: 2, PerPage: 50, Name: "web", TagName: "prod env"},
+			want: "name=web&page=2&per_page=50&tag_name=prod+env",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := addOptions("v2/images", tt.opt)
+			if err != nil {
+				t.Fatalf("addOptions returned error: %v", err)
+			}
+			u, _ := url.Parse(got)
+			if u.RawQuery != tt.want {
+				t.Errorf("expected query %q, got %q", tt.want, u.RawQuery)
+			}
+		})
+	}
+}
+
+func TestListOptions_validateSort(t *testing.T) {
+	tests := []struct {
+		name    string
+		opt     *ListOptions
+		wantErr bool
+	}{
+		{name: "nil", opt: nil},
+		{name: "unsorted", opt: &ListOptions{}},
+		{name: "field", opt: &ListOptions{SortBy: "name"}},
+		{name: "field and direction", opt: &ListOptions{SortBy: "name", SortDirection: SortDescending}},
+		{name: "unknown field", opt: &ListOptions{SortBy: "size"}, wantErr: true},
+		{name: "unknown direction", opt: &ListOptions{SortBy: "name", SortDirection: "up"}, wantErr: true},
+		{name: "direction without field", opt: &ListOptions{SortDirection: SortAscending}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.opt.validateSort("name", "created_at")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestClient_OnRequestCompleted(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"account":{}}`)
+	})
+	mux.HandleFunc("/v2/droplets/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"id":"not_found","message":"not found"}`)
+	})
+
+	var completed []string
+	c.OnRequestCompleted(func(req *http.Request, resp *http.Response) {
+		completed = append(completed, fmt.Sprintf("%s %s %d", req.Method, req.URL.Path, resp.StatusCode))
+	})
+
+	if _, _, err := c.Account.Get(context.Background()); err != nil {
+		t.Fatalf("Account.Get returned error: %v", err)
+	}
+	if _, _, err := c.Droplets.Get(context.Background(), 1); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	want := "[GET /v2/account 200 GET /v2/droplets/1 404]"
+	if fmt.Sprint(completed) != want {
+		t.Errorf("expected %s, got %v", want, completed)
+	}
+}
+
+func TestClient_OnRequestCompleted_transportError(t *testing.T) {
+	c, err := New(&http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	})})
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+	c.OnRequestCompleted(func(req *http.Request, resp *http.Response) {
+		t.Error("expected no callback for a request without a response")
+	})
+
+	if _, _, err := c.Account.Get(context.Background()); err == nil {
+		t.Error("expected an error")
+	}
+}
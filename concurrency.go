@@ -0,0 +1,118 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ConcurrencyLimit configures the adaptive concurrency limiter.
+//
+// The limiter caps the number of requests in flight. Every successful response
+// raises the cap additively by roughly one per window of requests, while a 429
+// or 503 response, or one slower than LatencyThreshold, halves it (AIMD).
+type ConcurrencyLimit struct {
+	// Initial is the cap on requests in flight the client starts with.
+	Initial int
+
+	// Min and Max bound the cap.
+	Min int
+	Max int
+
+	// LatencyThreshold marks responses slower than it as a sign of
+	// congestion. Zero ignores latency.
+	LatencyThreshold time.Duration
+}
+
+// SetAdaptiveConcurrency is a client option enabling the adaptive concurrency
+// limiter, protecting both the client process and the API during bursts.
+func SetAdaptiveConcurrency(limit ConcurrencyLimit) ClientOpt {
+	return func(c *Client) error {
+		if limit.Min < 1 || limit.Max < limit.Min || limit.Initial < limit.Min || limit.Initial > limit.Max {
+			return fmt.Errorf("invalid concurrency limit %+v", limit)
+		}
+		c.concurrency = &adaptiveLimiter{
+			cfg:   limit,
+			limit: float64(limit.Initial),
+			wake:  make(chan struct{}),
+		}
+		return nil
+	}
+}
+
+// ConcurrencyLimit returns the current cap on requests in flight, or zero if
+// the adaptive concurrency limiter is not enabled.
+func (c *Client) ConcurrencyLimit() int {
+	if c.concurrency == nil {
+		return 0
+	}
+	return c.concurrency.current()
+}
+
+// adaptiveLimiter implements the AIMD limiter configured by ConcurrencyLimit.
+type adaptiveLimiter struct {
+	cfg ConcurrencyLimit
+
+	mu       sync.Mutex
+	limit    float64
+	inFlight int
+
+	// wake is closed and replaced whenever a slot may have become free.
+	wake chan struct{}
+}
+
+// current returns the cap on requests in flight.
+func (l *adaptiveLimiter) current() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return int(l.limit)
+}
+
+// acquire blocks until a request may be sent or ctx is done.
+func (l *adaptiveLimiter) acquire(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		if l.inFlight < int(l.limit) {
+			l.inFlight++
+			l.mu.Unlock()
+			return nil
+		}
+		wake := l.wake
+		l.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-wake:
+		}
+	}
+}
+
+// release frees the slot of a request which took latency and finished with
+// status, zero when no response was received, and adapts the cap.
+func (l *adaptiveLimiter) release(latency time.Duration, status int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.inFlight--
+	switch {
+	case status == 0:
+		// no signal about the API without a response
+	case status == http.StatusTooManyRequests || status == http.StatusServiceUnavailable,
+		l.cfg.LatencyThreshold > 0 && latency > l.cfg.LatencyThreshold:
+		l.limit /= 2
+		if l.limit < float64(l.cfg.Min) {
+			l.limit = float64(l.cfg.Min)
+		}
+	default:
+		l.limit += 1 / l.limit
+		if l.limit > float64(l.cfg.Max) {
+			l.limit = float64(l.cfg.Max)
+		}
+	}
+
+	close(l.wake)
+	l.wake = make(chan struct{})
+}
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAdaptiveLimiter_AIMD(t *testing.T) {
+	l := &adaptiveLimiter{
+		cfg:   ConcurrencyLimit{Initial: 8, Min: 2, Max: 10, LatencyThreshold: time.Second},
+		limit: 8,
+		wake:  make(chan struct{}),
+	}
+	release := func(latency time.Duration, status int) {
+		t.Helper()
+		if err := l.acquire(context.Background()); err != nil {
+			t.Fatalf("acquire returned error: %v", err)
+		}
+		l.release(latency, status)
+	}
+
+	release(time.Millisecond, http.StatusServiceUnavailable)
+	if got := l.current(); got != 4 {
+		t.Errorf("expected the limit halved on 503, got %d", got)
+	}
+	release(2*time.Second, http.StatusOK)
+	if got := l.current(); got != 2 {
+		t.Errorf("expected the limit halved on a slow response, got %d", got)
+	}
+	release(time.Millisecond, http.StatusTooManyRequests)
+	if got := l.current(); got != 2 {
+		t.Errorf("expected the limit bounded by Min, got %d", got)
+	}
+	release(time.Millisecond, 0)
+	if got := l.current(); got != 2 {
+		t.Errorf("expected the limit kept without a response, got %d", got)
+	}
+
+	for i := 0; i < 4; i++ {
+		release(time.Millisecond, http.StatusOK)
+	}
+	if got := l.current(); got != 3 {
+		t.Errorf("expected the limit raised additively, got %d", got)
+	}
+	for i := 0; i < 200; i++ {
+		release(time.Millisecond, http.StatusOK)
+	}
+	if got := l.current(); got != 10 {
+		t.Errorf("expected the limit bounded by Max, got %d", got)
+	}
+}
+
+func TestAdaptiveLimiter_acquireCanceled(t *testing.T) {
+	l := &adaptiveLimiter{cfg: ConcurrencyLimit{Initial: 1, Min: 1, Max: 1}, limit: 1, wake: make(chan struct{})}
+	if err := l.acquire(context.Background()); err != nil {
+		t.Fatalf("acquire returned error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.acquire(ctx); err != context.DeadlineExceeded {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestSetAdaptiveConcurrency_capsInFlight(t *testing.T) {
+	c, mux := setup(t, SetAdaptiveConcurrency(ConcurrencyLimit{Initial: 2, Min: 1, Max: 2}))
+
+	var inFlight, maxInFlight int32
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		fmt.Fprint(w, `{"account":{}}`)
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, _, err := c.Account.Get(context.Background()); err != nil {
+				t.Errorf("Account.Get returned error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&maxInFlight); got != 2 {
+		t.Errorf("expected at most 2 requests in flight, got %d", got)
+	}
+}
+
+func TestSetAdaptiveConcurrency_invalid(t *testing.T) {
+	for _, limit := range []ConcurrencyLimit{
+		{},
+		{Initial: 1, Min: 2, Max: 4},
+		{Initial: 5, Min: 1, Max: 4},
+		{Initial: 2, Min: 3, Max: 2},
+	} {
+		if _, err := New(nil, SetAdaptiveConcurrency(limit)); err == nil {
+			t.Errorf("expected an error for %+v", limit)
+		}
+	}
+}
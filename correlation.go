@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+const (
+	defaultCorrelationHeader = "X-Correlation-ID"
+	headerRequestID          = "X-Request-ID"
+)
+
+type correlationIDContextKey struct{}
+
+// WithCorrelationID returns a copy of ctx carrying the correlation ID sent
+// with the requests made with it.
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationIDContextKey{}, id)
+}
+
+// SetCorrelationIDHeader is a client option for setting the header the
+// correlation ID of requests is sent in, X-Correlation-ID by default.
+func SetCorrelationIDHeader(name string) ClientOpt {
+	return func(c *Client) error {
+		if name == "" {
+			return errors.New("correlation ID header name is empty")
+		}
+		c.correlationHeader = http.CanonicalHeaderKey(name)
+		return nil
+	}
+}
+
+// SetCorrelationIDKey is a client option for reading correlation IDs from the
+// context value stored under key, so IDs already carried by an application's
+// tracing can be propagated without calling WithCorrelationID. Values which
+// are not strings or fmt.Stringers are ignored.
+func SetCorrelationIDKey(key any) ClientOpt {
+	return func(c *Client) error {
+		if key == nil {
+			return errors.New("correlation ID context key is nil")
+		}
+		c.correlationKey = key
+		return nil
+	}
+}
+
+// correlationID returns the correlation ID carried by ctx, if any.
+func (c *Client) correlationID(ctx context.Context) string {
+	if id, ok := ctx.Value(correlationIDContextKey{}).(string); ok && id != "" {
+		return id
+	}
+	if c.correlationKey == nil {
+		return ""
+	}
+	switch id := ctx.Value(c.correlationKey).(type) {
+	case string:
+		return id
+	case interface{ String() string }:
+		return id.String()
+	}
+	return ""
+}
+
+func (c *Client) correlationIDHeader() string {
+	if c.correlationHeader == "" {
+		return defaultCorrelationHeader
+	}
+	return c.correlationHeader
+}
+
+// setCorrelationID adds the correlation ID carried by ctx to req unless the
+// caller has set the header already.
+func (c *Client) setCorrelationID(ctx context.Context, req *http.Request) {
+	header := c.correlationIDHeader()
+	if req.Header.Get(header) != "" {
+		return
+	}
+	if id := c.correlationID(ctx); id != "" {
+		req.Header.Set(header, id)
+	}
+}
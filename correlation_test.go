@@ -0,0 +1,101 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestWithCorrelationID(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Correlation-ID", r.Header.Get("X-Correlation-ID"))
+		w.Header().Set("X-Request-ID", "req-1")
+		fmt.Fprint(w, `{"account":{}}`)
+	})
+
+	_, resp, err := c.Account.Get(WithCorrelationID(context.Background(), "corr-1"))
+	if err != nil {
+		t.Fatalf("Account.Get returned error: %v", err)
+	}
+	if resp.CorrelationID != "corr-1" {
+		t.Errorf("expected the correlation ID echoed, got %q", resp.CorrelationID)
+	}
+	if resp.RequestID != "req-1" {
+		t.Errorf("expected request ID req-1, got %q", resp.RequestID)
+	}
+
+	_, resp, err = c.Account.Get(context.Background())
+	if err != nil {
+		t.Fatalf("Account.Get returned error: %v", err)
+	}
+	if resp.CorrelationID != "" {
+		t.Errorf("expected no correlation ID without one in the context, got %q", resp.CorrelationID)
+	}
+}
+
+func TestWithCorrelationID_headerSetByCaller(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Correlation-ID"); got != "mine" {
+			t.Errorf("expected the header of the caller kept, got %q", got)
+		}
+		fmt.Fprint(w, `{"account":{}}`)
+	})
+
+	ctx := WithCorrelationID(context.Background(), "corr-1")
+	req, err := c.NewRequest(ctx, http.MethodGet, "v2/account", nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	req.Header.Set("X-Correlation-ID", "mine")
+	if _, err := c.Do(ctx, req, nil); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+}
+
+type traceKey struct{}
+
+type traceID [2]int
+
+func (id traceID) String() string {
+	return fmt.Sprintf("%d-%d", id[0], id[1])
+}
+
+func TestSetCorrelationIDKey(t *testing.T) {
+	c, mux := setup(t, SetCorrelationIDHeader("x-trace-id"), SetCorrelationIDKey(traceKey{}))
+
+	var got []string
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("X-Trace-Id"))
+		fmt.Fprint(w, `{"account":{}}`)
+	})
+
+	for _, ctx := range []context.Context{
+		context.WithValue(context.Background(), traceKey{}, "abc"),
+		context.WithValue(context.Background(), traceKey{}, traceID{1, 2}),
+		context.WithValue(context.Background(), traceKey{}, 42),
+		WithCorrelationID(context.WithValue(context.Background(), traceKey{}, "abc"), "explicit"),
+	} {
+		if _, _, err := c.Account.Get(ctx); err != nil {
+			t.Fatalf("Account.Get returned error: %v", err)
+		}
+	}
+
+	want := []string{"abc", "1-2", "", "explicit"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("expected correlation IDs %q, got %q", want, got)
+	}
+}
+
+func TestCorrelationOptions_invalid(t *testing.T) {
+	if _, err := New(nil, SetCorrelationIDHeader("")); err == nil {
+		t.Error("expected an error for an empty header name")
+	}
+	if _, err := New(nil, SetCorrelationIDKey(nil)); err == nil {
+		t.Error("expected an error for a nil context key")
+	}
+}
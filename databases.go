@@ -0,0 +1,723 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const databasesBasePath = "v2/databases"
+
+// Engines of database clusters.
+const (
+	DatabaseEnginePostgres = "pg"
+	DatabaseEngineMySQL    = "mysql"
+	DatabaseEngineRedis    = "redis"
+	DatabaseEngineMongoDB  = "mongodb"
+)
+
+// Statuses of database clusters.
+const (
+	DatabaseStatusCreating  = "creating"
+	DatabaseStatusOnline    = "online"
+	DatabaseStatusResizing  = "resizing"
+	DatabaseStatusMigrating = "migrating"
+	DatabaseStatusForking   = "forking"
+)
+
+// Database represents a DigitalOcean managed database cluster.
+type Database struct {
+	ID                 string                     `json:"id,omitempty"`
+	Name               string                     `json:"name,omitempty"`
+	EngineSlug         string                     `json:"engine,omitempty"`
+	VersionSlug        string                     `json:"version,omitempty"`
+	Connection         *DatabaseConnection        `json:"connection,omitempty"`
+	PrivateConnection  *DatabaseConnection        `json:"private_connection,omitempty"`
+	Users              []DatabaseUser             `json:"users,omitempty"`
+	DBNames            []string                   `json:"db_names,omitempty"`
+	NumNodes           int                        `json:"num_nodes,omitempty"`
+	RegionSlug         string                     `json:"region,omitempty"`
+	Status             string                     `json:"status,omitempty"`
+	MaintenanceWindow  *DatabaseMaintenanceWindow `json:"maintenance_window,omitempty"`
+	SizeSlug           string                     `json:"size,omitempty"`
+	PrivateNetworkUUID string                     `json:"private_network_uuid,omitempty"`
+	Tags               []string                   `json:"tags,omitempty"`
+	ProjectID          string                     `json:"project_id,omitempty"`
+	CreatedAt          time.Time                  `json:"created_at,omitempty"`
+}
+
+// URN returns the database cluster in a valid DO API URN form.
+func (d Database) URN() string {
+	return Resource{ID: d.ID, Type: DatabaseResourceType}.URN()
+}
+
+// DatabaseConnection represents a database connection. Clients must connect
+// with TLS when SSL is set.
+type DatabaseConnection struct {
+	URI      string `json:"uri,omitempty"`
+	Database string `json:"database,omitempty"`
+	Host     string `json:"host,omitempty"`
+	Port     int    `json:"port,omitempty"`
+	User     string `json:"user,omitempty"`
+	Password string `json:"password,omitempty"`
+	SSL      bool   `json:"ssl,omitempty"`
+}
+
+// DatabaseCA is the certificate authority of a database cluster, used to
+// verify its certificate when connecting with SSL.
+type DatabaseCA struct {
+	Certificate []byte `json:"certificate"`
+}
+
+// DatabaseMaintenanceWindow represents the maintenance window of a database
+// cluster.
+type DatabaseMaintenanceWindow struct {
+	Day         string   `json:"day,omitempty"`
+	Hour        string   `json:"hour,omitempty"`
+	Pending     bool     `json:"pending,omitempty"`
+	Description []string `json:"description,omitempty"`
+}
+
+// DatabaseBackup represents a database backup.
+type DatabaseBackup struct {
+	CreatedAt     time.Time `json:"created_at,omitempty"`
+	SizeGigabytes float64   `json:"size_gigabytes,omitempty"`
+}
+
+// DatabaseBackupRestore selects the backup a new database cluster is restored
+// from. BackupCreatedAt picks a backup of the named cluster by its creation
+// time, the latest backup is used when it is empty.
+type DatabaseBackupRestore struct {
+	DatabaseName    string `json:"database_name,omitempty"`
+	BackupCreatedAt string `json:"backup_created_at,omitempty"`
+}
+
+// Authentication plugins of MySQL users.
+const (
+	SQLAuthPluginNative      = "mysql_native_password"
+	SQLAuthPluginCachingSHA2 = "caching_sha2_password"
+)
+
+// DatabaseUser represents a user in the database.
+type DatabaseUser struct {
+	Name          string                     `json:"name,omitempty"`
+	Role          string                     `json:"role,omitempty"`
+	Password      string                     `json:"password,omitempty"`
+	MySQLSettings *DatabaseMySQLUserSettings `json:"mysql_settings,omitempty"`
+}
+
+// DatabaseMySQLUserSettings contains MySQL-specific user settings. Older
+// clients may need SQLAuthPluginNative to connect.
+type DatabaseMySQLUserSettings struct {
+	AuthPlugin string `json:"auth_plugin"`
+}
+
+// DatabaseCreateUserRequest is used to create a new database user.
+type DatabaseCreateUserRequest struct {
+	Name          string                     `json:"name"`
+	MySQLSettings *DatabaseMySQLUserSettings `json:"mysql_settings,omitempty"`
+}
+
+// DatabaseResetUserAuthRequest is used to reset a user's MySQL
+// authentication plugin.
+type DatabaseResetUserAuthRequest struct {
+	MySQLSettings *DatabaseMySQLUserSettings `json:"mysql_settings,omitempty"`
+}
+
+// DatabaseDB represents a logical database in a database cluster.
+type DatabaseDB struct {
+	Name string `json:"name"`
+}
+
+// DatabaseCreateDBRequest is used to create a new logical database.
+type DatabaseCreateDBRequest struct {
+	Name string `json:"name"`
+}
+
+// DatabasePool represents a connection pool of a PostgreSQL cluster.
+type DatabasePool struct {
+	User              string              `json:"user"`
+	Name              string              `json:"name"`
+	Size              int                 `json:"size"`
+	Database          string              `json:"db"`
+	Mode              string              `json:"mode"`
+	Connection        *DatabaseConnection `json:"connection"`
+	PrivateConnection *DatabaseConnection `json:"private_connection,omitempty"`
+}
+
+// DatabaseCreatePoolRequest is used to create a new database connection pool.
+// Mode is one of "session", "transaction" or "statement".
+type DatabaseCreatePoolRequest struct {
+	User     string `json:"user"`
+	Name     string `json:"name"`
+	Size     int    `json:"size"`
+	Database string `json:"db"`
+	Mode     string `json:"mode"`
+}
+
+// DatabaseReplica represents a read-only replica of a particular database.
+type DatabaseReplica struct {
+	ID                 string              `json:"id"`
+	Name               string              `json:"name"`
+	Connection         *DatabaseConnection `json:"connection"`
+	PrivateConnection  *DatabaseConnection `json:"private_connection,omitempty"`
+	Region             string              `json:"region"`
+	Status             string              `json:"status"`
+	Size               string              `json:"size,omitempty"`
+	PrivateNetworkUUID string              `json:"private_network_uuid,omitempty"`
+	Tags               []string            `json:"tags,omitempty"`
+	CreatedAt          time.Time           `json:"created_at"`
+}
+
+// DatabaseCreateReplicaRequest is used to create a new read-only replica.
+type DatabaseCreateReplicaRequest struct {
+	Name               string   `json:"name"`
+	Region             string   `json:"region"`
+	Size               string   `json:"size"`
+	PrivateNetworkUUID string   `json:"private_network_uuid,omitempty"`
+	Tags               []string `json:"tags,omitempty"`
+}
+
+// Types of firewall rules, i.e. of the sources trusted by a database cluster.
+const (
+	DatabaseFirewallRuleIPAddr  = "ip_addr"
+	DatabaseFirewallRuleDroplet = "droplet"
+	DatabaseFirewallRuleK8s     = "k8s"
+	DatabaseFirewallRuleTag     = "tag"
+	DatabaseFirewallRuleApp     = "app"
+)
+
+// DatabaseFirewallRule is a rule describing a source allowed to connect to
+// a database cluster. Value is an IP address or CIDR, or the ID or name of a
+// resource of Type.
+type DatabaseFirewallRule struct {
+	UUID        string    `json:"uuid,omitempty"`
+	ClusterUUID string    `json:"cluster_uuid,omitempty"`
+	Type        string    `json:"type"`
+	Value       string    `json:"value"`
+	CreatedAt   time.Time `json:"created_at,omitempty"`
+}
+
+// DatabaseUpdateFirewallRulesRequest is used to set the firewall rules of a
+// database cluster. The rules replace all existing ones.
+type DatabaseUpdateFirewallRulesRequest struct {
+	Rules []*DatabaseFirewallRule `json:"rules"`
+}
+
+// DatabaseCreateRequest represents a request to create a database cluster.
+// Set BackupRestore to create the cluster from a backup of another one.
+type DatabaseCreateRequest struct {
+	Name               string                 `json:"name,omitempty"`
+	EngineSlug         string                 `json:"engine,omitempty"`
+	Version            string                 `json:"version,omitempty"`
+	SizeSlug           string                 `json:"size,omitempty"`
+	Region             string                 `json:"region,omitempty"`
+	NumNodes           int                    `json:"num_nodes,omitempty"`
+	PrivateNetworkUUID string                 `json:"private_network_uuid,omitempty"`
+	Tags               []string               `json:"tags,omitempty"`
+	ProjectID          string                 `json:"project_id,omitempty"`
+	BackupRestore      *DatabaseBackupRestore `json:"backup_restore,omitempty"`
+}
+
+// DatabaseResizeRequest can be used to initiate a database resize operation.
+type DatabaseResizeRequest struct {
+	SizeSlug string `json:"size,omitempty"`
+	NumNodes int    `json:"num_nodes,omitempty"`
+}
+
+// DatabaseMigrateRequest can be used to initiate a database migrate operation.
+type DatabaseMigrateRequest struct {
+	Region             string `json:"region,omitempty"`
+	PrivateNetworkUUID string `json:"private_network_uuid,omitempty"`
+}
+
+// DatabaseUpdateMaintenanceRequest can be used to update the database's
+// maintenance window. Day is a weekday name and Hour is given as "HH:MM" in
+// UTC.
+type DatabaseUpdateMaintenanceRequest struct {
+	Day  string `json:"day,omitempty"`
+	Hour string `json:"hour,omitempty"`
+}
+
+/* SERVICE */
+
+// DatabasesService is an interface for interfacing with the databases endpoints
+// of the DigitalOcean API.
+// See: https://docs.digitalocean.com/reference/api/api-reference/#tag/Databases
+type DatabasesService interface {
+	List(context.Context, *ListOptions) ([]Database, *Response, error)
+	ListAll(context.Context, *ListAllOptions) ([]Database, *Response, error)
+	Get(context.Context, string) (*Database, *Response, error)
+	GetCA(context.Context, string) (*DatabaseCA, *Response, error)
+	Create(context.Context, *DatabaseCreateRequest) (*Database, *Response, error)
+	Delete(context.Context, string) (*Response, error)
+	Resize(context.Context, string, *DatabaseResizeRequest) (*Response, error)
+	Migrate(context.Context, string, *DatabaseMigrateRequest) (*Response, error)
+	UpdateMaintenance(context.Context, string, *DatabaseUpdateMaintenanceRequest) (*Response, error)
+	ListBackups(context.Context, string, *ListOptions) ([]DatabaseBackup, *Response, error)
+
+	GetUser(context.Context, string, string) (*DatabaseUser, *Response, error)
+	ListUsers(context.Context, string, *ListOptions) ([]DatabaseUser, *Response, error)
+	CreateUser(context.Context, string, *DatabaseCreateUserRequest) (*DatabaseUser, *Response, error)
+	DeleteUser(context.Context, string, string) (*Response, error)
+	ResetUserAuth(context.Context, string, string, *DatabaseResetUserAuthRequest) (*DatabaseUser, *Response, error)
+
+	ListDBs(context.Context, string, *ListOptions) ([]DatabaseDB, *Response, error)
+	CreateDB(context.Context, string, *DatabaseCreateDBRequest) (*DatabaseDB, *Response, error)
+	GetDB(context.Context, string, string) (*DatabaseDB, *Response, error)
+	DeleteDB(context.Context, string, string) (*Response, error)
+
+	ListPools(context.Context, string, *ListOptions) ([]DatabasePool, *Response, error)
+	CreatePool(context.Context, string, *DatabaseCreatePoolRequest) (*DatabasePool, *Response, error)
+	GetPool(context.Context, string, string) (*DatabasePool, *Response, error)
+	DeletePool(context.Context, string, string) (*Response, error)
+
+	ListReplicas(context.Context, string, *ListOptions) ([]DatabaseReplica, *Response, error)
+	CreateReplica(context.Context, string, *DatabaseCreateReplicaRequest) (*DatabaseReplica, *Response, error)
+	GetReplica(context.Context, string, string) (*DatabaseReplica, *Response, error)
+	DeleteReplica(context.Context, string, string) (*Response, error)
+	PromoteReplicaToPrimary(context.Context, string, string) (*Response, error)
+
+	GetFirewallRules(context.Context, string) ([]DatabaseFirewallRule, *Response, error)
+	UpdateFirewallRules(context.Context, string, *DatabaseUpdateFirewallRulesRequest) (*Response, error)
+}
+
+// DatabasesServiceOp handles communication with the Databases related methods
+// of the DigitalOcean API.
+type DatabasesServiceOp struct {
+	client *Client
+}
+
+var _ DatabasesService = &DatabasesServiceOp{}
+
+// List returns a list of the Databases visible with the caller's API token.
+func (s *DatabasesServiceOp) List(ctx context.Context, opt *ListOptions) ([]Database, *Response, error) {
+	path, err := addOptions(databasesBasePath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	databases, resp, err := DoEnvelope[[]Database](ctx, s.client, req, "databases")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *databases, resp, err
+}
+
+// ListAll databases across all pages
+func (s *DatabasesServiceOp) ListAll(ctx context.Context, opt *ListAllOptions) ([]Database, *Response, error) {
+	return ListAll[Database](ctx, s.List, opt)
+}
+
+// Get retrieves the details of a database cluster.
+func (s *DatabasesServiceOp) Get(ctx context.Context, databaseID string) (*Database, *Response, error) {
+	path, err := databasePath(databaseID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doDatabase(ctx, req)
+}
+
+// GetCA retrieves the CA certificate of a database cluster.
+func (s *DatabasesServiceOp) GetCA(ctx context.Context, databaseID string) (*DatabaseCA, *Response, error) {
+	path, err := databasePath(databaseID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path+"/ca", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return DoEnvelope[DatabaseCA](ctx, s.client, req, "ca")
+}
+
+// Create creates a database cluster, restoring it from a backup when the
+// request has BackupRestore set.
+func (s *DatabasesServiceOp) Create(ctx context.Context, createRequest *DatabaseCreateRequest) (*Database, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, &ValidationError{Field: "createRequest", Reason: "cannot be nil"}
+	}
+	if createRequest.Name == "" {
+		return nil, nil, &ValidationError{Field: "name", Reason: "must not be empty"}
+	}
+	if createRequest.EngineSlug == "" {
+		return nil, nil, &ValidationError{Field: "engine", Reason: "must not be empty"}
+	}
+	if createRequest.NumNodes <= 0 {
+		return nil, nil, &ValidationError{Field: "num_nodes", Value: fmt.Sprint(createRequest.NumNodes), Reason: "must be positive"}
+	}
+	if restore := createRequest.BackupRestore; restore != nil && restore.DatabaseName == "" {
+		return nil, nil, &ValidationError{Field: "backup_restore.database_name", Reason: "must not be empty"}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, databasesBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doDatabase(ctx, req)
+}
+
+// Delete deletes a database cluster. There is no way to recover a cluster once
+// it has been destroyed.
+func (s *DatabasesServiceOp) Delete(ctx context.Context, databaseID string) (*Response, error) {
+	path, err := databasePath(databaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// Resize resizes a database cluster by number of nodes or size.
+func (s *DatabasesServiceOp) Resize(ctx context.Context, databaseID string, resize *DatabaseResizeRequest) (*Response, error) {
+	if resize == nil {
+		return nil, &ValidationError{Field: "resize", Reason: "cannot be nil"}
+	}
+	if resize.SizeSlug == "" && resize.NumNodes == 0 {
+		return nil, &ValidationError{Field: "resize", Reason: "must set size or num_nodes"}
+	}
+
+	return s.put(ctx, databaseID, "resize", resize)
+}
+
+// Migrate migrates a database cluster to a new region.
+func (s *DatabasesServiceOp) Migrate(ctx context.Context, databaseID string, migrate *DatabaseMigrateRequest) (*Response, error) {
+	if migrate == nil {
+		return nil, &ValidationError{Field: "migrate", Reason: "cannot be nil"}
+	}
+	if migrate.Region == "" {
+		return nil, &ValidationError{Field: "region", Reason: "must not be empty"}
+	}
+
+	return s.put(ctx, databaseID, "migrate", migrate)
+}
+
+// UpdateMaintenance updates the maintenance window on a cluster.
+func (s *DatabasesServiceOp) UpdateMaintenance(ctx context.Context, databaseID string, maintenance *DatabaseUpdateMaintenanceRequest) (*Response, error) {
+	if maintenance == nil {
+		return nil, &ValidationError{Field: "maintenance", Reason: "cannot be nil"}
+	}
+
+	return s.put(ctx, databaseID, "maintenance", maintenance)
+}
+
+// ListBackups returns a list of the current backups of a database. Pass the
+// creation time of one as BackupCreatedAt to Create to restore it.
+func (s *DatabasesServiceOp) ListBackups(ctx context.Context, databaseID string, opt *ListOptions) ([]DatabaseBackup, *Response, error) {
+	return databaseSubList[DatabaseBackup](ctx, s.client, databaseID, "backups", "backups", opt)
+}
+
+// GetUser returns the database user identified by userID.
+func (s *DatabasesServiceOp) GetUser(ctx context.Context, databaseID, userID string) (*DatabaseUser, *Response, error) {
+	return databaseSubDo[DatabaseUser](ctx, s.client, http.MethodGet, databaseID, "users", userID, nil, "user")
+}
+
+// ListUsers returns all database users for the database.
+func (s *DatabasesServiceOp) ListUsers(ctx context.Context, databaseID string, opt *ListOptions) ([]DatabaseUser, *Response, error) {
+	return databaseSubList[DatabaseUser](ctx, s.client, databaseID, "users", "users", opt)
+}
+
+// CreateUser will create a new database user. For MySQL clusters the
+// authentication plugin can be chosen with MySQLSettings.
+func (s *DatabasesServiceOp) CreateUser(ctx context.Context, databaseID string, createUser *DatabaseCreateUserRequest) (*DatabaseUser, *Response, error) {
+	if createUser == nil {
+		return nil, nil, &ValidationError{Field: "createUser", Reason: "cannot be nil"}
+	}
+	if createUser.Name == "" {
+		return nil, nil, &ValidationError{Field: "name", Reason: "must not be empty"}
+	}
+	if err := validateMySQLUserSettings(createUser.MySQLSettings); err != nil {
+		return nil, nil, err
+	}
+
+	return databaseSubDo[DatabaseUser](ctx, s.client, http.MethodPost, databaseID, "users", "", createUser, "user")
+}
+
+// DeleteUser will delete an existing database user.
+func (s *DatabasesServiceOp) DeleteUser(ctx context.Context, databaseID, userID string) (*Response, error) {
+	return databaseSubDelete(ctx, s.client, databaseID, "users", userID)
+}
+
+// ResetUserAuth will reset user authentication of a MySQL user.
+func (s *DatabasesServiceOp) ResetUserAuth(ctx context.Context, databaseID, userID string, resetAuth *DatabaseResetUserAuthRequest) (*DatabaseUser, *Response, error) {
+	if userID == "" {
+		return nil, nil, &ValidationError{Field: "userID", Reason: "must not be empty"}
+	}
+	if resetAuth == nil || resetAuth.MySQLSettings == nil {
+		return nil, nil, &ValidationError{Field: "mysql_settings", Reason: "cannot be nil"}
+	}
+	if err := validateMySQLUserSettings(resetAuth.MySQLSettings); err != nil {
+		return nil, nil, err
+	}
+
+	return databaseSubDo[DatabaseUser](ctx, s.client, http.MethodPost, databaseID, "users", userID+"/reset_auth", resetAuth, "user")
+}
+
+// ListDBs returns all databases for a given database cluster.
+func (s *DatabasesServiceOp) ListDBs(ctx context.Context, databaseID string, opt *ListOptions) ([]DatabaseDB, *Response, error) {
+	return databaseSubList[DatabaseDB](ctx, s.client, databaseID, "dbs", "dbs", opt)
+}
+
+// GetDB returns a single database by name.
+func (s *DatabasesServiceOp) GetDB(ctx context.Context, databaseID, name string) (*DatabaseDB, *Response, error) {
+	return databaseSubDo[DatabaseDB](ctx, s.client, http.MethodGet, databaseID, "dbs", name, nil, "db")
+}
+
+// CreateDB will create a new database.
+func (s *DatabasesServiceOp) CreateDB(ctx context.Context, databaseID string, createDB *DatabaseCreateDBRequest) (*DatabaseDB, *Response, error) {
+	if createDB == nil {
+		return nil, nil, &ValidationError{Field: "createDB", Reason: "cannot be nil"}
+	}
+	if createDB.Name == "" {
+		return nil, nil, &ValidationError{Field: "name", Reason: "must not be empty"}
+	}
+
+	return databaseSubDo[DatabaseDB](ctx, s.client, http.MethodPost, databaseID, "dbs", "", createDB, "db")
+}
+
+// DeleteDB will delete an existing database.
+func (s *DatabasesServiceOp) DeleteDB(ctx context.Context, databaseID, name string) (*Response, error) {
+	return databaseSubDelete(ctx, s.client, databaseID, "dbs", name)
+}
+
+// ListPools returns all connection pools for a given database cluster.
+func (s *DatabasesServiceOp) ListPools(ctx context.Context, databaseID string, opt *ListOptions) ([]DatabasePool, *Response, error) {
+	return databaseSubList[DatabasePool](ctx, s.client, databaseID, "pools", "pools", opt)
+}
+
+// GetPool returns a single database connection pool by name.
+func (s *DatabasesServiceOp) GetPool(ctx context.Context, databaseID, name string) (*DatabasePool, *Response, error) {
+	return databaseSubDo[DatabasePool](ctx, s.client, http.MethodGet, databaseID, "pools", name, nil, "pool")
+}
+
+// CreatePool will create a new database connection pool.
+func (s *DatabasesServiceOp) CreatePool(ctx context.Context, databaseID string, createPool *DatabaseCreatePoolRequest) (*DatabasePool, *Response, error) {
+	if createPool == nil {
+		return nil, nil, &ValidationError{Field: "createPool", Reason: "cannot be nil"}
+	}
+	if createPool.Name == "" {
+		return nil, nil, &ValidationError{Field: "name", Reason: "must not be empty"}
+	}
+	if createPool.Size <= 0 {
+		return nil, nil, &ValidationError{Field: "size", Value: fmt.Sprint(createPool.Size), Reason: "must be positive"}
+	}
+
+	return databaseSubDo[DatabasePool](ctx, s.client, http.MethodPost, databaseID, "pools", "", createPool, "pool")
+}
+
+// DeletePool will delete an existing database connection pool.
+func (s *DatabasesServiceOp) DeletePool(ctx context.Context, databaseID, name string) (*Response, error) {
+	return databaseSubDelete(ctx, s.client, databaseID, "pools", name)
+}
+
+// ListReplicas returns all read-only replicas for a given database cluster.
+func (s *DatabasesServiceOp) ListReplicas(ctx context.Context, databaseID string, opt *ListOptions) ([]DatabaseReplica, *Response, error) {
+	return databaseSubList[DatabaseReplica](ctx, s.client, databaseID, "replicas", "replicas", opt)
+}
+
+// GetReplica returns a single database replica.
+func (s *DatabasesServiceOp) GetReplica(ctx context.Context, databaseID, name string) (*DatabaseReplica, *Response, error) {
+	return databaseSubDo[DatabaseReplica](ctx, s.client, http.MethodGet, databaseID, "replicas", name, nil, "replica")
+}
+
+// CreateReplica will create a new database replica.
+func (s *DatabasesServiceOp) CreateReplica(ctx context.Context, databaseID string, createReplica *DatabaseCreateReplicaRequest) (*DatabaseReplica, *Response, error) {
+	if createReplica == nil {
+		return nil, nil, &ValidationError{Field: "createReplica", Reason: "cannot be nil"}
+	}
+	if createReplica.Name == "" {
+		return nil, nil, &ValidationError{Field: "name", Reason: "must not be empty"}
+	}
+
+	return databaseSubDo[DatabaseReplica](ctx, s.client, http.MethodPost, databaseID, "replicas", "", createReplica, "replica")
+}
+
+// DeleteReplica will delete an existing database replica.
+func (s *DatabasesServiceOp) DeleteReplica(ctx context.Context, databaseID, name string) (*Response, error) {
+	return databaseSubDelete(ctx, s.client, databaseID, "replicas", name)
+}
+
+// PromoteReplicaToPrimary will sever the read replica integration and then
+// promote the replica cluster to be an R/W cluster.
+func (s *DatabasesServiceOp) PromoteReplicaToPrimary(ctx context.Context, databaseID, name string) (*Response, error) {
+	path, err := databaseSubPath(databaseID, "replicas", name)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path+"/promote", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// GetFirewallRules loads the inbound sources for a given cluster.
+func (s *DatabasesServiceOp) GetFirewallRules(ctx context.Context, databaseID string) ([]DatabaseFirewallRule, *Response, error) {
+	return databaseSubList[DatabaseFirewallRule](ctx, s.client, databaseID, "firewall", "rules", nil)
+}
+
+// UpdateFirewallRules sets the inbound sources for a given cluster.
+func (s *DatabasesServiceOp) UpdateFirewallRules(ctx context.Context, databaseID string, firewallRules *DatabaseUpdateFirewallRulesRequest) (*Response, error) {
+	if firewallRules == nil {
+		return nil, &ValidationError{Field: "firewallRules", Reason: "cannot be nil"}
+	}
+	for _, rule := range firewallRules.Rules {
+		if rule == nil || rule.Type == "" || rule.Value == "" {
+			return nil, &ValidationError{Field: "rules", Reason: "must have a type and value"}
+		}
+	}
+
+	return s.put(ctx, databaseID, "firewall", firewallRules)
+}
+
+// put sends body to a sub resource of a database cluster which responds
+// without content.
+func (s *DatabasesServiceOp) put(ctx context.Context, databaseID, resource string, body interface{}) (*Response, error) {
+	path, err := databasePath(databaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path+"/"+resource, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *DatabasesServiceOp) doDatabase(ctx context.Context, req *http.Request) (*Database, *Response, error) {
+	database, resp, err := DoEnvelope[Database](ctx, s.client, req, "database")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return database, resp, err
+}
+
+// databaseSubList fetches a page of a collection below a database cluster,
+// e.g. /v2/databases/1/users, stored under key.
+func databaseSubList[T any](ctx context.Context, c *Client, databaseID, resource, key string, opt *ListOptions) ([]T, *Response, error) {
+	path, err := databaseSubPath(databaseID, resource, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	path, err = addOptions(path, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	items, resp, err := DoEnvelope[[]T](ctx, c, req, key)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *items, resp, err
+}
+
+// databaseSubDo sends a request for the named item of a collection below a
+// database cluster, or the collection itself when name is empty, and decodes
+// the item stored under key.
+func databaseSubDo[T any](ctx context.Context, c *Client, method, databaseID, resource, name string, body interface{}, key string) (*T, *Response, error) {
+	path, err := databaseSubPath(databaseID, resource, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	if name == "" && method != http.MethodPost {
+		return nil, nil, &ValidationError{Field: "name", Reason: "must not be empty"}
+	}
+
+	req, err := c.NewRequest(ctx, method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	item, resp, err := DoEnvelope[T](ctx, c, req, key)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item, resp, err
+}
+
+// databaseSubDelete deletes the named item of a collection below a database
+// cluster.
+func databaseSubDelete(ctx context.Context, c *Client, databaseID, resource, name string) (*Response, error) {
+	if name == "" {
+		return nil, &ValidationError{Field: "name", Reason: "must not be empty"}
+	}
+	path, err := databaseSubPath(databaseID, resource, name)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Do(ctx, req, nil)
+}
+
+// databaseSubPath returns the path of a collection below a database cluster,
+// or of the named item in it.
+func databaseSubPath(databaseID, resource, name string) (string, error) {
+	path, err := databasePath(databaseID)
+	if err != nil {
+		return "", err
+	}
+	path += "/" + resource
+	if name != "" {
+		path += "/" + name
+	}
+	return path, nil
+}
+
+// validateMySQLUserSettings checks the authentication plugin of a MySQL user.
+func validateMySQLUserSettings(settings *DatabaseMySQLUserSettings) error {
+	if settings == nil {
+		return nil
+	}
+	switch settings.AuthPlugin {
+	case SQLAuthPluginNative, SQLAuthPluginCachingSHA2:
+		return nil
+	}
+	return &ValidationError{Field: "auth_plugin", Value: settings.AuthPlugin, Reason: fmt.Sprintf("must be %s or %s", SQLAuthPluginNative, SQLAuthPluginCachingSHA2)}
+}
+
+// databasePath returns the path of a database cluster.
+func databasePath(databaseID string) (string, error) {
+	if databaseID == "" {
+		return "", &ValidationError{Field: "databaseID", Reason: "must not be empty"}
+	}
+	return fmt.Sprintf("%s/%s", databasesBasePath, databaseID), nil
+}
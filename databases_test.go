@@ -0,0 +1,438 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestDatabases_List(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/databases", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"databases":[{"id":"9cc10173","name":"backend","engine":"pg","num_nodes":2,"status":"online","connection":{"host":"db.example.com","port":25060,"ssl":true},"maintenance_window":{"day":"tuesday","hour":"08:00:00"}}]}`)
+	})
+
+	databases, _, err := c.Databases.List(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Databases.List returned error: %v", err)
+	}
+	if len(databases) != 1 {
+		t.Fatalf("got databases %+v", databases)
+	}
+	db := databases[0]
+	if db.EngineSlug != DatabaseEnginePostgres || db.Status != DatabaseStatusOnline || db.NumNodes != 2 {
+		t.Errorf("got database %+v", db)
+	}
+	if db.Connection == nil || db.Connection.Port != 25060 || !db.Connection.SSL {
+		t.Errorf("got connection %+v", db.Connection)
+	}
+	if db.MaintenanceWindow == nil || db.MaintenanceWindow.Day != "tuesday" {
+		t.Errorf("got maintenance window %+v", db.MaintenanceWindow)
+	}
+	if got := db.URN(); got != "do:dbaas:9cc10173" {
+		t.Errorf("got URN %q", got)
+	}
+}
+
+func TestDatabases_GetCA(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/databases/9cc10173/ca", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"ca":{"certificate":"LS0tLS1CRUdJTg=="}}`)
+	})
+
+	ca, _, err := c.Databases.GetCA(context.Background(), "9cc10173")
+	if err != nil {
+		t.Fatalf("Databases.GetCA returned error: %v", err)
+	}
+	if string(ca.Certificate) != "-----BEGIN" {
+		t.Errorf("got certificate %q", ca.Certificate)
+	}
+}
+
+func TestDatabases_Create(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/databases", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var req DatabaseCreateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if req.Name != "backend-restored" || req.BackupRestore == nil || req.BackupRestore.DatabaseName != "backend" {
+			t.Errorf("got request %+v", req)
+		}
+		fmt.Fprint(w, `{"database":{"id":"9cc10173","status":"creating"}}`)
+	})
+
+	db, _, err := c.Databases.Create(context.Background(), &DatabaseCreateRequest{
+		Name:          "backend-restored",
+		EngineSlug:    DatabaseEnginePostgres,
+		SizeSlug:      "db-s-2vcpu-4gb",
+		Region:        "nyc3",
+		NumNodes:      2,
+		BackupRestore: &DatabaseBackupRestore{DatabaseName: "backend", BackupCreatedAt: "2024-01-02T03:04:05Z"},
+	})
+	if err != nil {
+		t.Fatalf("Databases.Create returned error: %v", err)
+	}
+	if db.Status != DatabaseStatusCreating {
+		t.Errorf("got database %+v", db)
+	}
+}
+
+func TestDatabases_GetDelete(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/databases/9cc10173", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"database":{"id":"9cc10173","db_names":["defaultdb"]}}`)
+	})
+
+	db, _, err := c.Databases.Get(context.Background(), "9cc10173")
+	if err != nil {
+		t.Fatalf("Databases.Get returned error: %v", err)
+	}
+	if fmt.Sprint(db.DBNames) != "[defaultdb]" {
+		t.Errorf("got database %+v", db)
+	}
+	if _, err := c.Databases.Delete(context.Background(), "9cc10173"); err != nil {
+		t.Errorf("Databases.Delete returned error: %v", err)
+	}
+}
+
+func TestDatabases_put(t *testing.T) {
+	c, mux := setup(t)
+
+	got := make(map[string]map[string]interface{})
+	for _, resource := range []string{"resize", "migrate", "maintenance"} {
+		resource := resource
+		mux.HandleFunc("/v2/databases/9cc10173/"+resource, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPut)
+			var req map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("decoding request body: %v", err)
+			}
+			got[resource] = req
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+
+	ctx := context.Background()
+	if _, err := c.Databases.Resize(ctx, "9cc10173", &DatabaseResizeRequest{SizeSlug: "db-s-4vcpu-8gb", NumNodes: 3}); err != nil {
+		t.Fatalf("Databases.Resize returned error: %v", err)
+	}
+	if _, err := c.Databases.Migrate(ctx, "9cc10173", &DatabaseMigrateRequest{Region: "ams3"}); err != nil {
+		t.Fatalf("Databases.Migrate returned error: %v", err)
+	}
+	if _, err := c.Databases.UpdateMaintenance(ctx, "9cc10173", &DatabaseUpdateMaintenanceRequest{Day: "sunday", Hour: "03:00"}); err != nil {
+		t.Fatalf("Databases.UpdateMaintenance returned error: %v", err)
+	}
+
+	want := map[string]map[string]interface{}{
+		"resize":      {"size": "db-s-4vcpu-8gb", "num_nodes": 3.0},
+		"migrate":     {"region": "ams3"},
+		"maintenance": {"day": "sunday", "hour": "03:00"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected requests %v, got %v", want, got)
+	}
+}
+
+func TestDatabases_ListBackups(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/databases/9cc10173/backups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"backups":[{"created_at":"2024-01-02T03:04:05Z","size_gigabytes":0.25}]}`)
+	})
+
+	backups, _, err := c.Databases.ListBackups(context.Background(), "9cc10173", nil)
+	if err != nil {
+		t.Fatalf("Databases.ListBackups returned error: %v", err)
+	}
+	if len(backups) != 1 || backups[0].SizeGigabytes != 0.25 || backups[0].CreatedAt.Year() != 2024 {
+		t.Errorf("got backups %+v", backups)
+	}
+}
+
+func TestDatabases_validation(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+	d := c.Databases
+	valid := DatabaseCreateRequest{Name: "backend", EngineSlug: DatabaseEnginePostgres, NumNodes: 1}
+
+	calls := map[string]func() error{
+		"get empty id":   func() error { _, _, err := d.Get(ctx, ""); return err },
+		"ca empty id":    func() error { _, _, err := d.GetCA(ctx, ""); return err },
+		"create nil":     func() error { _, _, err := d.Create(ctx, nil); return err },
+		"create no name": func() error { r := valid; r.Name = ""; _, _, err := d.Create(ctx, &r); return err },
+		"create engine":  func() error { r := valid; r.EngineSlug = ""; _, _, err := d.Create(ctx, &r); return err },
+		"create nodes":   func() error { r := valid; r.NumNodes = 0; _, _, err := d.Create(ctx, &r); return err },
+		"create restore": func() error {
+			r := valid
+			r.BackupRestore = &DatabaseBackupRestore{}
+			_, _, err := d.Create(ctx, &r)
+			return err
+		},
+		"delete empty id":   func() error { _, err := d.Delete(ctx, ""); return err },
+		"resize nil":        func() error { _, err := d.Resize(ctx, "9cc10173", nil); return err },
+		"resize empty":      func() error { _, err := d.Resize(ctx, "9cc10173", &DatabaseResizeRequest{}); return err },
+		"migrate no region": func() error { _, err := d.Migrate(ctx, "9cc10173", &DatabaseMigrateRequest{}); return err },
+		"maintenance nil":   func() error { _, err := d.UpdateMaintenance(ctx, "9cc10173", nil); return err },
+		"backups empty id":  func() error { _, _, err := d.ListBackups(ctx, "", nil); return err },
+	}
+	for name, call := range calls {
+		var verr *ValidationError
+		if err := call(); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
+
+func TestDatabases_users(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+
+	mux.HandleFunc("/v2/databases/9cc10173/users", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			var req DatabaseCreateUserRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("decoding request body: %v", err)
+			}
+			if req.Name != "app" || req.MySQLSettings == nil || req.MySQLSettings.AuthPlugin != SQLAuthPluginNative {
+				t.Errorf("got request %+v", req)
+			}
+			fmt.Fprint(w, `{"user":{"name":"app","role":"normal","password":"secret"}}`)
+			return
+		}
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"users":[{"name":"doadmin","role":"primary"},{"name":"app","role":"normal"}]}`)
+	})
+	mux.HandleFunc("/v2/databases/9cc10173/users/app", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"user":{"name":"app","role":"normal"}}`)
+	})
+	mux.HandleFunc("/v2/databases/9cc10173/users/app/reset_auth", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"user":{"name":"app","mysql_settings":{"auth_plugin":"caching_sha2_password"}}}`)
+	})
+
+	user, _, err := c.Databases.CreateUser(ctx, "9cc10173", &DatabaseCreateUserRequest{Name: "app", MySQLSettings: &DatabaseMySQLUserSettings{AuthPlugin: SQLAuthPluginNative}})
+	if err != nil {
+		t.Fatalf("Databases.CreateUser returned error: %v", err)
+	}
+	if user.Password != "secret" {
+		t.Errorf("got user %+v", user)
+	}
+
+	users, _, err := c.Databases.ListUsers(ctx, "9cc10173", nil)
+	if err != nil {
+		t.Fatalf("Databases.ListUsers returned error: %v", err)
+	}
+	if len(users) != 2 || users[0].Role != "primary" {
+		t.Errorf("got users %+v", users)
+	}
+
+	if user, _, err = c.Databases.GetUser(ctx, "9cc10173", "app"); err != nil || user.Role != "normal" {
+		t.Errorf("Databases.GetUser returned %+v, %v", user, err)
+	}
+
+	user, _, err = c.Databases.ResetUserAuth(ctx, "9cc10173", "app", &DatabaseResetUserAuthRequest{MySQLSettings: &DatabaseMySQLUserSettings{AuthPlugin: SQLAuthPluginCachingSHA2}})
+	if err != nil {
+		t.Fatalf("Databases.ResetUserAuth returned error: %v", err)
+	}
+	if user.MySQLSettings == nil || user.MySQLSettings.AuthPlugin != SQLAuthPluginCachingSHA2 {
+		t.Errorf("got user %+v", user)
+	}
+
+	if _, err := c.Databases.DeleteUser(ctx, "9cc10173", "app"); err != nil {
+		t.Errorf("Databases.DeleteUser returned error: %v", err)
+	}
+}
+
+func TestDatabases_subResources(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+
+	var got []string
+	for _, resource := range []struct{ path, item, list string }{
+		{"dbs", `"db":{"name":"orders"}`, `"dbs":[{"name":"orders"}]`},
+		{"pools", `"pool":{"name":"orders","size":10,"mode":"transaction"}`, `"pools":[{"name":"orders","size":10}]`},
+		{"replicas", `"replica":{"id":"326f188b","name":"read-nyc3"}`, `"replicas":[{"id":"326f188b","name":"read-nyc3"}]`},
+	} {
+		resource := resource
+		mux.HandleFunc("/v2/databases/9cc10173/"+resource.path, func(w http.ResponseWriter, r *http.Request) {
+			got = append(got, r.Method+" "+resource.path)
+			if r.Method == http.MethodPost {
+				fmt.Fprintf(w, "{%s}", resource.item)
+				return
+			}
+			fmt.Fprintf(w, "{%s}", resource.list)
+		})
+		mux.HandleFunc("/v2/databases/9cc10173/"+resource.path+"/", func(w http.ResponseWriter, r *http.Request) {
+			got = append(got, r.Method+" "+r.URL.Path[len("/v2/databases/9cc10173/"):])
+			if r.Method == http.MethodDelete || r.Method == http.MethodPut {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			fmt.Fprintf(w, "{%s}", resource.item)
+		})
+	}
+
+	if _, _, err := c.Databases.CreateDB(ctx, "9cc10173", &DatabaseCreateDBRequest{Name: "orders"}); err != nil {
+		t.Fatalf("Databases.CreateDB returned error: %v", err)
+	}
+	if dbs, _, err := c.Databases.ListDBs(ctx, "9cc10173", nil); err != nil || len(dbs) != 1 {
+		t.Fatalf("Databases.ListDBs returned %+v, %v", dbs, err)
+	}
+	if db, _, err := c.Databases.GetDB(ctx, "9cc10173", "orders"); err != nil || db.Name != "orders" {
+		t.Fatalf("Databases.GetDB returned %+v, %v", db, err)
+	}
+	if _, err := c.Databases.DeleteDB(ctx, "9cc10173", "orders"); err != nil {
+		t.Fatalf("Databases.DeleteDB returned error: %v", err)
+	}
+
+	pool, _, err := c.Databases.CreatePool(ctx, "9cc10173", &DatabaseCreatePoolRequest{Name: "orders", User: "app", Size: 10, Database: "orders", Mode: "transaction"})
+	if err != nil {
+		t.Fatalf("Databases.CreatePool returned error: %v", err)
+	}
+	if pool.Size != 10 || pool.Mode != "transaction" {
+		t.Errorf("got pool %+v", pool)
+	}
+	if pools, _, err := c.Databases.ListPools(ctx, "9cc10173", nil); err != nil || len(pools) != 1 {
+		t.Fatalf("Databases.ListPools returned %+v, %v", pools, err)
+	}
+	if _, _, err := c.Databases.GetPool(ctx, "9cc10173", "orders"); err != nil {
+		t.Fatalf("Databases.GetPool returned error: %v", err)
+	}
+	if _, err := c.Databases.DeletePool(ctx, "9cc10173", "orders"); err != nil {
+		t.Fatalf("Databases.DeletePool returned error: %v", err)
+	}
+
+	if _, _, err := c.Databases.CreateReplica(ctx, "9cc10173", &DatabaseCreateReplicaRequest{Name: "read-nyc3", Region: "nyc3", Size: "db-s-2vcpu-4gb"}); err != nil {
+		t.Fatalf("Databases.CreateReplica returned error: %v", err)
+	}
+	if replicas, _, err := c.Databases.ListReplicas(ctx, "9cc10173", nil); err != nil || replicas[0].ID != "326f188b" {
+		t.Fatalf("Databases.ListReplicas returned %+v, %v", replicas, err)
+	}
+	if _, _, err := c.Databases.GetReplica(ctx, "9cc10173", "read-nyc3"); err != nil {
+		t.Fatalf("Databases.GetReplica returned error: %v", err)
+	}
+	if _, err := c.Databases.PromoteReplicaToPrimary(ctx, "9cc10173", "read-nyc3"); err != nil {
+		t.Fatalf("Databases.PromoteReplicaToPrimary returned error: %v", err)
+	}
+	if _, err := c.Databases.DeleteReplica(ctx, "9cc10173", "read-nyc3"); err != nil {
+		t.Fatalf("Databases.DeleteReplica returned error: %v", err)
+	}
+
+	want := fmt.Sprint([]string{
+		"POST dbs", "GET dbs", "GET dbs/orders", "DELETE dbs/orders",
+		"POST pools", "GET pools", "GET pools/orders", "DELETE pools/orders",
+		"POST replicas", "GET replicas", "GET replicas/read-nyc3", "PUT replicas/read-nyc3/promote", "DELETE replicas/read-nyc3",
+	})
+	if fmt.Sprint(got) != want {
+		t.Errorf("expected %s, got %v", want, got)
+	}
+}
+
+func TestDatabases_firewall(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/databases/9cc10173/firewall", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			var req DatabaseUpdateFirewallRulesRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("decoding request body: %v", err)
+			}
+			if len(req.Rules) != 2 || req.Rules[1].Type != DatabaseFirewallRuleTag {
+				t.Errorf("got request %+v", req)
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"rules":[{"uuid":"79f26d28","type":"ip_addr","value":"192.0.2.0/24"}]}`)
+	})
+
+	rules, _, err := c.Databases.GetFirewallRules(context.Background(), "9cc10173")
+	if err != nil {
+		t.Fatalf("Databases.GetFirewallRules returned error: %v", err)
+	}
+	if len(rules) != 1 || rules[0].Type != DatabaseFirewallRuleIPAddr || rules[0].Value != "192.0.2.0/24" {
+		t.Errorf("got rules %+v", rules)
+	}
+
+	_, err = c.Databases.UpdateFirewallRules(context.Background(), "9cc10173", &DatabaseUpdateFirewallRulesRequest{Rules: []*DatabaseFirewallRule{
+		{Type: DatabaseFirewallRuleIPAddr, Value: "192.0.2.0/24"},
+		{Type: DatabaseFirewallRuleTag, Value: "backend"},
+	}})
+	if err != nil {
+		t.Errorf("Databases.UpdateFirewallRules returned error: %v", err)
+	}
+}
+
+func TestDatabases_subResources_validation(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+	d := c.Databases
+	plugin := &DatabaseMySQLUserSettings{AuthPlugin: "sha256_password"}
+
+	calls := map[string]func() error{
+		"get user empty":   func() error { _, _, err := d.GetUser(ctx, "9cc10173", ""); return err },
+		"users no cluster": func() error { _, _, err := d.ListUsers(ctx, "", nil); return err },
+		"create user nil":  func() error { _, _, err := d.CreateUser(ctx, "9cc10173", nil); return err },
+		"create user name": func() error { _, _, err := d.CreateUser(ctx, "9cc10173", &DatabaseCreateUserRequest{}); return err },
+		"create user auth": func() error {
+			_, _, err := d.CreateUser(ctx, "9cc10173", &DatabaseCreateUserRequest{Name: "app", MySQLSettings: plugin})
+			return err
+		},
+		"delete user empty": func() error { _, err := d.DeleteUser(ctx, "9cc10173", ""); return err },
+		"reset auth empty":  func() error { _, _, err := d.ResetUserAuth(ctx, "9cc10173", "", nil); return err },
+		"reset auth nil":    func() error { _, _, err := d.ResetUserAuth(ctx, "9cc10173", "app", nil); return err },
+		"reset auth plugin": func() error {
+			_, _, err := d.ResetUserAuth(ctx, "9cc10173", "app", &DatabaseResetUserAuthRequest{MySQLSettings: plugin})
+			return err
+		},
+		"create db nil":   func() error { _, _, err := d.CreateDB(ctx, "9cc10173", nil); return err },
+		"create db name":  func() error { _, _, err := d.CreateDB(ctx, "9cc10173", &DatabaseCreateDBRequest{}); return err },
+		"get db empty":    func() error { _, _, err := d.GetDB(ctx, "9cc10173", ""); return err },
+		"create pool nil": func() error { _, _, err := d.CreatePool(ctx, "9cc10173", nil); return err },
+		"create pool size": func() error {
+			_, _, err := d.CreatePool(ctx, "9cc10173", &DatabaseCreatePoolRequest{Name: "orders"})
+			return err
+		},
+		"delete pool": func() error { _, err := d.DeletePool(ctx, "9cc10173", ""); return err },
+		"create replica": func() error {
+			_, _, err := d.CreateReplica(ctx, "9cc10173", &DatabaseCreateReplicaRequest{})
+			return err
+		},
+		"promote empty": func() error { _, err := d.PromoteReplicaToPrimary(ctx, "", "read-nyc3"); return err },
+		"firewall nil":  func() error { _, err := d.UpdateFirewallRules(ctx, "9cc10173", nil); return err },
+		"firewall rule": func() error {
+			_, err := d.UpdateFirewallRules(ctx, "9cc10173", &DatabaseUpdateFirewallRulesRequest{Rules: []*DatabaseFirewallRule{{Type: DatabaseFirewallRuleTag}}})
+			return err
+		},
+	}
+	for name, call := range calls {
+		var verr *ValidationError
+		if err := call(); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
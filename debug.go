@@ -0,0 +1,98 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"sync"
+)
+
+// debugMu serializes dumps so those of concurrent requests do not interleave.
+var debugMu sync.Mutex
+
+// SetDebug is a client option which dumps every request and response,
+// including bodies, to the debug writer (os.Stderr unless set with
+// SetDebugWriter). Authorization headers and token-like JSON fields are
+// redacted by the Redactor of the client. Response bodies are read into
+// memory to dump them, up to the size set with SetMaxResponseBodySize.
+func SetDebug(debug bool) ClientOpt {
+	return func(c *Client) error {
+		c.debug = debug
+		return nil
+	}
+}
+
+// SetDebugWriter is a client option setting where debug dumps are written.
+func SetDebugWriter(w io.Writer) ClientOpt {
+	return func(c *Client) error {
+		c.debugWriter = w
+		return nil
+	}
+}
+
+// dumpRequest writes the sanitized dump of req to the debug writer.
+func (c *Client) dumpRequest(req *http.Request) {
+	if !c.debug {
+		return
+	}
+	dump, err := httputil.DumpRequestOut(req, true)
+	c.writeDump("request", dump, err)
+}
+
+// dumpResponse writes the sanitized dump of resp to the debug writer.
+func (c *Client) dumpResponse(resp *http.Response) {
+	if !c.debug {
+		return
+	}
+	limit := c.maxResponseBodySize
+	if limit <= 0 {
+		dump, err := httputil.DumpResponse(resp, true)
+		c.writeDump("response", dump, err)
+		return
+	}
+
+	dump, err := httputil.DumpResponse(resp, false)
+	if err != nil {
+		c.writeDump("response", nil, err)
+		return
+	}
+
+	// read at most one byte over the limit and put it back for decoding,
+	// which reports the body as too large
+	body, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+	if err != nil {
+		c.writeDump("response", nil, err)
+		return
+	}
+
+	if int64(len(body)) > limit {
+		dump = append(dump, body[:limit]...)
+		dump = append(dump, fmt.Sprintf("\n[body truncated at %d bytes]", limit)...)
+	} else {
+		dump = append(dump, body...)
+	}
+	c.writeDump("response", dump, nil)
+}
+
+func (c *Client) writeDump(kind string, dump []byte, err error) {
+	w := c.debugWriter
+	if w == nil {
+		w = os.Stderr
+	}
+
+	debugMu.Lock()
+	defer debugMu.Unlock()
+
+	if err != nil {
+		fmt.Fprintf(w, "---[ %s dump failed: %v ]---\n", kind, err)
+		return
+	}
+	fmt.Fprintf(w, "---[ %s ]---\n%s\n", kind, c.redactor.Redact(dump))
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestDebug_responseDumpHonorsBodyLimit(t *testing.T) {
+	var dump bytes.Buffer
+	c, mux := setup(t, SetDebug(true), SetDebugWriter(&dump), SetMaxResponseBodySize(32))
+
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"account":{"uuid":"abc"}}`)
+	})
+	mux.HandleFunc("/v2/large", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"value":"`)
+		w.(http.Flusher).Flush()
+		fmt.Fprint(w, strings.Repeat("x", 64)+`"}`)
+	})
+
+	account, _, err := c.Account.Get(context.Background())
+	if err != nil {
+		t.Fatalf("Account.Get returned error: %v", err)
+	}
+	if account.UUID != "abc" {
+		t.Errorf("expected the dumped body to be decoded, got %+v", account)
+	}
+	if !strings.Contains(dump.String(), `{"account":{"uuid":"abc"}}`) {
+		t.Errorf("expected the body in the dump, got %q", dump.String())
+	}
+
+	dump.Reset()
+	req, err := c.NewRequest(context.Background(), http.MethodGet, "v2/large", nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	_, err = c.Do(context.Background(), req, new(map[string]string))
+	var terr *ResponseTooLargeError
+	if !errors.As(err, &terr) {
+		t.Fatalf("expected *ResponseTooLargeError, got %v", err)
+	}
+	if !strings.Contains(dump.String(), "[body truncated at 32 bytes]") {
+		t.Errorf("expected a truncated dump, got %q", dump.String())
+	}
+	if strings.Contains(dump.String(), strings.Repeat("x", 32)) {
+		t.Errorf("expected the dump to stop at the limit, got %q", dump.String())
+	}
+}
@@ -0,0 +1,184 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// DomainRecord represents a DigitalOcean DomainRecord
+type DomainRecord struct {
+	ID       int    `json:"id,omitempty"`
+	Type     string `json:"type,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Data     string `json:"data,omitempty"`
+	Priority int    `json:"priority"`
+	Port     int    `json:"port"`
+	TTL      int    `json:"ttl,omitempty"`
+	Weight   int    `json:"weight"`
+	Flags    int    `json:"flags"`
+	Tag      string `json:"tag,omitempty"`
+}
+
+// DomainRecordEditRequest represents a request to create or update a domain
+// record.
+type DomainRecordEditRequest struct {
+	Type     string `json:"type,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Data     string `json:"data,omitempty"`
+	Priority int    `json:"priority"`
+	Port     int    `json:"port"`
+	TTL      int    `json:"ttl,omitempty"`
+	Weight   int    `json:"weight"`
+	Flags    int    `json:"flags"`
+	Tag      string `json:"tag,omitempty"`
+}
+
+/* SERVICE */
+
+// DomainRecordsService is an interface for managing the DNS records of
+// domains with the DigitalOcean API.
+type DomainRecordsService interface {
+	List(context.Context, string, *ListOptions) ([]DomainRecord, *Response, error)
+	ListAll(context.Context, string, *ListAllOptions) ([]DomainRecord, *Response, error)
+	Get(context.Context, string, int) (*DomainRecord, *Response, error)
+	Create(context.Context, string, *DomainRecordEditRequest) (*DomainRecord, *Response, error)
+	Update(context.Context, string, int, *DomainRecordEditRequest) (*DomainRecord, *Response, error)
+	Delete(context.Context, string, int) (*Response, error)
+}
+
+// DomainRecordsServiceOp handles communication with the domain record related
+// methods of the DigitalOcean API.
+type DomainRecordsServiceOp struct {
+	client *Client
+}
+
+var _ DomainRecordsService = &DomainRecordsServiceOp{}
+
+// List the records of a domain. Records can be filtered by record type with
+// ListOptions.Type, e.g. "A", and by fully qualified name with
+// ListOptions.Name.
+func (s *DomainRecordsServiceOp) List(ctx context.Context, domain string, opt *ListOptions) ([]DomainRecord, *Response, error) {
+	if domain == "" {
+		return nil, nil, &ValidationError{Field: "domain", Reason: "must not be empty"}
+	}
+
+	path := domainRecordsPath(domain)
+	path, err := addOptions(path, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	records, resp, err := DoEnvelope[[]DomainRecord](ctx, s.client, req, "domain_records")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *records, resp, err
+}
+
+// ListAll the records of a domain across all pages
+func (s *DomainRecordsServiceOp) ListAll(ctx context.Context, domain string, opt *ListAllOptions) ([]DomainRecord, *Response, error) {
+	return ListAll[DomainRecord](ctx, func(ctx context.Context, opt *ListOptions) ([]DomainRecord, *Response, error) {
+		return s.List(ctx, domain, opt)
+	}, opt)
+}
+
+// Get a single record of a domain.
+func (s *DomainRecordsServiceOp) Get(ctx context.Context, domain string, id int) (*DomainRecord, *Response, error) {
+	if err := validateDomainRecord(domain, id); err != nil {
+		return nil, nil, err
+	}
+
+	path := fmt.Sprintf("%s/%d", domainRecordsPath(domain), id)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doRecord(ctx, req)
+}
+
+// Create a record for a domain.
+func (s *DomainRecordsServiceOp) Create(ctx context.Context, domain string, createRequest *DomainRecordEditRequest) (*DomainRecord, *Response, error) {
+	if domain == "" {
+		return nil, nil, &ValidationError{Field: "domain", Reason: "must not be empty"}
+	}
+	if createRequest == nil {
+		return nil, nil, &ValidationError{Field: "createRequest", Reason: "cannot be nil"}
+	}
+	if createRequest.Type == "" {
+		return nil, nil, &ValidationError{Field: "type", Reason: "must not be empty"}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, domainRecordsPath(domain), createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doRecord(ctx, req)
+}
+
+// Update a record of a domain.
+func (s *DomainRecordsServiceOp) Update(ctx context.Context, domain string, id int, editRequest *DomainRecordEditRequest) (*DomainRecord, *Response, error) {
+	if err := validateDomainRecord(domain, id); err != nil {
+		return nil, nil, err
+	}
+	if editRequest == nil {
+		return nil, nil, &ValidationError{Field: "editRequest", Reason: "cannot be nil"}
+	}
+
+	path := fmt.Sprintf("%s/%d", domainRecordsPath(domain), id)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, editRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doRecord(ctx, req)
+}
+
+// Delete a record of a domain.
+func (s *DomainRecordsServiceOp) Delete(ctx context.Context, domain string, id int) (*Response, error) {
+	if err := validateDomainRecord(domain, id); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("%s/%d", domainRecordsPath(domain), id)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *DomainRecordsServiceOp) doRecord(ctx context.Context, req *http.Request) (*DomainRecord, *Response, error) {
+	record, resp, err := DoEnvelope[DomainRecord](ctx, s.client, req, "domain_record")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, err
+}
+
+func domainRecordsPath(domain string) string {
+	return fmt.Sprintf("%s/%s/records", domainsBasePath, domain)
+}
+
+func validateDomainRecord(domain string, id int) error {
+	if domain == "" {
+		return &ValidationError{Field: "domain", Reason: "must not be empty"}
+	}
+	if id < 1 {
+		return &ValidationError{Field: "id", Reason: "must be positive"}
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestDomainRecords_List(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/domains/example.com/records", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		q := r.URL.Query()
+		if q.Get("type") != "A" || q.Get("name") != "www.example.com" {
+			t.Errorf("expected records filtered by type and name, got %q", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"domain_records":[{"id":1,"type":"A","name":"www","data":"192.0.2.1","ttl":3600}]}`)
+	})
+
+	records, _, err := c.DomainRecords.List(context.Background(), "example.com", &ListOptions{Type: "A", Name: "www.example.com"})
+	if err != nil {
+		t.Fatalf("DomainRecords.List returned error: %v", err)
+	}
+	if len(records) != 1 || records[0].Data != "192.0.2.1" || records[0].TTL != 3600 {
+		t.Errorf("got records %+v", records)
+	}
+
+	var verr *ValidationError
+	if _, _, err := c.DomainRecords.List(context.Background(), "", nil); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for an empty domain, got %v", err)
+	}
+}
+
+func TestDomainRecords_ListAll(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/domains/example.com/records", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"domain_records":[{"id":2}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"domain_records":[{"id":1}],"links":{"pages":{"next":"https://api.example.com/v2/domains/example.com/records?page=2"}}}`)
+	})
+
+	records, _, err := c.DomainRecords.ListAll(context.Background(), "example.com", nil)
+	if err != nil {
+		t.Fatalf("DomainRecords.ListAll returned error: %v", err)
+	}
+	if len(records) != 2 || records[1].ID != 2 {
+		t.Errorf("got records %+v", records)
+	}
+}
+
+func TestDomainRecords_Get(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/domains/example.com/records/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"domain_record":{"id":1,"type":"MX","data":"mail.example.com.","priority":10}}`)
+	})
+
+	record, _, err := c.DomainRecords.Get(context.Background(), "example.com", 1)
+	if err != nil {
+		t.Fatalf("DomainRecords.Get returned error: %v", err)
+	}
+	if record.Type != "MX" || record.Priority != 10 {
+		t.Errorf("got record %+v", record)
+	}
+}
+
+func TestDomainRecords_CreateUpdate(t *testing.T) {
+	c, mux := setup(t)
+
+	var got []string
+	handle := func(w http.ResponseWriter, r *http.Request) {
+		var req DomainRecordEditRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		got = append(got, fmt.Sprintf("%s %s %s %s", r.Method, req.Type, req.Name, req.Data))
+		fmt.Fprintf(w, `{"domain_record":{"id":1,"type":%q,"name":%q,"data":%q}}`, req.Type, req.Name, req.Data)
+	}
+	mux.HandleFunc("/v2/domains/example.com/records", handle)
+	mux.HandleFunc("/v2/domains/example.com/records/1", handle)
+
+	record, _, err := c.DomainRecords.Create(context.Background(), "example.com", &DomainRecordEditRequest{Type: "A", Name: "www", Data: "192.0.2.1"})
+	if err != nil {
+		t.Fatalf("DomainRecords.Create returned error: %v", err)
+	}
+	if record.ID != 1 || record.Data != "192.0.2.1" {
+		t.Errorf("got record %+v", record)
+	}
+	if _, _, err := c.DomainRecords.Update(context.Background(), "example.com", 1, &DomainRecordEditRequest{Type: "A", Name: "www", Data: "192.0.2.2"}); err != nil {
+		t.Fatalf("DomainRecords.Update returned error: %v", err)
+	}
+
+	want := "[POST A www 192.0.2.1 PUT A www 192.0.2.2]"
+	if fmt.Sprint(got) != want {
+		t.Errorf("expected %s, got %v", want, got)
+	}
+}
+
+func TestDomainRecords_Delete(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/domains/example.com/records/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := c.DomainRecords.Delete(context.Background(), "example.com", 1); err != nil {
+		t.Fatalf("DomainRecords.Delete returned error: %v", err)
+	}
+}
+
+func TestDomainRecords_validation(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+	r := c.DomainRecords
+
+	calls := map[string]func() error{
+		"get empty domain": func() error { _, _, err := r.Get(ctx, "", 1); return err },
+		"get record 0":     func() error { _, _, err := r.Get(ctx, "example.com", 0); return err },
+		"create nil":       func() error { _, _, err := r.Create(ctx, "example.com", nil); return err },
+		"create empty type": func() error {
+			_, _, err := r.Create(ctx, "example.com", &DomainRecordEditRequest{Name: "www"})
+			return err
+		},
+		"update nil":      func() error { _, _, err := r.Update(ctx, "example.com", 1, nil); return err },
+		"delete record 0": func() error { _, err := r.Delete(ctx, "example.com", 0); return err },
+	}
+	for name, call := range calls {
+		var verr *ValidationError
+		if err := call(); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
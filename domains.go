@@ -0,0 +1,128 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const domainsBasePath = "v2/domains"
+
+// Domain represents a DigitalOcean domain
+type Domain struct {
+	Name string `json:"name"`
+	TTL  int    `json:"ttl"`
+
+	// ZoneFile is the complete contents of the zone file of the domain.
+	ZoneFile string `json:"zone_file"`
+}
+
+// DomainCreateRequest represents a request to create a domain.
+type DomainCreateRequest struct {
+	Name      string `json:"name"`
+	IPAddress string `json:"ip_address,omitempty"`
+}
+
+/* SERVICE */
+
+// DomainsService is an interface for managing DNS with the DigitalOcean API.
+type DomainsService interface {
+	List(context.Context, *ListOptions) ([]Domain, *Response, error)
+	ListAll(context.Context, *ListAllOptions) ([]Domain, *Response, error)
+	Get(context.Context, string) (*Domain, *Response, error)
+	Create(context.Context, *DomainCreateRequest) (*Domain, *Response, error)
+	Delete(context.Context, string) (*Response, error)
+}
+
+// DomainsServiceOp handles communication with the domain related methods of the
+// DigitalOcean API.
+type DomainsServiceOp struct {
+	client *Client
+}
+
+var _ DomainsService = &DomainsServiceOp{}
+
+// List all domains.
+func (s *DomainsServiceOp) List(ctx context.Context, opt *ListOptions) ([]Domain, *Response, error) {
+	path, err := addOptions(domainsBasePath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	domains, resp, err := DoEnvelope[[]Domain](ctx, s.client, req, "domains")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *domains, resp, err
+}
+
+// ListAll domains across all pages
+func (s *DomainsServiceOp) ListAll(ctx context.Context, opt *ListAllOptions) ([]Domain, *Response, error) {
+	return ListAll[Domain](ctx, s.List, opt)
+}
+
+// Get individual domain. Errors for domains which do not exist match
+// ErrNotFound.
+func (s *DomainsServiceOp) Get(ctx context.Context, name string) (*Domain, *Response, error) {
+	if name == "" {
+		return nil, nil, &ValidationError{Field: "name", Reason: "must not be empty"}
+	}
+
+	path := fmt.Sprintf("%s/%s", domainsBasePath, name)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	domain, resp, err := DoEnvelope[Domain](ctx, s.client, req, "domain")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return domain, resp, err
+}
+
+// Create a new domain
+func (s *DomainsServiceOp) Create(ctx context.Context, createRequest *DomainCreateRequest) (*Domain, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, &ValidationError{Field: "createRequest", Reason: "cannot be nil"}
+	}
+	if createRequest.Name == "" {
+		return nil, nil, &ValidationError{Field: "name", Reason: "must not be empty"}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, domainsBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	domain, resp, err := DoEnvelope[Domain](ctx, s.client, req, "domain")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return domain, resp, err
+}
+
+// Delete domain
+func (s *DomainsServiceOp) Delete(ctx context.Context, name string) (*Response, error) {
+	if name == "" {
+		return nil, &ValidationError{Field: "name", Reason: "must not be empty"}
+	}
+
+	path := fmt.Sprintf("%s/%s", domainsBasePath, name)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestDomains_List(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/domains", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"domains":[{"name":"example.com","ttl":1800},{"name":"example.org"}],"meta":{"total":2}}`)
+	})
+
+	domains, _, err := c.Domains.List(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Domains.List returned error: %v", err)
+	}
+	if len(domains) != 2 || domains[0].Name != "example.com" || domains[0].TTL != 1800 {
+		t.Errorf("got domains %+v", domains)
+	}
+}
+
+func TestDomains_Get(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/domains/example.com", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"domain":{"name":"example.com","ttl":1800,"zone_file":"$ORIGIN example.com.\n"}}`)
+	})
+
+	domain, _, err := c.Domains.Get(context.Background(), "example.com")
+	if err != nil {
+		t.Fatalf("Domains.Get returned error: %v", err)
+	}
+	if domain.Name != "example.com" || domain.ZoneFile != "$ORIGIN example.com.\n" {
+		t.Errorf("got domain %+v", domain)
+	}
+
+	var verr *ValidationError
+	if _, _, err := c.Domains.Get(context.Background(), ""); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for an empty name, got %v", err)
+	}
+}
+
+func TestDomains_Create(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/domains", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var req DomainCreateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if req.Name != "example.com" || req.IPAddress != "192.0.2.1" {
+			t.Errorf("got request %+v", req)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"domain":{"name":"example.com","ttl":1800}}`)
+	})
+
+	domain, _, err := c.Domains.Create(context.Background(), &DomainCreateRequest{Name: "example.com", IPAddress: "192.0.2.1"})
+	if err != nil {
+		t.Fatalf("Domains.Create returned error: %v", err)
+	}
+	if domain.Name != "example.com" {
+		t.Errorf("got domain %+v", domain)
+	}
+
+	var verr *ValidationError
+	if _, _, err := c.Domains.Create(context.Background(), nil); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for a nil request, got %v", err)
+	}
+	if _, _, err := c.Domains.Create(context.Background(), &DomainCreateRequest{}); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for an empty name, got %v", err)
+	}
+}
+
+func TestDomains_Delete(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/domains/example.com", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := c.Domains.Delete(context.Background(), "example.com"); err != nil {
+		t.Fatalf("Domains.Delete returned error: %v", err)
+	}
+
+	var verr *ValidationError
+	if _, err := c.Domains.Delete(context.Background(), ""); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for an empty name, got %v", err)
+	}
+}
@@ -0,0 +1,307 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ActionRequest represents DigitalOcean Action Request
+type ActionRequest map[string]interface{}
+
+// DropletActionsService is an interface for interfacing with the Droplet
+// actions endpoints of the DigitalOcean API
+type DropletActionsService interface {
+	Shutdown(context.Context, int) (*Action, *Response, error)
+	ShutdownByTag(context.Context, string) ([]Action, *Response, error)
+	PowerOff(context.Context, int) (*Action, *Response, error)
+	PowerOffByTag(context.Context, string) ([]Action, *Response, error)
+	PowerOn(context.Context, int) (*Action, *Response, error)
+	PowerOnByTag(context.Context, string) ([]Action, *Response, error)
+	PowerCycle(context.Context, int) (*Action, *Response, error)
+	PowerCycleByTag(context.Context, string) ([]Action, *Response, error)
+	Reboot(context.Context, int) (*Action, *Response, error)
+	Restore(context.Context, int, int) (*Action, *Response, error)
+	Resize(context.Context, int, string, bool) (*Action, *Response, error)
+	Rename(context.Context, int, string) (*Action, *Response, error)
+	Snapshot(context.Context, int, string) (*Action, *Response, error)
+	SnapshotByTag(context.Context, string, string) ([]Action, *Response, error)
+	EnableBackups(context.Context, int) (*Action, *Response, error)
+	EnableBackupsByTag(context.Context, string) ([]Action, *Response, error)
+	EnableBackupsWithPolicy(context.Context, int, *DropletBackupPolicyRequest) (*Action, *Response, error)
+	ChangeBackupPolicy(context.Context, int, *DropletBackupPolicyRequest) (*Action, *Response, error)
+	DisableBackups(context.Context, int) (*Action, *Response, error)
+	DisableBackupsByTag(context.Context, string) ([]Action, *Response, error)
+	PasswordReset(context.Context, int) (*Action, *Response, error)
+	RebuildByImageID(context.Context, int, int) (*Action, *Response, error)
+	RebuildByImageSlug(context.Context, int, string) (*Action, *Response, error)
+	ChangeKernel(context.Context, int, int) (*Action, *Response, error)
+	EnableIPv6(context.Context, int) (*Action, *Response, error)
+	EnableIPv6ByTag(context.Context, string) ([]Action, *Response, error)
+	EnablePrivateNetworking(context.Context, int) (*Action, *Response, error)
+	EnablePrivateNetworkingByTag(context.Context, string) ([]Action, *Response, error)
+	Get(context.Context, int, int) (*Action, *Response, error)
+	GetByURI(context.Context, string) (*Action, *Response, error)
+}
+
+// DropletActionsServiceOp handles communication with the Droplet action related
+// methods of the DigitalOcean API.
+type DropletActionsServiceOp struct {
+	client *Client
+}
+
+var _ DropletActionsService = &DropletActionsServiceOp{}
+
+// Shutdown a Droplet
+func (s *DropletActionsServiceOp) Shutdown(ctx context.Context, id int) (*Action, *Response, error) {
+	return s.doAction(ctx, id, ActionRequest{"type": "shutdown"})
+}
+
+// ShutdownByTag shuts down Droplets matched by a Tag.
+func (s *DropletActionsServiceOp) ShutdownByTag(ctx context.Context, tag string) ([]Action, *Response, error) {
+	return s.doActionByTag(ctx, tag, ActionRequest{"type": "shutdown"})
+}
+
+// PowerOff a Droplet
+func (s *DropletActionsServiceOp) PowerOff(ctx context.Context, id int) (*Action, *Response, error) {
+	return s.doAction(ctx, id, ActionRequest{"type": "power_off"})
+}
+
+// PowerOffByTag powers off Droplets matched by a Tag.
+func (s *DropletActionsServiceOp) PowerOffByTag(ctx context.Context, tag string) ([]Action, *Response, error) {
+	return s.doActionByTag(ctx, tag, ActionRequest{"type": "power_off"})
+}
+
+// PowerOn a Droplet
+func (s *DropletActionsServiceOp) PowerOn(ctx context.Context, id int) (*Action, *Response, error) {
+	return s.doAction(ctx, id, ActionRequest{"type": "power_on"})
+}
+
+// PowerOnByTag powers on Droplets matched by a Tag.
+func (s *DropletActionsServiceOp) PowerOnByTag(ctx context.Context, tag string) ([]Action, *Response, error) {
+	return s.doActionByTag(ctx, tag, ActionRequest{"type": "power_on"})
+}
+
+// PowerCycle a Droplet
+func (s *DropletActionsServiceOp) PowerCycle(ctx context.Context, id int) (*Action, *Response, error) {
+	return s.doAction(ctx, id, ActionRequest{"type": "power_cycle"})
+}
+
+// PowerCycleByTag power cycles Droplets matched by a Tag.
+func (s *DropletActionsServiceOp) PowerCycleByTag(ctx context.Context, tag string) ([]Action, *Response, error) {
+	return s.doActionByTag(ctx, tag, ActionRequest{"type": "power_cycle"})
+}
+
+// Reboot a Droplet
+func (s *DropletActionsServiceOp) Reboot(ctx context.Context, id int) (*Action, *Response, error) {
+	return s.doAction(ctx, id, ActionRequest{"type": "reboot"})
+}
+
+// Restore an image to a Droplet
+func (s *DropletActionsServiceOp) Restore(ctx context.Context, id, imageID int) (*Action, *Response, error) {
+	return s.doAction(ctx, id, ActionRequest{"type": "restore", "image": imageID})
+}
+
+// Resize a Droplet. Resizing the disk as well is permanent, the Droplet can
+// not be resized to a smaller size afterwards.
+func (s *DropletActionsServiceOp) Resize(ctx context.Context, id int, sizeSlug string, resizeDisk bool) (*Action, *Response, error) {
+	if sizeSlug == "" {
+		return nil, nil, &ValidationError{Field: "sizeSlug", Reason: "must not be empty"}
+	}
+
+	return s.doAction(ctx, id, ActionRequest{"type": "resize", "size": sizeSlug, "disk": resizeDisk})
+}
+
+// Rename a Droplet
+func (s *DropletActionsServiceOp) Rename(ctx context.Context, id int, name string) (*Action, *Response, error) {
+	if name == "" {
+		return nil, nil, &ValidationError{Field: "name", Reason: "must not be empty"}
+	}
+
+	return s.doAction(ctx, id, ActionRequest{"type": "rename", "name": name})
+}
+
+// Snapshot a Droplet.
+func (s *DropletActionsServiceOp) Snapshot(ctx context.Context, id int, name string) (*Action, *Response, error) {
+	return s.doAction(ctx, id, ActionRequest{"type": "snapshot", "name": name})
+}
+
+// SnapshotByTag snapshots Droplets matched by a Tag.
+func (s *DropletActionsServiceOp) SnapshotByTag(ctx context.Context, tag string, name string) ([]Action, *Response, error) {
+	return s.doActionByTag(ctx, tag, ActionRequest{"type": "snapshot", "name": name})
+}
+
+// EnableBackups enables backups for a Droplet.
+func (s *DropletActionsServiceOp) EnableBackups(ctx context.Context, id int) (*Action, *Response, error) {
+	return s.doAction(ctx, id, ActionRequest{"type": "enable_backups"})
+}
+
+// EnableBackupsByTag enables backups for Droplets matched by a Tag.
+func (s *DropletActionsServiceOp) EnableBackupsByTag(ctx context.Context, tag string) ([]Action, *Response, error) {
+	return s.doActionByTag(ctx, tag, ActionRequest{"type": "enable_backups"})
+}
+
+// EnableBackupsWithPolicy enables backups for a Droplet with a backup policy.
+func (s *DropletActionsServiceOp) EnableBackupsWithPolicy(ctx context.Context, id int, policy *DropletBackupPolicyRequest) (*Action, *Response, error) {
+	if policy == nil {
+		return nil, nil, &ValidationError{Field: "policy", Reason: "cannot be nil"}
+	}
+	if err := validateBackupPolicy(policy); err != nil {
+		return nil, nil, err
+	}
+
+	return s.doAction(ctx, id, ActionRequest{"type": "enable_backups", "backup_policy": policy})
+}
+
+// ChangeBackupPolicy changes the backup policy of a Droplet with backups
+// enabled.
+func (s *DropletActionsServiceOp) ChangeBackupPolicy(ctx context.Context, id int, policy *DropletBackupPolicyRequest) (*Action, *Response, error) {
+	if policy == nil {
+		return nil, nil, &ValidationError{Field: "policy", Reason: "cannot be nil"}
+	}
+	if err := validateBackupPolicy(policy); err != nil {
+		return nil, nil, err
+	}
+
+	return s.doAction(ctx, id, ActionRequest{"type": "change_backup_policy", "backup_policy": policy})
+}
+
+// DisableBackups disables backups for a Droplet.
+func (s *DropletActionsServiceOp) DisableBackups(ctx context.Context, id int) (*Action, *Response, error) {
+	return s.doAction(ctx, id, ActionRequest{"type": "disable_backups"})
+}
+
+// DisableBackupsByTag disables backups for Droplet matched by a Tag.
+func (s *DropletActionsServiceOp) DisableBackupsByTag(ctx context.Context, tag string) ([]Action, *Response, error) {
+	return s.doActionByTag(ctx, tag, ActionRequest{"type": "disable_backups"})
+}
+
+// PasswordReset resets the password for a Droplet.
+func (s *DropletActionsServiceOp) PasswordReset(ctx context.Context, id int) (*Action, *Response, error) {
+	return s.doAction(ctx, id, ActionRequest{"type": "password_reset"})
+}
+
+// RebuildByImageID rebuilds a Droplet from an image with a given id.
+func (s *DropletActionsServiceOp) RebuildByImageID(ctx context.Context, id, imageID int) (*Action, *Response, error) {
+	return s.doAction(ctx, id, ActionRequest{"type": "rebuild", "image": imageID})
+}
+
+// RebuildByImageSlug rebuilds a Droplet from an Image matched by a given Slug.
+func (s *DropletActionsServiceOp) RebuildByImageSlug(ctx context.Context, id int, slug string) (*Action, *Response, error) {
+	return s.doAction(ctx, id, ActionRequest{"type": "rebuild", "image": slug})
+}
+
+// ChangeKernel changes the kernel for a Droplet.
+func (s *DropletActionsServiceOp) ChangeKernel(ctx context.Context, id, kernelID int) (*Action, *Response, error) {
+	return s.doAction(ctx, id, ActionRequest{"type": "change_kernel", "kernel": kernelID})
+}
+
+// EnableIPv6 enables IPv6 for a Droplet.
+func (s *DropletActionsServiceOp) EnableIPv6(ctx context.Context, id int) (*Action, *Response, error) {
+	return s.doAction(ctx, id, ActionRequest{"type": "enable_ipv6"})
+}
+
+// EnableIPv6ByTag enables IPv6 for Droplets matched by a Tag.
+func (s *DropletActionsServiceOp) EnableIPv6ByTag(ctx context.Context, tag string) ([]Action, *Response, error) {
+	return s.doActionByTag(ctx, tag, ActionRequest{"type": "enable_ipv6"})
+}
+
+// EnablePrivateNetworking enables private networking for a Droplet.
+func (s *DropletActionsServiceOp) EnablePrivateNetworking(ctx context.Context, id int) (*Action, *Response, error) {
+	return s.doAction(ctx, id, ActionRequest{"type": "enable_private_networking"})
+}
+
+// EnablePrivateNetworkingByTag enables private networking for Droplets matched by a Tag.
+func (s *DropletActionsServiceOp) EnablePrivateNetworkingByTag(ctx context.Context, tag string) ([]Action, *Response, error) {
+	return s.doActionByTag(ctx, tag, ActionRequest{"type": "enable_private_networking"})
+}
+
+func (s *DropletActionsServiceOp) doAction(ctx context.Context, id int, request ActionRequest) (*Action, *Response, error) {
+	if id < 1 {
+		return nil, nil, &ValidationError{Field: "dropletID", Reason: "must be positive"}
+	}
+
+	path := dropletActionPath(id)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	action, resp, err := DoEnvelope[Action](ctx, s.client, req, "action")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return action, resp, err
+}
+
+func (s *DropletActionsServiceOp) doActionByTag(ctx context.Context, tag string, request ActionRequest) ([]Action, *Response, error) {
+	if err := validateTagName(tag); err != nil {
+		return nil, nil, err
+	}
+
+	path, err := addOptions(dropletActionPathByTag(), &ListOptions{TagName: tag})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	actions, resp, err := DoEnvelope[[]Action](ctx, s.client, req, "actions")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *actions, resp, err
+}
+
+// Get an action for a particular Droplet by id.
+func (s *DropletActionsServiceOp) Get(ctx context.Context, dropletID, actionID int) (*Action, *Response, error) {
+	if dropletID < 1 {
+		return nil, nil, &ValidationError{Field: "dropletID", Reason: "must be positive"}
+	}
+	if actionID < 1 {
+		return nil, nil, &ValidationError{Field: "actionID", Reason: "must be positive"}
+	}
+
+	path := fmt.Sprintf("%s/%d", dropletActionPath(dropletID), actionID)
+	return s.get(ctx, path)
+}
+
+// GetByURI gets an action for a particular Droplet by URI, e.g. the href of
+// an action link.
+func (s *DropletActionsServiceOp) GetByURI(ctx context.Context, rawurl string) (*Action, *Response, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.get(ctx, u.Path)
+}
+
+func (s *DropletActionsServiceOp) get(ctx context.Context, path string) (*Action, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	action, resp, err := DoEnvelope[Action](ctx, s.client, req, "action")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return action, resp, err
+}
+
+func dropletActionPath(dropletID int) string {
+	return fmt.Sprintf("%s/%d/actions", dropletBasePath, dropletID)
+}
+
+func dropletActionPathByTag() string {
+	return dropletBasePath + "/actions"
+}
@@ -0,0 +1,211 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestDropletActions(t *testing.T) {
+	c, mux := setup(t)
+
+	var got ActionRequest
+	mux.HandleFunc("/v2/droplets/1/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		got = nil
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		fmt.Fprintf(w, `{"action":{"id":2,"status":"in-progress","type":%q,"resource_id":1,"resource_type":"droplet"}}`, got["type"])
+	})
+
+	ctx := context.Background()
+	a := c.DropletActions
+	tests := []struct {
+		name string
+		call func() (*Action, *Response, error)
+		want ActionRequest
+	}{
+		{"Shutdown", func() (*Action, *Response, error) { return a.Shutdown(ctx, 1) }, ActionRequest{"type": "shutdown"}},
+		{"PowerOff", func() (*Action, *Response, error) { return a.PowerOff(ctx, 1) }, ActionRequest{"type": "power_off"}},
+		{"PowerOn", func() (*Action, *Response, error) { return a.PowerOn(ctx, 1) }, ActionRequest{"type": "power_on"}},
+		{"PowerCycle", func() (*Action, *Response, error) { return a.PowerCycle(ctx, 1) }, ActionRequest{"type": "power_cycle"}},
+		{"Reboot", func() (*Action, *Response, error) { return a.Reboot(ctx, 1) }, ActionRequest{"type": "reboot"}},
+		{"Restore", func() (*Action, *Response, error) { return a.Restore(ctx, 1, 3) }, ActionRequest{"type": "restore", "image": 3.0}},
+		{"Resize", func() (*Action, *Response, error) { return a.Resize(ctx, 1, "s-2vcpu-2gb", true) }, ActionRequest{"type": "resize", "size": "s-2vcpu-2gb", "disk": true}},
+		{"Rename", func() (*Action, *Response, error) { return a.Rename(ctx, 1, "web-2") }, ActionRequest{"type": "rename", "name": "web-2"}},
+		{"Snapshot", func() (*Action, *Response, error) { return a.Snapshot(ctx, 1, "snap") }, ActionRequest{"type": "snapshot", "name": "snap"}},
+		{"EnableBackups", func() (*Action, *Response, error) { return a.EnableBackups(ctx, 1) }, ActionRequest{"type": "enable_backups"}},
+		{"DisableBackups", func() (*Action, *Response, error) { return a.DisableBackups(ctx, 1) }, ActionRequest{"type": "disable_backups"}},
+		{"PasswordReset", func() (*Action, *Response, error) { return a.PasswordReset(ctx, 1) }, ActionRequest{"type": "password_reset"}},
+		{"RebuildByImageID", func() (*Action, *Response, error) { return a.RebuildByImageID(ctx, 1, 3) }, ActionRequest{"type": "rebuild", "image": 3.0}},
+		{"RebuildByImageSlug", func() (*Action, *Response, error) { return a.RebuildByImageSlug(ctx, 1, "ubuntu-22-04-x64") }, ActionRequest{"type": "rebuild", "image": "ubuntu-22-04-x64"}},
+		{"ChangeKernel", func() (*Action, *Response, error) { return a.ChangeKernel(ctx, 1, 4) }, ActionRequest{"type": "change_kernel", "kernel": 4.0}},
+		{"EnableIPv6", func() (*Action, *Response, error) { return a.EnableIPv6(ctx, 1) }, ActionRequest{"type": "enable_ipv6"}},
+		{"EnablePrivateNetworking", func() (*Action, *Response, error) { return a.EnablePrivateNetworking(ctx, 1) }, ActionRequest{"type": "enable_private_networking"}},
+	}
+
+	for _, tt := range tests {
+		action, _, err := tt.call()
+		if err != nil {
+			t.Errorf("%s returned error: %v", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: expected request %v, got %v", tt.name, tt.want, got)
+		}
+		if action.ID != 2 || action.Type != tt.want["type"] {
+			t.Errorf("%s: got action %+v", tt.name, action)
+		}
+	}
+}
+
+func TestDropletActions_byTag(t *testing.T) {
+	c, mux := setup(t)
+
+	var got []string
+	mux.HandleFunc("/v2/droplets/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var req ActionRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		got = append(got, fmt.Sprintf("%s:%v", r.URL.Query().Get("tag_name"), req["type"]))
+		fmt.Fprint(w, `{"actions":[{"id":2},{"id":3}]}`)
+	})
+
+	ctx := context.Background()
+	a := c.DropletActions
+	calls := []func() ([]Action, *Response, error){
+		func() ([]Action, *Response, error) { return a.ShutdownByTag(ctx, "web") },
+		func() ([]Action, *Response, error) { return a.PowerOffByTag(ctx, "web") },
+		func() ([]Action, *Response, error) { return a.PowerOnByTag(ctx, "web") },
+		func() ([]Action, *Response, error) { return a.PowerCycleByTag(ctx, "web") },
+		func() ([]Action, *Response, error) { return a.SnapshotByTag(ctx, "web", "snap") },
+		func() ([]Action, *Response, error) { return a.EnableBackupsByTag(ctx, "web") },
+		func() ([]Action, *Response, error) { return a.DisableBackupsByTag(ctx, "web") },
+		func() ([]Action, *Response, error) { return a.EnableIPv6ByTag(ctx, "web") },
+		func() ([]Action, *Response, error) { return a.EnablePrivateNetworkingByTag(ctx, "web") },
+	}
+	for i, call := range calls {
+		actions, _, err := call()
+		if err != nil {
+			t.Fatalf("call %d returned error: %v", i, err)
+		}
+		if len(actions) != 2 {
+			t.Errorf("call %d: expected 2 actions, got %+v", i, actions)
+		}
+	}
+
+	want := "[web:shutdown web:power_off web:power_on web:power_cycle web:snapshot web:enable_backups web:disable_backups web:enable_ipv6 web:enable_private_networking]"
+	if fmt.Sprint(got) != want {
+		t.Errorf("expected %s, got %v", want, got)
+	}
+}
+
+func TestDropletActions_Get(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/droplets/1/actions/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"action":{"id":2,"status":"completed"}}`)
+	})
+
+	action, _, err := c.DropletActions.Get(context.Background(), 1, 2)
+	if err != nil {
+		t.Fatalf("DropletActions.Get returned error: %v", err)
+	}
+	if action.ID != 2 || action.Status != ActionCompleted {
+		t.Errorf("got action %+v", action)
+	}
+
+	action, _, err = c.DropletActions.GetByURI(context.Background(), "https://api.example.com/v2/droplets/1/actions/2")
+	if err != nil {
+		t.Fatalf("DropletActions.GetByURI returned error: %v", err)
+	}
+	if action.ID != 2 {
+		t.Errorf("got action %+v", action)
+	}
+}
+
+func TestDropletActions_validation(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+	a := c.DropletActions
+
+	calls := map[string]func() error{
+		"droplet 0":     func() error { _, _, err := a.Reboot(ctx, 0); return err },
+		"empty size":    func() error { _, _, err := a.Resize(ctx, 1, "", false); return err },
+		"empty name":    func() error { _, _, err := a.Rename(ctx, 1, ""); return err },
+		"empty tag":     func() error { _, _, err := a.PowerOffByTag(ctx, ""); return err },
+		"get droplet 0": func() error { _, _, err := a.Get(ctx, 0, 2); return err },
+		"get action 0":  func() error { _, _, err := a.Get(ctx, 1, 0); return err },
+	}
+	for name, call := range calls {
+		var verr *ValidationError
+		if err := call(); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
+
+func TestDropletActions_backupPolicy(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+
+	var got []ActionRequest
+	mux.HandleFunc("/v2/droplets/1/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var req ActionRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		got = append(got, req)
+		fmt.Fprintf(w, `{"action":{"id":2,"status":"in-progress","type":%q}}`, req["type"])
+	})
+
+	hour := 20
+	if _, _, err := c.DropletActions.EnableBackupsWithPolicy(ctx, 1, &DropletBackupPolicyRequest{Plan: DropletBackupPlanWeekly, Weekday: "SUN", Hour: &hour}); err != nil {
+		t.Fatalf("DropletActions.EnableBackupsWithPolicy returned error: %v", err)
+	}
+	if _, _, err := c.DropletActions.ChangeBackupPolicy(ctx, 1, &DropletBackupPolicyRequest{Plan: DropletBackupPlanDaily}); err != nil {
+		t.Fatalf("DropletActions.ChangeBackupPolicy returned error: %v", err)
+	}
+
+	want := []ActionRequest{
+		{"type": "enable_backups", "backup_policy": map[string]interface{}{"plan": "weekly", "weekday": "SUN", "hour": 20.0}},
+		{"type": "change_backup_policy", "backup_policy": map[string]interface{}{"plan": "daily"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected requests %v, got %v", want, got)
+	}
+}
+
+func TestDropletActions_backupPolicy_validation(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+	a := c.DropletActions
+	hour := func(h int) *int { return &h }
+
+	policies := map[string]*DropletBackupPolicyRequest{
+		"nil":               nil,
+		"unknown plan":      {Plan: "monthly"},
+		"daily weekday":     {Plan: DropletBackupPlanDaily, Weekday: "MON"},
+		"unknown weekday":   {Plan: DropletBackupPlanWeekly, Weekday: "MONDAY"},
+		"hour not multiple": {Plan: DropletBackupPlanDaily, Hour: hour(6)},
+		"hour out of range": {Plan: DropletBackupPlanDaily, Hour: hour(24)},
+	}
+	for name, policy := range policies {
+		var verr *ValidationError
+		if _, _, err := a.EnableBackupsWithPolicy(ctx, 1, policy); !errors.As(err, &verr) {
+			t.Errorf("enable %s: expected a *ValidationError, got %v", name, err)
+		}
+		if _, _, err := a.ChangeBackupPolicy(ctx, 1, policy); !errors.As(err, &verr) {
+			t.Errorf("change %s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
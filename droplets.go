@@ -0,0 +1,782 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"time"
+)
+
+const dropletBasePath = "v2/droplets"
+
+const dropletNeighborsReportPath = "v2/reports/droplet_neighbors_ids"
+
+// maxDropletsPerCreate is the number of droplets the API creates with a
+// single request.
+const maxDropletsPerCreate = 10
+
+// DefaultDropletBatchCreateTimeout bounds the wait for each Droplet created by
+// CreateBatch when WaitOptions.Timeout is not set.
+const DefaultDropletBatchCreateTimeout = 10 * time.Minute
+
+// Droplet statuses
+const (
+	// DropletNew is the status of a Droplet which is being created.
+	DropletNew = "new"
+	// DropletActive is the status of a running Droplet.
+	DropletActive = "active"
+	// DropletOff is the status of a powered off Droplet.
+	DropletOff = "off"
+	// DropletArchive is the status of a Droplet which was destroyed.
+	DropletArchive = "archive"
+)
+
+/*  Objects */
+
+// Droplet represents a DigitalOcean Droplet
+type Droplet struct {
+	ID               int           `json:"id,omitempty"`
+	Name             string        `json:"name,omitempty"`
+	Memory           int           `json:"memory,omitempty"`
+	Vcpus            int           `json:"vcpus,omitempty"`
+	Disk             int           `json:"disk,omitempty"`
+	Region           *Region       `json:"region,omitempty"`
+	Image            *Image        `json:"image,omitempty"`
+	Size             *Size         `json:"size,omitempty"`
+	SizeSlug         string        `json:"size_slug,omitempty"`
+	BackupIDs        []int         `json:"backup_ids,omitempty"`
+	NextBackupWindow *BackupWindow `json:"next_backup_window,omitempty"`
+	SnapshotIDs      []int         `json:"snapshot_ids,omitempty"`
+	Features         []string      `json:"features,omitempty"`
+	Locked           bool          `json:"locked,omitempty"`
+	Status           string        `json:"status,omitempty"`
+	Networks         *Networks     `json:"networks,omitempty"`
+	Created          string        `json:"created_at,omitempty"`
+	Kernel           *Kernel       `json:"kernel,omitempty"`
+	Tags             []string      `json:"tags,omitempty"`
+	VolumeIDs        []string      `json:"volume_ids"`
+	VPCUUID          string        `json:"vpc_uuid,omitempty"`
+	GPUInfo          *GPUInfo      `json:"gpu_info,omitempty"`
+}
+
+// ErrNoNetworks is returned by the IP address accessors of a Droplet whose
+// networks were not returned by the API.
+var ErrNoNetworks = errors.New("droplet has no networks")
+
+// PublicIPv4 returns the public IPv4 address for the Droplet.
+func (d *Droplet) PublicIPv4() (string, error) {
+	return d.ipv4("public")
+}
+
+// PrivateIPv4 returns the private IPv4 address for the Droplet.
+func (d *Droplet) PrivateIPv4() (string, error) {
+	return d.ipv4("private")
+}
+
+// PublicIPv6 returns the public IPv6 address for the Droplet.
+func (d *Droplet) PublicIPv6() (string, error) {
+	if d.Networks == nil {
+		return "", ErrNoNetworks
+	}
+
+	for _, v6 := range d.Networks.V6 {
+		if v6.Type == "public" && v6.IPAddress.IsValid() {
+			return v6.IPAddress.String(), nil
+		}
+	}
+
+	return "", nil
+}
+
+// ipv4 returns the IPv4 address of the given network type.
+func (d *Droplet) ipv4(typ string) (string, error) {
+	if d.Networks == nil {
+		return "", ErrNoNetworks
+	}
+
+	for _, v4 := range d.Networks.V4 {
+		if v4.Type == typ {
+			return v4.IPAddress, nil
+		}
+	}
+
+	return "", nil
+}
+
+// URN returns the droplet ID in a valid DO API URN form.
+func (d Droplet) URN() string {
+	return Resource{ID: fmt.Sprint(d.ID), Type: DropletResourceType}.URN()
+}
+
+// BackupWindow object
+type BackupWindow struct {
+	Start *Timestamp `json:"start,omitempty"`
+	End   *Timestamp `json:"end,omitempty"`
+}
+
+// Droplet backup plans
+const (
+	// DropletBackupPlanDaily backs a Droplet up every day.
+	DropletBackupPlanDaily = "daily"
+	// DropletBackupPlanWeekly backs a Droplet up once a week.
+	DropletBackupPlanWeekly = "weekly"
+)
+
+// dropletBackupWeekdays are the days a weekly backup can be scheduled on.
+var dropletBackupWeekdays = []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+
+// DropletBackupPolicyRequest defines the backup policy when creating a Droplet
+// or changing its backup policy. Hour is the UTC hour the backup window starts
+// at, a multiple of 4. Weekday, e.g. "MON", is only used by weekly plans.
+type DropletBackupPolicyRequest struct {
+	Plan    string `json:"plan,omitempty"`
+	Weekday string `json:"weekday,omitempty"`
+	Hour    *int   `json:"hour,omitempty"`
+}
+
+// DropletBackupPolicyConfig is the backup policy of a Droplet.
+type DropletBackupPolicyConfig struct {
+	Plan                string `json:"plan,omitempty"`
+	Weekday             string `json:"weekday,omitempty"`
+	Hour                int    `json:"hour,omitempty"`
+	WindowLengthHours   int    `json:"window_length_hours,omitempty"`
+	RetentionPeriodDays int    `json:"retention_period_days,omitempty"`
+}
+
+// DropletBackupPolicy is the backup configuration of a Droplet.
+type DropletBackupPolicy struct {
+	DropletID        int                        `json:"droplet_id,omitempty"`
+	BackupEnabled    bool                       `json:"backup_enabled,omitempty"`
+	BackupPolicy     *DropletBackupPolicyConfig `json:"backup_policy,omitempty"`
+	NextBackupWindow *BackupWindow              `json:"next_backup_window,omitempty"`
+}
+
+// SupportedBackupPolicy is a backup plan Droplets can use, with the hours and
+// days its backup window can start at.
+type SupportedBackupPolicy struct {
+	Name                 string   `json:"name,omitempty"`
+	PossibleWindowStarts []int    `json:"possible_window_starts,omitempty"`
+	WindowLengthHours    int      `json:"window_length_hours,omitempty"`
+	RetentionPeriodDays  int      `json:"retention_period_days,omitempty"`
+	PossibleDays         []string `json:"possible_days,omitempty"`
+}
+
+// Kernel object
+type Kernel struct {
+	ID      int    `json:"id,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
+// Networks represents the Droplet's Networks.
+type Networks struct {
+	V4 []NetworkV4 `json:"v4,omitempty"`
+	V6 []NetworkV6 `json:"v6,omitempty"`
+}
+
+// NetworkV4 represents a DigitalOcean IPv4 Network.
+type NetworkV4 struct {
+	IPAddress string `json:"ip_address,omitempty"`
+	Netmask   string `json:"netmask,omitempty"`
+	Gateway   string `json:"gateway,omitempty"`
+	Type      string `json:"type,omitempty"`
+}
+
+// NetworkV6 represents a DigitalOcean IPv6 network.
+type NetworkV6 struct {
+	IPAddress netip.Addr `json:"ip_address,omitempty"`
+	Netmask   int        `json:"netmask,omitempty"`
+	Gateway   netip.Addr `json:"gateway,omitempty"`
+	Type      string     `json:"type,omitempty"`
+}
+
+// Prefix returns the network the address belongs to, e.g.
+// 2604:a880:800:10::/64. It is invalid if the address or netmask is.
+func (n NetworkV6) Prefix() netip.Prefix {
+	prefix, _ := n.IPAddress.Prefix(n.Netmask)
+	return prefix
+}
+
+// DropletCreateImage identifies an image for the create request. It prefers
+// slug over ID.
+type DropletCreateImage struct {
+	ID   int
+	Slug string
+}
+
+// MarshalJSON returns either the slug or id of the image. It returns the id
+// if the slug is empty.
+func (d DropletCreateImage) MarshalJSON() ([]byte, error) {
+	if d.Slug != "" {
+		return json.Marshal(d.Slug)
+	}
+
+	return json.Marshal(d.ID)
+}
+
+// DropletCreateVolume identifies a volume to attach for the create request.
+type DropletCreateVolume struct {
+	ID string `json:"id"`
+}
+
+// DropletCreateSSHKey identifies a SSH Key for the create request. It prefers
+// fingerprint over ID.
+type DropletCreateSSHKey struct {
+	ID          int
+	Fingerprint string
+}
+
+// MarshalJSON returns either the fingerprint or id of the ssh key. It returns
+// the id if the fingerprint is empty.
+func (d DropletCreateSSHKey) MarshalJSON() ([]byte, error) {
+	if d.Fingerprint != "" {
+		return json.Marshal(d.Fingerprint)
+	}
+
+	return json.Marshal(d.ID)
+}
+
+// DropletCreateRequest represents a request to create a Droplet.
+type DropletCreateRequest struct {
+	Name              string                      `json:"name"`
+	Region            string                      `json:"region"`
+	Size              string                      `json:"size"`
+	Image             DropletCreateImage          `json:"image"`
+	SSHKeys           []DropletCreateSSHKey       `json:"ssh_keys"`
+	Backups           bool                        `json:"backups"`
+	IPv6              bool                        `json:"ipv6"`
+	PrivateNetworking bool                        `json:"private_networking"`
+	Monitoring        bool                        `json:"monitoring"`
+	UserData          string                      `json:"user_data,omitempty"`
+	Volumes           []DropletCreateVolume       `json:"volumes,omitempty"`
+	Tags              []string                    `json:"tags"`
+	VPCUUID           string                      `json:"vpc_uuid,omitempty"`
+	WithDropletAgent  *bool                       `json:"with_droplet_agent,omitempty"`
+	BackupPolicy      *DropletBackupPolicyRequest `json:"backup_policy,omitempty"`
+}
+
+// DropletMultiCreateRequest is a request to create multiple Droplets.
+type DropletMultiCreateRequest struct {
+	Names             []string                    `json:"names"`
+	Region            string                      `json:"region"`
+	Size              string                      `json:"size"`
+	Image             DropletCreateImage          `json:"image"`
+	SSHKeys           []DropletCreateSSHKey       `json:"ssh_keys"`
+	Backups           bool                        `json:"backups"`
+	IPv6              bool                        `json:"ipv6"`
+	PrivateNetworking bool                        `json:"private_networking"`
+	Monitoring        bool                        `json:"monitoring"`
+	UserData          string                      `json:"user_data,omitempty"`
+	Tags              []string                    `json:"tags"`
+	VPCUUID           string                      `json:"vpc_uuid,omitempty"`
+	WithDropletAgent  *bool                       `json:"with_droplet_agent,omitempty"`
+	BackupPolicy      *DropletBackupPolicyRequest `json:"backup_policy,omitempty"`
+}
+
+// DropletCreateResult is the outcome of creating one Droplet of a batch.
+type DropletCreateResult struct {
+	Name    string
+	Droplet *Droplet
+	Err     error
+}
+
+/* SERVICE */
+
+// DropletsService is an interface for interfacing with the Droplet
+// endpoints of the DigitalOcean API
+type DropletsService interface {
+	List(context.Context, *ListOptions) ([]Droplet, *Response, error)
+	ListAll(context.Context, *ListAllOptions) ([]Droplet, *Response, error)
+	ListByTag(context.Context, string, *ListOptions) ([]Droplet, *Response, error)
+	ListWithGPUs(context.Context, *ListOptions) ([]Droplet, *Response, error)
+	Get(context.Context, int) (*Droplet, *Response, error)
+	Create(context.Context, *DropletCreateRequest) (*Droplet, *Response, error)
+	CreateAndWait(context.Context, *DropletCreateRequest, WaitOptions) (*Droplet, *Response, error)
+	CreateMultiple(context.Context, *DropletMultiCreateRequest) ([]Droplet, *Response, error)
+	CreateBatch(context.Context, *DropletMultiCreateRequest, WaitOptions) ([]DropletCreateResult, error)
+	Delete(context.Context, int) (*Response, error)
+	DeleteByTag(context.Context, string) (*Response, error)
+	Kernels(context.Context, int, *ListOptions) ([]Kernel, *Response, error)
+	Snapshots(context.Context, int, *ListOptions) ([]Image, *Response, error)
+	Backups(context.Context, int, *ListOptions) ([]Image, *Response, error)
+	Actions(context.Context, int, *ListOptions) ([]Action, *Response, error)
+	Neighbors(context.Context, int) ([]Droplet, *Response, error)
+	NeighborIDs(context.Context) ([][]int, *Response, error)
+	GetBackupPolicy(context.Context, int) (*DropletBackupPolicy, *Response, error)
+	ListBackupPolicies(context.Context, *ListOptions) (map[int]*DropletBackupPolicy, *Response, error)
+	ListSupportedBackupPolicies(context.Context) ([]*SupportedBackupPolicy, *Response, error)
+}
+
+// DropletsServiceOp handles communication with the Droplet related methods of the
+// DigitalOcean API.
+type DropletsServiceOp struct {
+	client *Client
+}
+
+var _ DropletsService = &DropletsServiceOp{}
+
+// List all Droplets
+func (s *DropletsServiceOp) List(ctx context.Context, opt *ListOptions) ([]Droplet, *Response, error) {
+	return s.list(ctx, dropletBasePath, opt)
+}
+
+// ListAll Droplets across all pages
+func (s *DropletsServiceOp) ListAll(ctx context.Context, opt *ListAllOptions) ([]Droplet, *Response, error) {
+	return ListAll[Droplet](ctx, s.List, opt)
+}
+
+// ListByTag lists all Droplets matched by a Tag.
+func (s *DropletsServiceOp) ListByTag(ctx context.Context, tag string, opt *ListOptions) ([]Droplet, *Response, error) {
+	if err := validateTagName(tag); err != nil {
+		return nil, nil, err
+	}
+
+	var o ListOptions
+	if opt != nil {
+		o = *opt
+	}
+	o.TagName = tag
+
+	return s.list(ctx, dropletBasePath, &o)
+}
+
+// ListWithGPUs lists the GPU Droplets.
+func (s *DropletsServiceOp) ListWithGPUs(ctx context.Context, opt *ListOptions) ([]Droplet, *Response, error) {
+	var o ListOptions
+	if opt != nil {
+		o = *opt
+	}
+	o.Type = "gpus"
+
+	return s.list(ctx, dropletBasePath, &o)
+}
+
+// Get individual Droplet
+func (s *DropletsServiceOp) Get(ctx context.Context, dropletID int) (*Droplet, *Response, error) {
+	if dropletID < 1 {
+		return nil, nil, &ValidationError{Field: "dropletID", Reason: "must be positive"}
+	}
+
+	path := fmt.Sprintf("%s/%d", dropletBasePath, dropletID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	droplet, resp, err := DoEnvelope[Droplet](ctx, s.client, req, "droplet")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return droplet, resp, err
+}
+
+// Create Droplet
+func (s *DropletsServiceOp) Create(ctx context.Context, createRequest *DropletCreateRequest) (*Droplet, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, &ValidationError{Field: "createRequest", Reason: "cannot be nil"}
+	}
+	if err := validateBackupPolicy(createRequest.BackupPolicy); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, dropletBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	droplet, resp, err := DoEnvelope[Droplet](ctx, s.client, req, "droplet")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return droplet, resp, err
+}
+
+// CreateAndWait creates a Droplet and waits until it is active, returning it
+// with its networks. The create action linked from the response is polled as
+// configured by opts, a failed create is reported as an *ActionError.
+func (s *DropletsServiceOp) CreateAndWait(ctx context.Context, createRequest *DropletCreateRequest, opts WaitOptions) (*Droplet, *Response, error) {
+	droplet, resp, err := s.Create(ctx, createRequest)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	droplet, waitResp, err := s.waitUntilActive(ctx, opts, droplet, linkedActionID(resp, "create"))
+	if waitResp != nil {
+		resp = waitResp
+	}
+	return droplet, resp, err
+}
+
+// waitUntilActive polls the create action of droplet, unless actionID is
+// zero, and the Droplet until it is active, returning the Response of the last
+// request made. A failed create is reported as an *ActionError.
+func (s *DropletsServiceOp) waitUntilActive(ctx context.Context, opts WaitOptions, droplet *Droplet, actionID int) (*Droplet, *Response, error) {
+	var resp *Response
+	dropletID := droplet.ID
+	droplet, err := pollFrom(ctx, opts, droplet, func(ctx context.Context) (*Droplet, error) {
+		if actionID != 0 {
+			action, actionResp, err := s.client.Actions.Get(ctx, actionID)
+			if err != nil {
+				resp = actionResp
+				return nil, err
+			}
+			switch action.Status {
+			case ActionErrored:
+				resp = actionResp
+				return nil, &ActionError{Action: action}
+			case ActionInProgress:
+				return droplet, nil
+			}
+		}
+
+		latest, latestResp, err := s.Get(ctx, dropletID)
+		resp = latestResp
+		return latest, err
+	}, func(droplet *Droplet) (bool, string, error) {
+		return droplet.Status == DropletActive, droplet.Status, nil
+	})
+	return droplet, resp, err
+}
+
+// createActionID returns the ID of the create action of a Droplet, or zero if
+// it has none.
+func (s *DropletsServiceOp) createActionID(ctx context.Context, dropletID int) (int, error) {
+	actions, _, err := s.Actions(ctx, dropletID, nil)
+	if err != nil {
+		return 0, err
+	}
+	for _, action := range actions {
+		if action.Type == "create" {
+			return action.ID, nil
+		}
+	}
+	return 0, nil
+}
+
+// CreateMultiple creates multiple Droplets, at most ten with one request.
+func (s *DropletsServiceOp) CreateMultiple(ctx context.Context, createRequest *DropletMultiCreateRequest) ([]Droplet, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, &ValidationError{Field: "createRequest", Reason: "cannot be nil"}
+	}
+	if n := len(createRequest.Names); n == 0 || n > maxDropletsPerCreate {
+		return nil, nil, &ValidationError{Field: "names", Reason: fmt.Sprintf("must hold 1 to %d names", maxDropletsPerCreate)}
+	}
+	if err := validateBackupPolicy(createRequest.BackupPolicy); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, dropletBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	droplets, resp, err := DoEnvelope[[]Droplet](ctx, s.client, req, "droplets")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *droplets, resp, err
+}
+
+// CreateBatch creates a Droplet for each of any number of names, sending
+// CreateMultiple requests of up to ten names concurrently, and waits until
+// each Droplet is active, polling its create action and then the Droplet as
+// configured by opts. A failed create is reported as an *ActionError, and a
+// Droplet which does not become active within opts.Timeout, or
+// DefaultDropletBatchCreateTimeout if it is not set, as a *WaitTimeoutError.
+// It reports the result for each name in order, and returns an error joining
+// the errors of all names which failed, so Droplets which were created can be
+// used or cleaned up.
+func (s *DropletsServiceOp) CreateBatch(ctx context.Context, createRequest *DropletMultiCreateRequest, opts WaitOptions) ([]DropletCreateResult, error) {
+	if createRequest == nil {
+		return nil, &ValidationError{Field: "createRequest", Reason: "cannot be nil"}
+	}
+	if len(createRequest.Names) == 0 {
+		return nil, &ValidationError{Field: "names", Reason: "must not be empty"}
+	}
+	if err := validateBackupPolicy(createRequest.BackupPolicy); err != nil {
+		return nil, err
+	}
+
+	results := make([]DropletCreateResult, len(createRequest.Names))
+	for i, name := range createRequest.Names {
+		results[i].Name = name
+	}
+
+	b := batches(results, maxDropletsPerCreate)
+	runBounded(ctx, len(b), bulkConcurrency, func(i int) {
+		batchRequest := *createRequest
+		batchRequest.Names = createRequest.Names[i*maxDropletsPerCreate : i*maxDropletsPerCreate+len(b[i])]
+
+		droplets, _, err := s.CreateMultiple(ctx, &batchRequest)
+		if err != nil {
+			for j := range b[i] {
+				b[i][j].Err = err
+			}
+			return
+		}
+
+		// the response is not guaranteed to be in request order
+		byName := make(map[string][]*Droplet, len(droplets))
+		for k := range droplets {
+			byName[droplets[k].Name] = append(byName[droplets[k].Name], &droplets[k])
+		}
+		for j := range b[i] {
+			matches := byName[b[i][j].Name]
+			if len(matches) == 0 {
+				b[i][j].Err = errors.New("droplet missing from create response")
+				continue
+			}
+			b[i][j].Droplet, byName[b[i][j].Name] = matches[0], matches[1:]
+		}
+	}, func(i int, err error) {
+		for j := range b[i] {
+			b[i][j].Err = err
+		}
+	})
+
+	opts = opts.withDefaultTimeout(DefaultDropletBatchCreateTimeout)
+	runBounded(ctx, len(results), bulkConcurrency, func(i int) {
+		if results[i].Err != nil {
+			return
+		}
+		// the create actions linked from a CreateMultiple response can not be
+		// told apart, so each Droplet's own is looked up
+		actionID, err := s.createActionID(ctx, results[i].Droplet.ID)
+		if err != nil {
+			results[i].Err = err
+			return
+		}
+		results[i].Droplet, _, results[i].Err = s.waitUntilActive(ctx, opts, results[i].Droplet, actionID)
+	}, func(i int, err error) {
+		if results[i].Err == nil {
+			results[i].Err = err
+		}
+	})
+
+	errs := make([]error, 0, len(results))
+	for _, r := range results {
+		if r.Err != nil {
+			errs = append(errs, fmt.Errorf("droplet %q: %w", r.Name, r.Err))
+		}
+	}
+	return results, errors.Join(errs...)
+}
+
+// Delete Droplet.
+func (s *DropletsServiceOp) Delete(ctx context.Context, dropletID int) (*Response, error) {
+	if dropletID < 1 {
+		return nil, &ValidationError{Field: "dropletID", Reason: "must be positive"}
+	}
+
+	path := fmt.Sprintf("%s/%d", dropletBasePath, dropletID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// DeleteByTag deletes Droplets matched by a Tag.
+func (s *DropletsServiceOp) DeleteByTag(ctx context.Context, tag string) (*Response, error) {
+	if err := validateTagName(tag); err != nil {
+		return nil, err
+	}
+
+	path, err := addOptions(dropletBasePath, &ListOptions{TagName: tag})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// Kernels lists kernels available for a Droplet.
+func (s *DropletsServiceOp) Kernels(ctx context.Context, dropletID int, opt *ListOptions) ([]Kernel, *Response, error) {
+	return dropletSubList[Kernel](ctx, s.client, dropletID, "kernels", opt)
+}
+
+// Snapshots lists the snapshots available for a Droplet.
+func (s *DropletsServiceOp) Snapshots(ctx context.Context, dropletID int, opt *ListOptions) ([]Image, *Response, error) {
+	return dropletSubList[Image](ctx, s.client, dropletID, "snapshots", opt)
+}
+
+// Backups lists the backups for a Droplet.
+func (s *DropletsServiceOp) Backups(ctx context.Context, dropletID int, opt *ListOptions) ([]Image, *Response, error) {
+	return dropletSubList[Image](ctx, s.client, dropletID, "backups", opt)
+}
+
+// Actions lists the actions for a Droplet.
+func (s *DropletsServiceOp) Actions(ctx context.Context, dropletID int, opt *ListOptions) ([]Action, *Response, error) {
+	return dropletSubList[Action](ctx, s.client, dropletID, "actions", opt)
+}
+
+// Neighbors lists the neighbors for a Droplet, the droplets running on the
+// same physical hardware.
+func (s *DropletsServiceOp) Neighbors(ctx context.Context, dropletID int) ([]Droplet, *Response, error) {
+	if dropletID < 1 {
+		return nil, nil, &ValidationError{Field: "dropletID", Reason: "must be positive"}
+	}
+
+	path := fmt.Sprintf("%s/%d/neighbors", dropletBasePath, dropletID)
+
+	return s.list(ctx, path, nil)
+}
+
+// NeighborIDs returns the groups of droplets of the account which run on the
+// same physical hardware, each group holding the IDs of its droplets.
+func (s *DropletsServiceOp) NeighborIDs(ctx context.Context) ([][]int, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, dropletNeighborsReportPath, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ids, resp, err := DoEnvelope[[][]int](ctx, s.client, req, "neighbor_ids")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *ids, resp, err
+}
+
+// GetBackupPolicy gets the backup policy of a Droplet.
+func (s *DropletsServiceOp) GetBackupPolicy(ctx context.Context, dropletID int) (*DropletBackupPolicy, *Response, error) {
+	if dropletID < 1 {
+		return nil, nil, &ValidationError{Field: "dropletID", Reason: "must be positive"}
+	}
+
+	path := fmt.Sprintf("%s/%d/backups/policy", dropletBasePath, dropletID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policy, resp, err := DoEnvelope[DropletBackupPolicy](ctx, s.client, req, "policy")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return policy, resp, err
+}
+
+// ListBackupPolicies lists the backup policies of all Droplets of the
+// account, keyed by Droplet ID.
+func (s *DropletsServiceOp) ListBackupPolicies(ctx context.Context, opt *ListOptions) (map[int]*DropletBackupPolicy, *Response, error) {
+	path, err := addOptions(dropletBasePath+"/backups/policies", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policies, resp, err := DoEnvelope[map[int]*DropletBackupPolicy](ctx, s.client, req, "policies")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *policies, resp, err
+}
+
+// ListSupportedBackupPolicies lists the backup plans Droplets can use.
+func (s *DropletsServiceOp) ListSupportedBackupPolicies(ctx context.Context) ([]*SupportedBackupPolicy, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, dropletBasePath+"/backups/supported_policies", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policies, resp, err := DoEnvelope[[]*SupportedBackupPolicy](ctx, s.client, req, "supported_policies")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *policies, resp, err
+}
+
+// list fetches a page of droplets from path.
+func (s *DropletsServiceOp) list(ctx context.Context, path string, opt *ListOptions) ([]Droplet, *Response, error) {
+	path, err := addOptions(path, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	droplets, resp, err := DoEnvelope[[]Droplet](ctx, s.client, req, "droplets")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *droplets, resp, err
+}
+
+// dropletSubList fetches a page of the collection stored under key below a
+// droplet, e.g. /v2/droplets/1/kernels.
+func dropletSubList[T any](ctx context.Context, c *Client, dropletID int, key string, opt *ListOptions) ([]T, *Response, error) {
+	if dropletID < 1 {
+		return nil, nil, &ValidationError{Field: "dropletID", Reason: "must be positive"}
+	}
+
+	path := fmt.Sprintf("%s/%d/%s", dropletBasePath, dropletID, key)
+	path, err := addOptions(path, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	items, resp, err := DoEnvelope[[]T](ctx, c, req, key)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *items, resp, err
+}
+
+// validateBackupPolicy checks the plan, weekday and hour of a backup policy.
+// A nil policy is valid and leaves the default policy in place.
+func validateBackupPolicy(policy *DropletBackupPolicyRequest) error {
+	if policy == nil {
+		return nil
+	}
+
+	switch policy.Plan {
+	case "", DropletBackupPlanDaily:
+		if policy.Weekday != "" {
+			return &ValidationError{Field: "backup_policy.weekday", Value: policy.Weekday, Reason: "is only used by weekly plans"}
+		}
+	case DropletBackupPlanWeekly:
+		if policy.Weekday != "" && !containsString(dropletBackupWeekdays, policy.Weekday) {
+			return &ValidationError{Field: "backup_policy.weekday", Value: policy.Weekday, Reason: "must be one of SUN, MON, TUE, WED, THU, FRI or SAT"}
+		}
+	default:
+		return &ValidationError{Field: "backup_policy.plan", Value: policy.Plan, Reason: "must be daily or weekly"}
+	}
+
+	if h := policy.Hour; h != nil && (*h < 0 || *h > 20 || *h%4 != 0) {
+		return &ValidationError{Field: "backup_policy.hour", Value: fmt.Sprint(*h), Reason: "must be one of 0, 4, 8, 12, 16 or 20"}
+	}
+	return nil
+}
@@ -0,0 +1,619 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestDroplets_CreateBatch(t *testing.T) {
+	c, mux := setup(t)
+
+	var mu sync.Mutex
+	nextID := 100
+	names := make(map[int]string)
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		var req struct{ Names []string }
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decoding request: %v", err)
+		}
+		if strings.HasPrefix(req.Names[0], "rejected") {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"id":"unprocessable_entity","message":"size unavailable"}`)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		var droplets []string
+		// respond in reverse order and leave out droplets named "lost"
+		for i := len(req.Names) - 1; i >= 0; i-- {
+			if strings.HasPrefix(req.Names[i], "lost") {
+				continue
+			}
+			nextID++
+			names[nextID] = req.Names[i]
+			droplets = append(droplets, fmt.Sprintf(`{"id":%d,"name":%q,"status":"new"}`, nextID, req.Names[i]))
+		}
+		fmt.Fprintf(w, `{"droplets":[%s]}`, strings.Join(droplets, ","))
+	})
+	mux.HandleFunc("/v2/droplets/", func(w http.ResponseWriter, r *http.Request) {
+		var id int
+		fmt.Sscanf(strings.TrimPrefix(r.URL.Path, "/v2/droplets/"), "%d", &id)
+		if strings.HasSuffix(r.URL.Path, "/actions") {
+			fmt.Fprintf(w, `{"actions":[{"id":%d,"type":"create","status":"in-progress","resource_id":%d}]}`, id+1000, id)
+			return
+		}
+		mu.Lock()
+		name := names[id]
+		mu.Unlock()
+		if strings.HasPrefix(name, "failed") {
+			t.Errorf("%s: expected the droplet not to be polled after its create failed", name)
+		}
+		fmt.Fprintf(w, `{"droplet":{"id":%d,"name":%q,"status":"active"}}`, id, name)
+	})
+	// the create actions of droplets named "failed" error
+	mux.HandleFunc("/v2/actions/", func(w http.ResponseWriter, r *http.Request) {
+		var id int
+		fmt.Sscanf(strings.TrimPrefix(r.URL.Path, "/v2/actions/"), "%d", &id)
+		mu.Lock()
+		name := names[id-1000]
+		mu.Unlock()
+		status := ActionCompleted
+		if strings.HasPrefix(name, "failed") {
+			status = ActionErrored
+		}
+		fmt.Fprintf(w, `{"action":{"id":%d,"type":"create","status":%q,"resource_id":%d}}`, id, status, id-1000)
+	})
+
+	var requested []string
+	for i := 0; i < 10; i++ {
+		requested = append(requested, fmt.Sprintf("web-%d", i))
+	}
+	requested[3] = "lost-3"
+	requested[5] = "failed-5"
+	for i := 0; i < 10; i++ {
+		requested = append(requested, fmt.Sprintf("rejected-%d", i))
+	}
+	requested = append(requested, "db-0", "db-1")
+
+	results, err := c.Droplets.CreateBatch(context.Background(),
+		&DropletMultiCreateRequest{Names: requested, Region: "nyc3", Size: "s-1vcpu-1gb"},
+		WaitOptions{PollInterval: time.Millisecond})
+	if err == nil {
+		t.Fatal("expected an error for the failed droplets")
+	}
+	if len(results) != len(requested) {
+		t.Fatalf("expected %d results, got %d", len(requested), len(results))
+	}
+
+	for i, r := range results {
+		if r.Name != requested[i] {
+			t.Errorf("result %d: expected name %q, got %q", i, requested[i], r.Name)
+		}
+
+		switch {
+		case strings.HasPrefix(r.Name, "lost"):
+			if r.Err == nil || !strings.Contains(r.Err.Error(), "missing from create response") {
+				t.Errorf("%s: expected missing droplet error, got %v", r.Name, r.Err)
+			}
+		case strings.HasPrefix(r.Name, "failed"):
+			var aerr *ActionError
+			if !errors.As(r.Err, &aerr) || aerr.Action.Status != ActionErrored {
+				t.Errorf("%s: expected *ActionError, got %v", r.Name, r.Err)
+			}
+		case strings.HasPrefix(r.Name, "rejected"):
+			if _, ok := r.Err.(*ErrorResponse); !ok {
+				t.Errorf("%s: expected *ErrorResponse, got %v", r.Name, r.Err)
+			}
+		default:
+			if r.Err != nil {
+				t.Errorf("%s: unexpected error %v", r.Name, r.Err)
+				continue
+			}
+			if r.Droplet.Name != r.Name || r.Droplet.Status != DropletActive {
+				t.Errorf("%s: got droplet %q with status %q", r.Name, r.Droplet.Name, r.Droplet.Status)
+			}
+		}
+	}
+
+	for _, name := range []string{"lost-3", "failed-5", "rejected-0", "rejected-9"} {
+		if !strings.Contains(err.Error(), fmt.Sprintf("%q", name)) {
+			t.Errorf("expected the error to mention %s: %v", name, err)
+		}
+	}
+	if strings.Contains(err.Error(), `"web-0"`) {
+		t.Errorf("expected the error not to mention web-0: %v", err)
+	}
+}
+
+func TestDroplets_CreateBatch_validation(t *testing.T) {
+	c, _ := setup(t)
+
+	for _, req := range []*DropletMultiCreateRequest{nil, {}} {
+		if _, err := c.Droplets.CreateBatch(context.Background(), req, WaitOptions{}); err == nil {
+			t.Errorf("expected a validation error for %+v", req)
+		} else if _, ok := err.(*ValidationError); !ok {
+			t.Errorf("expected *ValidationError, got %v", err)
+		}
+	}
+}
+
+func TestDroplet_IPAddresses(t *testing.T) {
+	d := &Droplet{}
+	if _, err := d.PublicIPv4(); err != ErrNoNetworks {
+		t.Errorf("PublicIPv4: expected ErrNoNetworks, got %v", err)
+	}
+	if _, err := d.PublicIPv6(); err != ErrNoNetworks {
+		t.Errorf("PublicIPv6: expected ErrNoNetworks, got %v", err)
+	}
+
+	d.Networks = &Networks{
+		V4: []NetworkV4{
+			{IPAddress: "10.0.0.2", Type: "private"},
+			{IPAddress: "203.0.113.5", Type: "public"},
+		},
+	}
+	if ip, err := d.PublicIPv4(); err != nil || ip != "203.0.113.5" {
+		t.Errorf("PublicIPv4: got %q, %v", ip, err)
+	}
+	if ip, err := d.PrivateIPv4(); err != nil || ip != "10.0.0.2" {
+		t.Errorf("PrivateIPv4: got %q, %v", ip, err)
+	}
+	if ip, err := d.PublicIPv6(); err != nil || ip != "" {
+		t.Errorf("PublicIPv6: expected no address, got %q, %v", ip, err)
+	}
+}
+
+func TestNetworkV6_JSON(t *testing.T) {
+	var n NetworkV6
+	blob := `{"ip_address":"2604:a880:800:10::1a:f001","netmask":64,"gateway":"2604:a880:800:10::1","type":"public"}`
+	if err := json.Unmarshal([]byte(blob), &n); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+
+	if got := n.IPAddress.String(); got != "2604:a880:800:10::1a:f001" {
+		t.Errorf("expected the address parsed, got %s", got)
+	}
+	if got := n.Gateway.String(); got != "2604:a880:800:10::1" {
+		t.Errorf("expected the gateway parsed, got %s", got)
+	}
+	if got := n.Prefix().String(); got != "2604:a880:800:10::/64" {
+		t.Errorf("expected prefix 2604:a880:800:10::/64, got %s", got)
+	}
+
+	d := &Droplet{Networks: &Networks{V6: []NetworkV6{n}}}
+	if ip, err := d.PublicIPv6(); err != nil || ip != "2604:a880:800:10::1a:f001" {
+		t.Errorf("PublicIPv6: got %q, %v", ip, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"ip_address":"not an address"}`), &n); err == nil {
+		t.Error("expected an error for an invalid address")
+	}
+}
+
+func TestDroplets_List(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		q := r.URL.Query()
+		if q.Get("page") != "2" || q.Get("per_page") != "10" {
+			t.Errorf("expected page 2 of 10 droplets, got %q", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"droplets":[{"id":1,"name":"web-1"},{"id":2,"name":"web-2"}],"meta":{"total":12}}`)
+	})
+
+	droplets, resp, err := c.Droplets.List(context.Background(), &ListOptions{Page: 2, PerPage: 10})
+	if err != nil {
+		t.Fatalf("Droplets.List returned error: %v", err)
+	}
+	if len(droplets) != 2 || droplets[0].ID != 1 || droplets[1].Name != "web-2" {
+		t.Errorf("got droplets %+v", droplets)
+	}
+	if resp.Meta == nil || resp.Meta.Total != 12 {
+		t.Errorf("expected meta total 12, got %+v", resp.Meta)
+	}
+}
+
+func TestDroplets_ListByTag(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("tag_name"); got != "prod" {
+			t.Errorf("expected droplets listed by tag prod, got %q", got)
+		}
+		fmt.Fprint(w, `{"droplets":[{"id":1}]}`)
+	})
+
+	droplets, _, err := c.Droplets.ListByTag(context.Background(), "prod", nil)
+	if err != nil {
+		t.Fatalf("Droplets.ListByTag returned error: %v", err)
+	}
+	if len(droplets) != 1 {
+		t.Errorf("got droplets %+v", droplets)
+	}
+
+	var verr *ValidationError
+	if _, _, err := c.Droplets.ListByTag(context.Background(), "", nil); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for an empty tag, got %v", err)
+	}
+}
+
+func TestDroplets_Get(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/droplets/12345", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"droplet":{"id":12345,"name":"web","memory":1024,"vcpus":1,"disk":25,"status":"active","region":{"slug":"nyc3"}}}`)
+	})
+
+	droplet, _, err := c.Droplets.Get(context.Background(), 12345)
+	if err != nil {
+		t.Fatalf("Droplets.Get returned error: %v", err)
+	}
+	if droplet.ID != 12345 || droplet.Status != DropletActive || droplet.Memory != 1024 || droplet.Region == nil || droplet.Region.Slug != "nyc3" {
+		t.Errorf("got droplet %+v", droplet)
+	}
+
+	var verr *ValidationError
+	if _, _, err := c.Droplets.Get(context.Background(), 0); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for droplet 0, got %v", err)
+	}
+}
+
+func TestDroplets_Create(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if body["name"] != "web" || body["region"] != "nyc3" || body["size"] != "s-1vcpu-1gb" || body["image"] != "ubuntu-22-04-x64" {
+			t.Errorf("got request body %v", body)
+		}
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"droplet":{"id":1,"name":"web","status":"new"},"links":{"actions":[{"id":7,"rel":"create","href":"https://api.example.com/v2/actions/7"}]}}`)
+	})
+
+	droplet, resp, err := c.Droplets.Create(context.Background(), &DropletCreateRequest{
+		Name:   "web",
+		Region: "nyc3",
+		Size:   "s-1vcpu-1gb",
+		Image:  DropletCreateImage{Slug: "ubuntu-22-04-x64"},
+	})
+	if err != nil {
+		t.Fatalf("Droplets.Create returned error: %v", err)
+	}
+	if droplet.ID != 1 || droplet.Status != "new" {
+		t.Errorf("got droplet %+v", droplet)
+	}
+	if id := linkedActionID(resp, "create"); id != 7 {
+		t.Errorf("expected the create action linked, got %d", id)
+	}
+
+	var verr *ValidationError
+	if _, _, err := c.Droplets.Create(context.Background(), nil); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for a nil request, got %v", err)
+	}
+}
+
+func TestDroplets_CreateAndWait(t *testing.T) {
+	c, mux := setup(t)
+
+	var requests []string
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		requests = append(requests, "create")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"droplet":{"id":1,"name":"web","status":"new"},"links":{"actions":[{"id":7,"rel":"create","href":"https://api.example.com/v2/actions/7"}]}}`)
+	})
+	polls := 0
+	mux.HandleFunc("/v2/actions/7", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		requests = append(requests, "action")
+		polls++
+		status := ActionInProgress
+		if polls > 1 {
+			status = ActionCompleted
+		}
+		fmt.Fprintf(w, `{"action":{"id":7,"status":%q}}`, status)
+	})
+	mux.HandleFunc("/v2/droplets/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		requests = append(requests, "droplet")
+		fmt.Fprint(w, `{"droplet":{"id":1,"name":"web","status":"active","networks":{"v4":[{"ip_address":"192.0.2.1","type":"public"}]}}}`)
+	})
+
+	droplet, _, err := c.Droplets.CreateAndWait(context.Background(), &DropletCreateRequest{
+		Name:   "web",
+		Region: "nyc3",
+		Size:   "s-1vcpu-1gb",
+		Image:  DropletCreateImage{Slug: "ubuntu-22-04-x64"},
+	}, WaitOptions{PollInterval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Droplets.CreateAndWait returned error: %v", err)
+	}
+	if droplet.Status != DropletActive || droplet.Networks == nil || len(droplet.Networks.V4) != 1 {
+		t.Errorf("got droplet %+v", droplet)
+	}
+	if fmt.Sprint(requests) != "[create action action droplet]" {
+		t.Errorf("expected the droplet fetched once its create action completed, got %v", requests)
+	}
+}
+
+func TestDroplets_CreateAndWait_actionErrored(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"droplet":{"id":1,"name":"web","status":"new"},"links":{"actions":[{"id":7,"rel":"create","href":"https://api.example.com/v2/actions/7"}]}}`)
+	})
+	mux.HandleFunc("/v2/actions/7", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"action":{"id":7,"status":"errored"}}`)
+	})
+	mux.HandleFunc("/v2/droplets/1", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("expected the droplet not to be fetched after its create failed")
+	})
+
+	var aerr *ActionError
+	_, _, err := c.Droplets.CreateAndWait(context.Background(), &DropletCreateRequest{
+		Name:   "web",
+		Region: "nyc3",
+		Size:   "s-1vcpu-1gb",
+		Image:  DropletCreateImage{Slug: "ubuntu-22-04-x64"},
+	}, WaitOptions{PollInterval: time.Millisecond})
+	if !errors.As(err, &aerr) || aerr.Action.ID != 7 {
+		t.Errorf("expected *ActionError for action 7, got %v", err)
+	}
+}
+
+func TestDroplets_CreateAndWait_withoutAction(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"droplet":{"id":1,"name":"web","status":"new"}}`)
+	})
+	polls := 0
+	mux.HandleFunc("/v2/droplets/1", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		status := "new"
+		if polls == 2 {
+			status = DropletActive
+		}
+		fmt.Fprintf(w, `{"droplet":{"id":1,"name":"web","status":%q}}`, status)
+	})
+
+	droplet, _, err := c.Droplets.CreateAndWait(context.Background(), &DropletCreateRequest{
+		Name:   "web",
+		Region: "nyc3",
+		Size:   "s-1vcpu-1gb",
+		Image:  DropletCreateImage{Slug: "ubuntu-22-04-x64"},
+	}, WaitOptions{PollInterval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Droplets.CreateAndWait returned error: %v", err)
+	}
+	if droplet.Status != DropletActive || polls != 2 {
+		t.Errorf("got droplet %+v after %d polls", droplet, polls)
+	}
+}
+
+func TestDropletCreateImage_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		image DropletCreateImage
+		want  string
+	}{
+		{image: DropletCreateImage{Slug: "ubuntu-22-04-x64"}, want: `"ubuntu-22-04-x64"`},
+		{image: DropletCreateImage{ID: 3}, want: `3`},
+		{image: DropletCreateImage{ID: 3, Slug: "ubuntu-22-04-x64"}, want: `"ubuntu-22-04-x64"`},
+	}
+
+	for _, tt := range tests {
+		got, err := json.Marshal(tt.image)
+		if err != nil {
+			t.Fatalf("Marshal returned error: %v", err)
+		}
+		if string(got) != tt.want {
+			t.Errorf("%+v: expected %s, got %s", tt.image, tt.want, got)
+		}
+	}
+}
+
+func TestDroplets_Delete(t *testing.T) {
+	c, mux := setup(t)
+
+	var deleted []string
+	mux.HandleFunc("/v2/droplets/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		deleted = append(deleted, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		deleted = append(deleted, "tag:"+r.URL.Query().Get("tag_name"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := c.Droplets.Delete(context.Background(), 1); err != nil {
+		t.Fatalf("Droplets.Delete returned error: %v", err)
+	}
+	if _, err := c.Droplets.DeleteByTag(context.Background(), "staging"); err != nil {
+		t.Fatalf("Droplets.DeleteByTag returned error: %v", err)
+	}
+	if fmt.Sprint(deleted) != "[/v2/droplets/1 tag:staging]" {
+		t.Errorf("got deletes %v", deleted)
+	}
+
+	var verr *ValidationError
+	if _, err := c.Droplets.Delete(context.Background(), -1); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for droplet -1, got %v", err)
+	}
+	if _, err := c.Droplets.DeleteByTag(context.Background(), ""); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for an empty tag, got %v", err)
+	}
+}
+
+func TestDroplets_subLists(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/droplets/1/kernels", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"kernels":[{"id":10,"name":"k","version":"5.15"}]}`)
+	})
+	mux.HandleFunc("/v2/droplets/1/snapshots", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"snapshots":[{"id":20},{"id":21}]}`)
+	})
+	mux.HandleFunc("/v2/droplets/1/backups", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"backups":[{"id":30}]}`)
+	})
+	mux.HandleFunc("/v2/droplets/1/actions", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("page"); got != "2" {
+			t.Errorf("expected page 2 of the actions, got %q", got)
+		}
+		fmt.Fprint(w, `{"actions":[{"id":40,"type":"create"}]}`)
+	})
+
+	ctx := context.Background()
+	kernels, _, err := c.Droplets.Kernels(ctx, 1, nil)
+	if err != nil || len(kernels) != 1 || kernels[0].Version != "5.15" {
+		t.Errorf("Droplets.Kernels returned %+v, %v", kernels, err)
+	}
+	snapshots, _, err := c.Droplets.Snapshots(ctx, 1, nil)
+	if err != nil || len(snapshots) != 2 {
+		t.Errorf("Droplets.Snapshots returned %+v, %v", snapshots, err)
+	}
+	backups, _, err := c.Droplets.Backups(ctx, 1, nil)
+	if err != nil || len(backups) != 1 || backups[0].ID != 30 {
+		t.Errorf("Droplets.Backups returned %+v, %v", backups, err)
+	}
+	actions, _, err := c.Droplets.Actions(ctx, 1, &ListOptions{Page: 2})
+	if err != nil || len(actions) != 1 || actions[0].Type != "create" {
+		t.Errorf("Droplets.Actions returned %+v, %v", actions, err)
+	}
+
+	var verr *ValidationError
+	if _, _, err := c.Droplets.Kernels(ctx, 0, nil); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for droplet 0, got %v", err)
+	}
+}
+
+func TestDroplets_Neighbors(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+
+	mux.HandleFunc("/v2/droplets/1/neighbors", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"droplets":[{"id":2,"name":"web-02"}]}`)
+	})
+	mux.HandleFunc("/v2/reports/droplet_neighbors_ids", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"neighbor_ids":[[1,2],[3,4,5]]}`)
+	})
+
+	neighbors, _, err := c.Droplets.Neighbors(ctx, 1)
+	if err != nil {
+		t.Fatalf("Droplets.Neighbors returned error: %v", err)
+	}
+	if len(neighbors) != 1 || neighbors[0].ID != 2 {
+		t.Errorf("got neighbors %+v", neighbors)
+	}
+
+	ids, _, err := c.Droplets.NeighborIDs(ctx)
+	if err != nil {
+		t.Fatalf("Droplets.NeighborIDs returned error: %v", err)
+	}
+	if fmt.Sprint(ids) != "[[1 2] [3 4 5]]" {
+		t.Errorf("got neighbor IDs %v", ids)
+	}
+
+	var verr *ValidationError
+	if _, _, err := c.Droplets.Neighbors(ctx, 0); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for droplet 0, got %v", err)
+	}
+}
+
+func TestDroplets_backupPolicies(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+
+	mux.HandleFunc("/v2/droplets/1/backups/policy", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"policy":{"droplet_id":1,"backup_enabled":true,"backup_policy":{"plan":"weekly","weekday":"SUN","hour":20,"window_length_hours":4,"retention_period_days":28},"next_backup_window":{"start":"2024-01-07T20:00:00Z","end":"2024-01-08T00:00:00Z"}}}`)
+	})
+	mux.HandleFunc("/v2/droplets/backups/policies", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"policies":{"1":{"droplet_id":1,"backup_enabled":true},"2":{"droplet_id":2}}}`)
+	})
+	mux.HandleFunc("/v2/droplets/backups/supported_policies", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"supported_policies":[{"name":"weekly","possible_window_starts":[0,4,8,12,16,20],"window_length_hours":4,"retention_period_days":28,"possible_days":["SUN","MON"]}]}`)
+	})
+
+	policy, _, err := c.Droplets.GetBackupPolicy(ctx, 1)
+	if err != nil {
+		t.Fatalf("Droplets.GetBackupPolicy returned error: %v", err)
+	}
+	if !policy.BackupEnabled || policy.BackupPolicy == nil || policy.BackupPolicy.Weekday != "SUN" || policy.BackupPolicy.RetentionPeriodDays != 28 {
+		t.Errorf("got policy %+v", policy)
+	}
+	if w := policy.NextBackupWindow; w == nil || w.Start == nil || w.Start.Hour() != 20 {
+		t.Errorf("got next backup window %+v", w)
+	}
+
+	policies, _, err := c.Droplets.ListBackupPolicies(ctx, nil)
+	if err != nil {
+		t.Fatalf("Droplets.ListBackupPolicies returned error: %v", err)
+	}
+	if len(policies) != 2 || !policies[1].BackupEnabled || policies[2].DropletID != 2 {
+		t.Errorf("got policies %+v", policies)
+	}
+
+	supported, _, err := c.Droplets.ListSupportedBackupPolicies(ctx)
+	if err != nil {
+		t.Fatalf("Droplets.ListSupportedBackupPolicies returned error: %v", err)
+	}
+	if len(supported) != 1 || len(supported[0].PossibleWindowStarts) != 6 || fmt.Sprint(supported[0].PossibleDays) != "[SUN MON]" {
+		t.Errorf("got supported policies %+v", supported)
+	}
+
+	var verr *ValidationError
+	if _, _, err := c.Droplets.GetBackupPolicy(ctx, 0); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for droplet 0, got %v", err)
+	}
+	create := &DropletCreateRequest{Name: "web-01", Region: "nyc3", Size: "s-1vcpu-1gb", Image: DropletCreateImage{Slug: "ubuntu-22-04-x64"}, Backups: true, BackupPolicy: &DropletBackupPolicyRequest{Plan: "hourly"}}
+	if _, _, err := c.Droplets.Create(ctx, create); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for an unknown backup plan, got %v", err)
+	}
+}
+
+func TestDroplets_ListWithGPUs(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if q := r.URL.Query(); q.Get("type") != "gpus" || q.Get("page") != "2" {
+			t.Errorf("got query %q", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"droplets":[{"id":1,"gpu_info":{"count":1,"model":"nvidia_h100","vram":{"amount":80,"unit":"gib"}}}]}`)
+	})
+
+	opt := &ListOptions{Page: 2}
+	droplets, _, err := c.Droplets.ListWithGPUs(context.Background(), opt)
+	if err != nil {
+		t.Fatalf("Droplets.ListWithGPUs returned error: %v", err)
+	}
+	if len(droplets) != 1 || droplets[0].GPUInfo == nil || droplets[0].GPUInfo.Count != 1 {
+		t.Errorf("got droplets %+v", droplets)
+	}
+	if opt.Type != "" {
+		t.Errorf("expected the options of the caller unchanged, got %+v", opt)
+	}
+}
@@ -0,0 +1,49 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+const (
+	envelopeLinksKey = "links"
+	envelopeMetaKey  = "meta"
+)
+
+// DoEnvelope sends an API request whose response payload is wrapped in a root
+// key, e.g. {"tags": [...]}, and decodes the value stored under key into a
+// newly allocated value of type T. Links and Meta returned next to the payload
+// are set on the Response.
+//
+// Service methods use it instead of declaring a private root struct per
+// endpoint. A missing key leaves the returned value at its zero value.
+func DoEnvelope[T any](ctx context.Context, c *Client, req *http.Request, key string) (*T, *Response, error) {
+	root, resp, err := Do[map[string]json.RawMessage](ctx, c, req)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	v := new(T)
+	if raw, ok := (*root)[key]; ok {
+		if err := json.Unmarshal(raw, v); err != nil {
+			return nil, resp, err
+		}
+	}
+	if raw, ok := (*root)[envelopeLinksKey]; ok {
+		links := new(Links)
+		if err := json.Unmarshal(raw, links); err != nil {
+			return nil, resp, err
+		}
+		resp.Links = links
+	}
+	if raw, ok := (*root)[envelopeMetaKey]; ok {
+		meta := new(Meta)
+		if err := json.Unmarshal(raw, meta); err != nil {
+			return nil, resp, err
+		}
+		resp.Meta = meta
+	}
+
+	return v, resp, nil
+}
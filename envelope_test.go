@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestDoEnvelope(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/tags", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+			"tags": [{"name":"web"},{"name":"db"}],
+			"links": {"pages": {"next": "https://api.example.com/v2/tags?page=2"}},
+			"meta": {"total": 3}
+		}`)
+	})
+
+	req, err := c.NewRequest(context.Background(), http.MethodGet, "v2/tags", nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	tags, resp, err := DoEnvelope[[]Tag](context.Background(), c, req, "tags")
+	if err != nil {
+		t.Fatalf("DoEnvelope returned error: %v", err)
+	}
+	if len(*tags) != 2 || (*tags)[0].Name != "web" {
+		t.Errorf("expected the tags decoded, got %+v", *tags)
+	}
+	if resp.Links == nil || resp.Links.IsLastPage() {
+		t.Errorf("expected the links set on the response, got %+v", resp.Links)
+	}
+	if resp.Meta == nil || resp.Meta.Total != 3 {
+		t.Errorf("expected the meta set on the response, got %+v", resp.Meta)
+	}
+
+	req, err = c.NewRequest(context.Background(), http.MethodGet, "v2/tags", nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	missing, _, err := DoEnvelope[[]Droplet](context.Background(), c, req, "droplets")
+	if err != nil {
+		t.Fatalf("DoEnvelope returned error: %v", err)
+	}
+	if *missing != nil {
+		t.Errorf("expected a zero value for a missing key, got %+v", *missing)
+	}
+}
+
+func TestDoEnvelope_decodeError(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/tags", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"tags": {"name": "not a list"}}`)
+	})
+
+	req, err := c.NewRequest(context.Background(), http.MethodGet, "v2/tags", nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	tags, resp, err := DoEnvelope[[]Tag](context.Background(), c, req, "tags")
+	if err == nil {
+		t.Fatalf("expected a decode error, got %+v", tags)
+	}
+	if resp == nil {
+		t.Error("expected the response with the error")
+	}
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Event is an occurrence in the lifecycle of a request. It is one of
+// *RequestQueued, *RetryScheduled, *RateLimited, *CircuitOpened or
+// *ResponseDecoded.
+type Event interface {
+	event()
+}
+
+// RequestQueued is emitted when a request is held back until the rate limit
+// of its endpoint resets.
+type RequestQueued struct {
+	Request *http.Request
+	// Reset is when the rate limit is expected to reset.
+	Reset time.Time
+}
+
+// RetryScheduled is emitted when a rate limited request will be retried
+// after Delay.
+type RetryScheduled struct {
+	Request *http.Request
+	// Attempt is the zero based attempt which will be sent.
+	Attempt int
+	Delay   time.Duration
+	Err     error
+}
+
+// RateLimited is emitted when the API rate limits a request, responding with
+// 429 Too Many Requests, or 403 Forbidden with no requests remaining.
+type RateLimited struct {
+	Request  *http.Request
+	Response *Response
+	// Endpoint is the prefix used by RatesSnapshot.
+	Endpoint string
+}
+
+// CircuitOpened is emitted when the circuit breaker of the client, see
+// SetCircuitBreaker, stops sending requests to an endpoint.
+type CircuitOpened struct {
+	// Endpoint is the prefix used by RatesSnapshot.
+	Endpoint string
+	// Err is the error of the request which opened the circuit.
+	Err error
+}
+
+// ResponseDecoded is emitted when the body of a successful response has been
+// decoded.
+type ResponseDecoded struct {
+	Request  *http.Request
+	Response *Response
+}
+
+func (*RequestQueued) event()   {}
+func (*RetryScheduled) event()  {}
+func (*RateLimited) event()     {}
+func (*CircuitOpened) event()   {}
+func (*ResponseDecoded) event() {}
+
+// EventListener is called synchronously with every event emitted by a client,
+// and so should not block.
+type EventListener func(ctx context.Context, e Event)
+
+// AddEventListener is a client option for registering a listener of request
+// lifecycle events. Listeners are called in the order they were added.
+func AddEventListener(l EventListener) ClientOpt {
+	return func(c *Client) error {
+		c.listeners = append(c.listeners, l)
+		return nil
+	}
+}
+
+// Emit sends e to the event listeners of the client. It allows middleware to
+// publish events such as CircuitOpened.
+func (c *Client) Emit(ctx context.Context, e Event) {
+	for _, l := range c.listeners {
+		l(ctx, e)
+	}
+}
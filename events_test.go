@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type eventsKey struct{}
+
+func TestAddEventListener(t *testing.T) {
+	var events []string
+	listener := func(name string) EventListener {
+		return func(ctx context.Context, e Event) {
+			if ctx.Value(eventsKey{}) != "test" {
+				t.Errorf("expected the context of the request passed to the listener")
+			}
+			switch e := e.(type) {
+			case *RateLimited:
+				events = append(events, fmt.Sprintf("%s RateLimited %s %d", name, e.Endpoint, e.Response.StatusCode))
+			case *RetryScheduled:
+				events = append(events, fmt.Sprintf("%s RetryScheduled %d %v", name, e.Attempt, e.Delay))
+			case *ResponseDecoded:
+				events = append(events, fmt.Sprintf("%s ResponseDecoded %d", name, e.Response.StatusCode))
+			default:
+				events = append(events, fmt.Sprintf("%s %T", name, e))
+			}
+		}
+	}
+	c, mux := setup(t,
+		AddEventListener(listener("first")),
+		AddEventListener(listener("second")),
+		SetRateLimitStrategy(RateLimitRetryWithBackoff(1, time.Millisecond)))
+
+	var attempts int
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"id":"too_many_requests","message":"slow down"}`)
+			return
+		}
+		fmt.Fprint(w, `{"account":{}}`)
+	})
+
+	ctx := context.WithValue(context.Background(), eventsKey{}, "test")
+	if _, _, err := c.Account.Get(ctx); err != nil {
+		t.Fatalf("Account.Get returned error: %v", err)
+	}
+
+	want := []string{
+		"first RateLimited /v2/account 429",
+		"second RateLimited /v2/account 429",
+		"first RetryScheduled 1 1ms",
+		"second RetryScheduled 1 1ms",
+		"first ResponseDecoded 200",
+		"second ResponseDecoded 200",
+	}
+	if fmt.Sprint(events) != fmt.Sprint(want) {
+		t.Errorf("expected events\n%q\ngot\n%q", want, events)
+	}
+}
+
+func TestClient_Emit(t *testing.T) {
+	var got []Event
+	opened := &CircuitOpened{Endpoint: "/v2/account"}
+
+	var c *Client
+	c, mux := setup(t,
+		AddEventListener(func(ctx context.Context, e Event) {
+			got = append(got, e)
+		}),
+		SetMiddleware(func(next Doer) Doer {
+			return DoerFunc(func(req *http.Request) (*http.Response, error) {
+				c.Emit(req.Context(), opened)
+				return next.Do(req)
+			})
+		}))
+
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	req, err := c.NewRequest(context.Background(), http.MethodGet, "v2/account", nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	if _, err := c.Do(context.Background(), req, nil); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if len(got) != 1 || got[0] != opened {
+		t.Errorf("expected the event emitted by the middleware, got %v", got)
+	}
+}
@@ -0,0 +1,43 @@
+package client
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+const fieldsParam = "fields"
+
+type fieldsContextKey struct{}
+
+// WithFields returns a copy of ctx which makes NewRequest ask the API to return
+// only the given fields. It is useful for endpoints which do not take
+// ListOptions, such as fetching a single resource.
+func WithFields(ctx context.Context, fields ...string) context.Context {
+	return context.WithValue(ctx, fieldsContextKey{}, fields)
+}
+
+// fieldsFromContext returns the fields set on ctx with WithFields.
+func fieldsFromContext(ctx context.Context) []string {
+	if ctx == nil {
+		return nil
+	}
+	fields, _ := ctx.Value(fieldsContextKey{}).([]string)
+	return fields
+}
+
+// addFields sets the fields query parameter on u from ctx. Fields already
+// present in the URL, e.g. from ListOptions, take precedence.
+func addFields(ctx context.Context, u *url.URL) {
+	fields := fieldsFromContext(ctx)
+	if len(fields) == 0 {
+		return
+	}
+
+	q := u.Query()
+	if q.Get(fieldsParam) != "" {
+		return
+	}
+	q.Set(fieldsParam, strings.Join(fields, ","))
+	u.RawQuery = q.Encode()
+}
@@ -0,0 +1,43 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestWithFields(t *testing.T) {
+	c, err := New(nil)
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+
+	ctx := WithFields(context.Background(), "id", "name")
+	req, err := c.NewRequest(ctx, http.MethodGet, "v2/droplets/1", nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	if got := req.URL.Query().Get("fields"); got != "id,name" {
+		t.Errorf("expected fields id,name, got %q", got)
+	}
+
+	path, err := addOptions("v2/droplets", &ListOptions{Fields: []string{"status"}})
+	if err != nil {
+		t.Fatalf("addOptions returned error: %v", err)
+	}
+	req, err = c.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	if got := req.URL.Query()["fields"]; len(got) != 1 || got[0] != "status" {
+		t.Errorf("expected the fields of ListOptions to take precedence, got %q", got)
+	}
+
+	req, err = c.NewRequest(context.Background(), http.MethodGet, "v2/droplets/1", nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	if req.URL.RawQuery != "" {
+		t.Errorf("expected no query without fields, got %q", req.URL.RawQuery)
+	}
+}
@@ -0,0 +1,297 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const functionsNamespacesBasePath = "v2/functions/namespaces"
+
+// FunctionsTriggerScheduled is the type of triggers which invoke a function
+// on a cron schedule.
+const FunctionsTriggerScheduled = "SCHEDULED"
+
+// FunctionsNamespace represents a namespace functions are deployed to.
+type FunctionsNamespace struct {
+	ApiHost   string    `json:"api_host,omitempty"`
+	Namespace string    `json:"namespace,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	Label     string    `json:"label,omitempty"`
+	Region    string    `json:"region,omitempty"`
+	UUID      string    `json:"uuid,omitempty"`
+	Key       string    `json:"key,omitempty"`
+}
+
+// FunctionsNamespaceCreateRequest represents a request to create a namespace.
+type FunctionsNamespaceCreateRequest struct {
+	Label  string `json:"label"`
+	Region string `json:"region"`
+}
+
+// FunctionsTrigger represents a trigger invoking a function of a namespace.
+type FunctionsTrigger struct {
+	Namespace        string                   `json:"namespace,omitempty"`
+	Function         string                   `json:"function,omitempty"`
+	Type             string                   `json:"type,omitempty"`
+	Name             string                   `json:"name,omitempty"`
+	IsEnabled        bool                     `json:"is_enabled"`
+	CreatedAt        time.Time                `json:"created_at,omitempty"`
+	UpdatedAt        time.Time                `json:"updated_at,omitempty"`
+	ScheduledDetails *TriggerScheduledDetails `json:"scheduled_details,omitempty"`
+	ScheduledRuns    *TriggerScheduledRuns    `json:"scheduled_runs,omitempty"`
+}
+
+// TriggerScheduledDetails is the schedule of a scheduled trigger. Body is
+// passed to the function as its parameters.
+type TriggerScheduledDetails struct {
+	Cron string                 `json:"cron,omitempty"`
+	Body map[string]interface{} `json:"body,omitempty"`
+}
+
+// TriggerScheduledRuns holds the times a scheduled trigger last ran and will
+// run next.
+type TriggerScheduledRuns struct {
+	LastRunAt time.Time `json:"last_run_at,omitempty"`
+	NextRunAt time.Time `json:"next_run_at,omitempty"`
+}
+
+// FunctionsTriggerCreateRequest represents a request to create a trigger.
+type FunctionsTriggerCreateRequest struct {
+	Name             string                   `json:"name"`
+	Type             string                   `json:"type"`
+	Function         string                   `json:"function"`
+	IsEnabled        bool                     `json:"is_enabled"`
+	ScheduledDetails *TriggerScheduledDetails `json:"scheduled_details,omitempty"`
+}
+
+// FunctionsTriggerUpdateRequest represents a request to update a trigger.
+// Nil fields are left unchanged.
+type FunctionsTriggerUpdateRequest struct {
+	IsEnabled        *bool                    `json:"is_enabled,omitempty"`
+	ScheduledDetails *TriggerScheduledDetails `json:"scheduled_details,omitempty"`
+}
+
+/* SERVICE */
+
+// FunctionsService is an interface for managing Functions with the DigitalOcean API.
+// See: https://docs.digitalocean.com/reference/api/api-reference/#tag/Functions
+type FunctionsService interface {
+	ListNamespaces(context.Context) ([]FunctionsNamespace, *Response, error)
+	GetNamespace(context.Context, string) (*FunctionsNamespace, *Response, error)
+	CreateNamespace(context.Context, *FunctionsNamespaceCreateRequest) (*FunctionsNamespace, *Response, error)
+	DeleteNamespace(context.Context, string) (*Response, error)
+
+	ListTriggers(context.Context, string) ([]FunctionsTrigger, *Response, error)
+	GetTrigger(context.Context, string, string) (*FunctionsTrigger, *Response, error)
+	CreateTrigger(context.Context, string, *FunctionsTriggerCreateRequest) (*FunctionsTrigger, *Response, error)
+	UpdateTrigger(context.Context, string, string, *FunctionsTriggerUpdateRequest) (*FunctionsTrigger, *Response, error)
+	DeleteTrigger(context.Context, string, string) (*Response, error)
+}
+
+// FunctionsServiceOp handles communication with Functions methods of the DigitalOcean API.
+type FunctionsServiceOp struct {
+	client *Client
+}
+
+var _ FunctionsService = &FunctionsServiceOp{}
+
+// ListNamespaces gets all the namespaces of the account.
+func (s *FunctionsServiceOp) ListNamespaces(ctx context.Context) ([]FunctionsNamespace, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, functionsNamespacesBasePath, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	namespaces, resp, err := DoEnvelope[[]FunctionsNamespace](ctx, s.client, req, "namespaces")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *namespaces, resp, err
+}
+
+// GetNamespace gets a namespace's details.
+func (s *FunctionsServiceOp) GetNamespace(ctx context.Context, namespace string) (*FunctionsNamespace, *Response, error) {
+	path, err := functionsNamespacePath(namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doNamespace(ctx, req)
+}
+
+// CreateNamespace creates a namespace.
+func (s *FunctionsServiceOp) CreateNamespace(ctx context.Context, createRequest *FunctionsNamespaceCreateRequest) (*FunctionsNamespace, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, &ValidationError{Field: "createRequest", Reason: "cannot be nil"}
+	}
+	if createRequest.Label == "" {
+		return nil, nil, &ValidationError{Field: "label", Reason: "must not be empty"}
+	}
+	if createRequest.Region == "" {
+		return nil, nil, &ValidationError{Field: "region", Reason: "must not be empty"}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, functionsNamespacesBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doNamespace(ctx, req)
+}
+
+// DeleteNamespace deletes a namespace along with its functions and triggers.
+func (s *FunctionsServiceOp) DeleteNamespace(ctx context.Context, namespace string) (*Response, error) {
+	path, err := functionsNamespacePath(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListTriggers gets all the triggers of a namespace.
+func (s *FunctionsServiceOp) ListTriggers(ctx context.Context, namespace string) ([]FunctionsTrigger, *Response, error) {
+	path, err := functionsNamespacePath(namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path+"/triggers", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	triggers, resp, err := DoEnvelope[[]FunctionsTrigger](ctx, s.client, req, "triggers")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *triggers, resp, err
+}
+
+// GetTrigger gets a trigger's details.
+func (s *FunctionsServiceOp) GetTrigger(ctx context.Context, namespace, trigger string) (*FunctionsTrigger, *Response, error) {
+	path, err := functionsTriggerPath(namespace, trigger)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doTrigger(ctx, req)
+}
+
+// CreateTrigger creates a trigger for a function of a namespace.
+func (s *FunctionsServiceOp) CreateTrigger(ctx context.Context, namespace string, createRequest *FunctionsTriggerCreateRequest) (*FunctionsTrigger, *Response, error) {
+	path, err := functionsNamespacePath(namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+	if createRequest == nil {
+		return nil, nil, &ValidationError{Field: "createRequest", Reason: "cannot be nil"}
+	}
+	if createRequest.Name == "" {
+		return nil, nil, &ValidationError{Field: "name", Reason: "must not be empty"}
+	}
+	if createRequest.Function == "" {
+		return nil, nil, &ValidationError{Field: "function", Reason: "must not be empty"}
+	}
+	if createRequest.Type == FunctionsTriggerScheduled && (createRequest.ScheduledDetails == nil || createRequest.ScheduledDetails.Cron == "") {
+		return nil, nil, &ValidationError{Field: "scheduled_details.cron", Reason: "must not be empty for scheduled triggers"}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path+"/triggers", createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doTrigger(ctx, req)
+}
+
+// UpdateTrigger updates a trigger.
+func (s *FunctionsServiceOp) UpdateTrigger(ctx context.Context, namespace, trigger string, updateRequest *FunctionsTriggerUpdateRequest) (*FunctionsTrigger, *Response, error) {
+	path, err := functionsTriggerPath(namespace, trigger)
+	if err != nil {
+		return nil, nil, err
+	}
+	if updateRequest == nil {
+		return nil, nil, &ValidationError{Field: "updateRequest", Reason: "cannot be nil"}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, updateRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doTrigger(ctx, req)
+}
+
+// DeleteTrigger deletes a trigger.
+func (s *FunctionsServiceOp) DeleteTrigger(ctx context.Context, namespace, trigger string) (*Response, error) {
+	path, err := functionsTriggerPath(namespace, trigger)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *FunctionsServiceOp) doNamespace(ctx context.Context, req *http.Request) (*FunctionsNamespace, *Response, error) {
+	namespace, resp, err := DoEnvelope[FunctionsNamespace](ctx, s.client, req, "namespace")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return namespace, resp, err
+}
+
+func (s *FunctionsServiceOp) doTrigger(ctx context.Context, req *http.Request) (*FunctionsTrigger, *Response, error) {
+	trigger, resp, err := DoEnvelope[FunctionsTrigger](ctx, s.client, req, "trigger")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return trigger, resp, err
+}
+
+// functionsNamespacePath returns the path of a namespace.
+func functionsNamespacePath(namespace string) (string, error) {
+	if namespace == "" {
+		return "", &ValidationError{Field: "namespace", Reason: "must not be empty"}
+	}
+	return fmt.Sprintf("%s/%s", functionsNamespacesBasePath, namespace), nil
+}
+
+// functionsTriggerPath returns the path of a trigger of a namespace.
+func functionsTriggerPath(namespace, trigger string) (string, error) {
+	path, err := functionsNamespacePath(namespace)
+	if err != nil {
+		return "", err
+	}
+	if trigger == "" {
+		return "", &ValidationError{Field: "trigger", Reason: "must not be empty"}
+	}
+	return fmt.Sprintf("%s/triggers/%s", path, trigger), nil
+}
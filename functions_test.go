@@ -0,0 +1,185 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestFunctions_namespaces(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+
+	mux.HandleFunc("/v2/functions/namespaces", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `{"namespaces":[{"namespace":"fn-xxx","label":"my-namespace","region":"nyc1","api_host":"https://faas-nyc1-2ef2e6cc.doserverless.co"}]}`)
+			return
+		}
+		testMethod(t, r, http.MethodPost)
+		var req FunctionsNamespaceCreateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if req.Label != "my-namespace" || req.Region != "nyc1" {
+			t.Errorf("got request %+v", req)
+		}
+		fmt.Fprint(w, `{"namespace":{"namespace":"fn-xxx","label":"my-namespace","key":"secret"}}`)
+	})
+
+	var methods []string
+	mux.HandleFunc("/v2/functions/namespaces/fn-xxx", func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		fmt.Fprint(w, `{"namespace":{"namespace":"fn-xxx","label":"my-namespace"}}`)
+	})
+
+	namespaces, _, err := c.Functions.ListNamespaces(ctx)
+	if err != nil {
+		t.Fatalf("Functions.ListNamespaces returned error: %v", err)
+	}
+	if len(namespaces) != 1 || namespaces[0].ApiHost != "https://faas-nyc1-2ef2e6cc.doserverless.co" {
+		t.Errorf("got namespaces %+v", namespaces)
+	}
+
+	namespace, _, err := c.Functions.CreateNamespace(ctx, &FunctionsNamespaceCreateRequest{Label: "my-namespace", Region: "nyc1"})
+	if err != nil {
+		t.Fatalf("Functions.CreateNamespace returned error: %v", err)
+	}
+	if namespace.Key != "secret" {
+		t.Errorf("got namespace %+v", namespace)
+	}
+	if _, _, err := c.Functions.GetNamespace(ctx, "fn-xxx"); err != nil {
+		t.Fatalf("Functions.GetNamespace returned error: %v", err)
+	}
+	if _, err := c.Functions.DeleteNamespace(ctx, "fn-xxx"); err != nil {
+		t.Fatalf("Functions.DeleteNamespace returned error: %v", err)
+	}
+
+	if fmt.Sprint(methods) != "[GET DELETE]" {
+		t.Errorf("got methods %v", methods)
+	}
+}
+
+func TestFunctions_triggers(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+
+	mux.HandleFunc("/v2/functions/namespaces/fn-xxx/triggers", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `{"triggers":[{"name":"nightly","function":"cleanup","type":"SCHEDULED","is_enabled":true,"scheduled_details":{"cron":"0 0 * * *"},"scheduled_runs":{"next_run_at":"2024-01-02T00:00:00Z"}}]}`)
+			return
+		}
+		testMethod(t, r, http.MethodPost)
+		var req FunctionsTriggerCreateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if req.Name != "nightly" || req.Type != FunctionsTriggerScheduled || req.ScheduledDetails.Body["dry_run"] != true {
+			t.Errorf("got request %+v", req)
+		}
+		fmt.Fprint(w, `{"trigger":{"name":"nightly","function":"cleanup","is_enabled":true}}`)
+	})
+
+	var methods []string
+	mux.HandleFunc("/v2/functions/namespaces/fn-xxx/triggers/nightly", func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		switch r.Method {
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodPut:
+			var req map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("decoding request body: %v", err)
+			}
+			if len(req) != 1 || req["is_enabled"] != false {
+				t.Errorf("expected only is_enabled sent, got %v", req)
+			}
+			fmt.Fprint(w, `{"trigger":{"name":"nightly","is_enabled":false}}`)
+		default:
+			fmt.Fprint(w, `{"trigger":{"name":"nightly","is_enabled":false}}`)
+		}
+	})
+
+	triggers, _, err := c.Functions.ListTriggers(ctx, "fn-xxx")
+	if err != nil {
+		t.Fatalf("Functions.ListTriggers returned error: %v", err)
+	}
+	if len(triggers) != 1 || triggers[0].ScheduledDetails.Cron != "0 0 * * *" || triggers[0].ScheduledRuns.NextRunAt.IsZero() {
+		t.Errorf("got triggers %+v", triggers)
+	}
+
+	_, _, err = c.Functions.CreateTrigger(ctx, "fn-xxx", &FunctionsTriggerCreateRequest{
+		Name:             "nightly",
+		Type:             FunctionsTriggerScheduled,
+		Function:         "cleanup",
+		IsEnabled:        true,
+		ScheduledDetails: &TriggerScheduledDetails{Cron: "0 0 * * *", Body: map[string]interface{}{"dry_run": true}},
+	})
+	if err != nil {
+		t.Fatalf("Functions.CreateTrigger returned error: %v", err)
+	}
+
+	disabled := false
+	trigger, _, err := c.Functions.UpdateTrigger(ctx, "fn-xxx", "nightly", &FunctionsTriggerUpdateRequest{IsEnabled: &disabled})
+	if err != nil {
+		t.Fatalf("Functions.UpdateTrigger returned error: %v", err)
+	}
+	if trigger.IsEnabled {
+		t.Errorf("got trigger %+v", trigger)
+	}
+	if _, _, err := c.Functions.GetTrigger(ctx, "fn-xxx", "nightly"); err != nil {
+		t.Fatalf("Functions.GetTrigger returned error: %v", err)
+	}
+	if _, err := c.Functions.DeleteTrigger(ctx, "fn-xxx", "nightly"); err != nil {
+		t.Fatalf("Functions.DeleteTrigger returned error: %v", err)
+	}
+
+	if fmt.Sprint(methods) != "[PUT GET DELETE]" {
+		t.Errorf("got methods %v", methods)
+	}
+}
+
+func TestFunctions_validation(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+	s := c.Functions
+
+	calls := map[string]func() error{
+		"get empty namespace":    func() error { _, _, err := s.GetNamespace(ctx, ""); return err },
+		"delete empty namespace": func() error { _, err := s.DeleteNamespace(ctx, ""); return err },
+		"create namespace nil":   func() error { _, _, err := s.CreateNamespace(ctx, nil); return err },
+		"create namespace no label": func() error {
+			_, _, err := s.CreateNamespace(ctx, &FunctionsNamespaceCreateRequest{Region: "nyc1"})
+			return err
+		},
+		"create namespace no region": func() error {
+			_, _, err := s.CreateNamespace(ctx, &FunctionsNamespaceCreateRequest{Label: "my-namespace"})
+			return err
+		},
+		"triggers empty namespace": func() error { _, _, err := s.ListTriggers(ctx, ""); return err },
+		"get empty trigger":        func() error { _, _, err := s.GetTrigger(ctx, "fn-xxx", ""); return err },
+		"create trigger nil":       func() error { _, _, err := s.CreateTrigger(ctx, "fn-xxx", nil); return err },
+		"create trigger no function": func() error {
+			_, _, err := s.CreateTrigger(ctx, "fn-xxx", &FunctionsTriggerCreateRequest{Name: "nightly"})
+			return err
+		},
+		"create scheduled no cron": func() error {
+			_, _, err := s.CreateTrigger(ctx, "fn-xxx", &FunctionsTriggerCreateRequest{Name: "nightly", Function: "cleanup", Type: FunctionsTriggerScheduled})
+			return err
+		},
+		"update trigger nil":   func() error { _, _, err := s.UpdateTrigger(ctx, "fn-xxx", "nightly", nil); return err },
+		"delete empty trigger": func() error { _, err := s.DeleteTrigger(ctx, "fn-xxx", ""); return err },
+	}
+	for name, call := range calls {
+		var verr *ValidationError
+		if err := call(); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// imageTransferPollInterval is how often TransferAndWait checks the progress
+// of a transfer unless WaitOptions.PollInterval is set.
+const imageTransferPollInterval = 10 * time.Second
+
+// ImageTransferResult is the outcome of transferring an image to one region.
+type ImageTransferResult struct {
+	Region string
+	Action *Action
+	Err    error
+}
+
+// ImageActionsService is an interface for interfacing with the image actions
+// endpoints of the DigitalOcean API
+type ImageActionsService interface {
+	Get(context.Context, int, int) (*Action, *Response, error)
+	GetByURI(context.Context, string) (*Action, *Response, error)
+	Transfer(context.Context, int, *ActionRequest) (*Action, *Response, error)
+	TransferAndWait(context.Context, int, string, WaitOptions, func(*Action)) (*Action, *Response, error)
+	TransferToRegions(context.Context, int, []string, WaitOptions, func(string, *Action)) ([]ImageTransferResult, error)
+	Convert(context.Context, int) (*Action, *Response, error)
+}
+
+// ImageActionsServiceOp handles communication with the image action related
+// methods of the DigitalOcean API.
+type ImageActionsServiceOp struct {
+	client *Client
+}
+
+var _ ImageActionsService = &ImageActionsServiceOp{}
+
+// Transfer an image to another region, e.g. with
+// &ActionRequest{"type": "transfer", "region": "nyc3"}.
+func (s *ImageActionsServiceOp) Transfer(ctx context.Context, imageID int, transferRequest *ActionRequest) (*Action, *Response, error) {
+	if transferRequest == nil {
+		return nil, nil, &ValidationError{Field: "transferRequest", Reason: "cannot be nil"}
+	}
+
+	return s.doAction(ctx, imageID, transferRequest)
+}
+
+// TransferAndWait transfers an image or snapshot to region and waits until
+// the transfer finished, polling as configured by opts. progress, if not nil,
+// is called with the action each time it is checked. A failed transfer is
+// reported as an *ActionError, and exceeding opts.Timeout as a
+// *WaitTimeoutError.
+func (s *ImageActionsServiceOp) TransferAndWait(ctx context.Context, imageID int, region string, opts WaitOptions, progress func(*Action)) (*Action, *Response, error) {
+	if region == "" {
+		return nil, nil, &ValidationError{Field: "region", Reason: "must not be empty"}
+	}
+
+	action, resp, err := s.Transfer(ctx, imageID, &ActionRequest{"type": "transfer", "region": region})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	actionID := action.ID
+	action, err = pollFrom(ctx, opts.withDefaultPollInterval(imageTransferPollInterval), action, func(ctx context.Context) (*Action, error) {
+		latest, latestResp, err := s.Get(ctx, imageID, actionID)
+		resp = latestResp
+		return latest, err
+	}, func(action *Action) (bool, string, error) {
+		if progress != nil {
+			progress(action)
+		}
+		switch action.Status {
+		case ActionCompleted:
+			return true, action.Status, nil
+		case ActionErrored:
+			return false, action.Status, &ActionError{Action: action}
+		}
+		return false, action.Status, nil
+	})
+	return action, resp, err
+}
+
+// TransferToRegions transfers an image or snapshot to each of regions
+// concurrently and waits until all transfers finished, see TransferAndWait.
+// opts applies to the wait for each region.
+// progress, if not nil, may be called concurrently for different regions. It
+// reports the result for each region in order, and returns an error joining
+// the errors of all regions which failed.
+func (s *ImageActionsServiceOp) TransferToRegions(ctx context.Context, imageID int, regions []string, opts WaitOptions, progress func(string, *Action)) ([]ImageTransferResult, error) {
+	if imageID < 1 {
+		return nil, &ValidationError{Field: "imageID", Reason: "must be positive"}
+	}
+	if len(regions) == 0 {
+		return nil, &ValidationError{Field: "regions", Reason: "must not be empty"}
+	}
+
+	results := make([]ImageTransferResult, len(regions))
+	runBounded(ctx, len(regions), bulkConcurrency, func(i int) {
+		region := regions[i]
+		var onProgress func(*Action)
+		if progress != nil {
+			onProgress = func(a *Action) { progress(region, a) }
+		}
+		action, _, err := s.TransferAndWait(ctx, imageID, region, opts, onProgress)
+		results[i] = ImageTransferResult{Region: region, Action: action, Err: err}
+	}, func(i int, err error) {
+		results[i] = ImageTransferResult{Region: regions[i], Err: err}
+	})
+
+	errs := make([]error, 0, len(results))
+	for _, r := range results {
+		if r.Err != nil {
+			errs = append(errs, fmt.Errorf("region %q: %w", r.Region, r.Err))
+		}
+	}
+	return results, errors.Join(errs...)
+}
+
+// Convert an image, such as a backup, to a snapshot.
+func (s *ImageActionsServiceOp) Convert(ctx context.Context, imageID int) (*Action, *Response, error) {
+	return s.doAction(ctx, imageID, &ActionRequest{"type": "convert"})
+}
+
+// Get an action for a particular image by id.
+func (s *ImageActionsServiceOp) Get(ctx context.Context, imageID, actionID int) (*Action, *Response, error) {
+	if imageID < 1 {
+		return nil, nil, &ValidationError{Field: "imageID", Reason: "must be positive"}
+	}
+	if actionID < 1 {
+		return nil, nil, &ValidationError{Field: "actionID", Reason: "must be positive"}
+	}
+
+	path := fmt.Sprintf("%s/%d/actions/%d", imageBasePath, imageID, actionID)
+	return s.get(ctx, path)
+}
+
+// GetByURI gets an action for a particular image by URI, e.g. the href of an
+// action link.
+func (s *ImageActionsServiceOp) GetByURI(ctx context.Context, rawurl string) (*Action, *Response, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.get(ctx, u.Path)
+}
+
+func (s *ImageActionsServiceOp) doAction(ctx context.Context, imageID int, request *ActionRequest) (*Action, *Response, error) {
+	if imageID < 1 {
+		return nil, nil, &ValidationError{Field: "imageID", Reason: "must be positive"}
+	}
+
+	path := fmt.Sprintf("%s/%d/actions", imageBasePath, imageID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	action, resp, err := DoEnvelope[Action](ctx, s.client, req, "action")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return action, resp, err
+}
+
+func (s *ImageActionsServiceOp) get(ctx context.Context, path string) (*Action, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	action, resp, err := DoEnvelope[Action](ctx, s.client, req, "action")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return action, resp, err
+}
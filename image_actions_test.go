@@ -0,0 +1,170 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestImageActions_Transfer(t *testing.T) {
+	c, mux := setup(t)
+
+	var got []ActionRequest
+	mux.HandleFunc("/v2/images/12/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var req ActionRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		got = append(got, req)
+		fmt.Fprintf(w, `{"action":{"id":2,"type":%q,"status":"in-progress","resource_type":"image"}}`, req["type"])
+	})
+
+	action, _, err := c.ImageActions.Transfer(context.Background(), 12, &ActionRequest{"type": "transfer", "region": "ams3"})
+	if err != nil {
+		t.Fatalf("ImageActions.Transfer returned error: %v", err)
+	}
+	if action.ID != 2 || action.Type != "transfer" {
+		t.Errorf("got action %+v", action)
+	}
+	if _, _, err := c.ImageActions.Convert(context.Background(), 12); err != nil {
+		t.Fatalf("ImageActions.Convert returned error: %v", err)
+	}
+
+	want := []ActionRequest{{"type": "transfer", "region": "ams3"}, {"type": "convert"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected requests %v, got %v", want, got)
+	}
+
+	var verr *ValidationError
+	if _, _, err := c.ImageActions.Transfer(context.Background(), 12, nil); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for a nil request, got %v", err)
+	}
+	if _, _, err := c.ImageActions.Convert(context.Background(), 0); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for image 0, got %v", err)
+	}
+}
+
+func TestImageActions_Get(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/images/12/actions/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"action":{"id":2,"status":"completed"}}`)
+	})
+
+	action, _, err := c.ImageActions.Get(context.Background(), 12, 2)
+	if err != nil {
+		t.Fatalf("ImageActions.Get returned error: %v", err)
+	}
+	if action.ID != 2 || action.Status != ActionCompleted {
+		t.Errorf("got action %+v", action)
+	}
+
+	action, _, err = c.ImageActions.GetByURI(context.Background(), "https://api.example.com/v2/images/12/actions/2")
+	if err != nil {
+		t.Fatalf("ImageActions.GetByURI returned error: %v", err)
+	}
+	if action.ID != 2 {
+		t.Errorf("got action %+v", action)
+	}
+
+	var verr *ValidationError
+	if _, _, err := c.ImageActions.Get(context.Background(), 12, 0); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for action 0, got %v", err)
+	}
+}
+
+func TestImageActions_TransferAndWait(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/images/12/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"action":{"id":2,"type":"transfer","status":"in-progress"}}`)
+	})
+	var polls int
+	mux.HandleFunc("/v2/images/12/actions/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		polls++
+		status := ActionInProgress
+		if polls == 2 {
+			status = ActionCompleted
+		}
+		fmt.Fprintf(w, `{"action":{"id":2,"type":"transfer","status":%q}}`, status)
+	})
+
+	var statuses []string
+	action, _, err := c.ImageActions.TransferAndWait(context.Background(), 12, "ams3", WaitOptions{PollInterval: time.Millisecond}, func(a *Action) {
+		statuses = append(statuses, a.Status)
+	})
+	if err != nil {
+		t.Fatalf("ImageActions.TransferAndWait returned error: %v", err)
+	}
+	if action.Status != ActionCompleted {
+		t.Errorf("got action %+v", action)
+	}
+	if fmt.Sprint(statuses) != "[in-progress in-progress completed]" {
+		t.Errorf("got progress %v", statuses)
+	}
+
+	var verr *ValidationError
+	if _, _, err := c.ImageActions.TransferAndWait(context.Background(), 12, "", WaitOptions{}, nil); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for an empty region, got %v", err)
+	}
+}
+
+func TestImageActions_TransferToRegions(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/images/12/actions", func(w http.ResponseWriter, r *http.Request) {
+		var req ActionRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decoding request body: %v", err)
+		}
+		id := 2
+		if req["region"] == "ams3" {
+			id = 3
+		}
+		fmt.Fprintf(w, `{"action":{"id":%d,"type":"transfer","status":"in-progress"}}`, id)
+	})
+	mux.HandleFunc("/v2/images/12/actions/2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"action":{"id":2,"status":"completed"}}`)
+	})
+	mux.HandleFunc("/v2/images/12/actions/3", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"action":{"id":3,"status":"errored"}}`)
+	})
+
+	var mu sync.Mutex
+	progress := map[string]int{}
+	results, err := c.ImageActions.TransferToRegions(context.Background(), 12, []string{"nyc3", "ams3"}, WaitOptions{PollInterval: time.Millisecond}, func(region string, a *Action) {
+		mu.Lock()
+		defer mu.Unlock()
+		progress[region]++
+	})
+
+	var aerr *ActionError
+	if !errors.As(err, &aerr) || aerr.Action.ID != 3 || !strings.Contains(err.Error(), `region "ams3"`) {
+		t.Fatalf("expected the *ActionError of ams3, got %v", err)
+	}
+	if len(results) != 2 || results[0].Region != "nyc3" || results[0].Err != nil || results[0].Action.Status != ActionCompleted {
+		t.Errorf("got nyc3 result %+v", results[0])
+	}
+	if results[1].Region != "ams3" || results[1].Err == nil {
+		t.Errorf("got ams3 result %+v", results[1])
+	}
+	if progress["nyc3"] == 0 || progress["ams3"] == 0 {
+		t.Errorf("expected progress of both regions, got %v", progress)
+	}
+
+	var verr *ValidationError
+	if _, err := c.ImageActions.TransferToRegions(context.Background(), 12, nil, WaitOptions{}, nil); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for no regions, got %v", err)
+	}
+}
@@ -0,0 +1,296 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const imageBasePath = "v2/images"
+
+// imageImportPollInterval is how often CreateAndWait checks the status of an
+// imported image unless WaitOptions.PollInterval is set.
+const imageImportPollInterval = 10 * time.Second
+
+// Image statuses
+const (
+	// ImageNew is the status of a custom image which is being imported.
+	ImageNew = "NEW"
+	// ImagePending is the status of an image which is being processed.
+	ImagePending = "pending"
+	// ImageAvailable is the status of an image which can be used.
+	ImageAvailable = "available"
+	// ImageDeleted is the status of a deleted image.
+	ImageDeleted = "deleted"
+)
+
+// Image represents a DigitalOcean Image
+type Image struct {
+	ID            int      `json:"id,omitempty"`
+	Name          string   `json:"name,omitempty"`
+	Type          string   `json:"type,omitempty"`
+	Distribution  string   `json:"distribution,omitempty"`
+	Slug          string   `json:"slug,omitempty"`
+	Public        bool     `json:"public,omitempty"`
+	Regions       []string `json:"regions,omitempty"`
+	MinDiskSize   int      `json:"min_disk_size,omitempty"`
+	SizeGigaBytes float64  `json:"size_gigabytes,omitempty"`
+	Created       string   `json:"created_at,omitempty"`
+	Description   string   `json:"description,omitempty"`
+	Tags          []string `json:"tags,omitempty"`
+	Status        string   `json:"status,omitempty"`
+	ErrorMessage  string   `json:"error_message,omitempty"`
+}
+
+// ImageUpdateRequest represents a request to update an image.
+type ImageUpdateRequest struct {
+	Name         string `json:"name,omitempty"`
+	Distribution string `json:"distribution,omitempty"`
+	Description  string `json:"description,omitempty"`
+}
+
+// CustomImageCreateRequest represents a request to create a custom image by
+// importing it from a URL.
+type CustomImageCreateRequest struct {
+	Name         string   `json:"name"`
+	Url          string   `json:"url"`
+	Region       string   `json:"region"`
+	Distribution string   `json:"distribution,omitempty"`
+	Description  string   `json:"description,omitempty"`
+	Tags         []string `json:"tags,omitempty"`
+}
+
+// ImageImportError occurs when the import of a custom image fails.
+type ImageImportError struct {
+	Image *Image
+}
+
+func (e *ImageImportError) Error() string {
+	return fmt.Sprintf("importing image %d: %s", e.Image.ID, e.Image.ErrorMessage)
+}
+
+// imageListOptions adds the image specific filters to ListOptions.
+type imageListOptions struct {
+	ListOptions
+	Private bool `url:"private,omitempty"`
+}
+
+/* SERVICE */
+
+// ImagesService is an interface for interfacing with the images
+// endpoints of the DigitalOcean API
+type ImagesService interface {
+	List(context.Context, *ListOptions) ([]Image, *Response, error)
+	ListAll(context.Context, *ListAllOptions) ([]Image, *Response, error)
+	ListDistribution(context.Context, *ListOptions) ([]Image, *Response, error)
+	ListApplication(context.Context, *ListOptions) ([]Image, *Response, error)
+	ListUser(context.Context, *ListOptions) ([]Image, *Response, error)
+	ListByTag(context.Context, string, *ListOptions) ([]Image, *Response, error)
+	GetByID(context.Context, int) (*Image, *Response, error)
+	GetBySlug(context.Context, string) (*Image, *Response, error)
+	Create(context.Context, *CustomImageCreateRequest) (*Image, *Response, error)
+	CreateAndWait(context.Context, *CustomImageCreateRequest, WaitOptions) (*Image, *Response, error)
+	Update(context.Context, int, *ImageUpdateRequest) (*Image, *Response, error)
+	Delete(context.Context, int) (*Response, error)
+}
+
+// ImagesServiceOp handles communication with the image related methods of the
+// DigitalOcean API.
+type ImagesServiceOp struct {
+	client *Client
+}
+
+var _ ImagesService = &ImagesServiceOp{}
+
+// List lists all the images available.
+func (s *ImagesServiceOp) List(ctx context.Context, opt *ListOptions) ([]Image, *Response, error) {
+	return s.list(ctx, opt, imageListOptions{})
+}
+
+// ListAll images across all pages
+func (s *ImagesServiceOp) ListAll(ctx context.Context, opt *ListAllOptions) ([]Image, *Response, error) {
+	return ListAll[Image](ctx, s.List, opt)
+}
+
+// ListDistribution lists all the distribution images.
+func (s *ImagesServiceOp) ListDistribution(ctx context.Context, opt *ListOptions) ([]Image, *Response, error) {
+	return s.list(ctx, opt, imageListOptions{ListOptions: ListOptions{Type: "distribution"}})
+}
+
+// ListApplication lists all the application images.
+func (s *ImagesServiceOp) ListApplication(ctx context.Context, opt *ListOptions) ([]Image, *Response, error) {
+	return s.list(ctx, opt, imageListOptions{ListOptions: ListOptions{Type: "application"}})
+}
+
+// ListUser lists all the user images.
+func (s *ImagesServiceOp) ListUser(ctx context.Context, opt *ListOptions) ([]Image, *Response, error) {
+	return s.list(ctx, opt, imageListOptions{Private: true})
+}
+
+// ListByTag lists all images with a specific tag applied.
+func (s *ImagesServiceOp) ListByTag(ctx context.Context, tag string, opt *ListOptions) ([]Image, *Response, error) {
+	if err := validateTagName(tag); err != nil {
+		return nil, nil, err
+	}
+
+	return s.list(ctx, opt, imageListOptions{ListOptions: ListOptions{TagName: tag}})
+}
+
+// GetByID retrieves an image by id.
+func (s *ImagesServiceOp) GetByID(ctx context.Context, imageID int) (*Image, *Response, error) {
+	if imageID < 1 {
+		return nil, nil, &ValidationError{Field: "imageID", Reason: "must be positive"}
+	}
+
+	return s.get(ctx, fmt.Sprint(imageID))
+}
+
+// GetBySlug retrieves an image by slug.
+func (s *ImagesServiceOp) GetBySlug(ctx context.Context, slug string) (*Image, *Response, error) {
+	if slug == "" {
+		return nil, nil, &ValidationError{Field: "slug", Reason: "must not be empty"}
+	}
+
+	return s.get(ctx, slug)
+}
+
+// Create a custom image by importing it from a URL. The import continues after
+// Create returns, the image has the status ImageNew until it is available;
+// use CreateAndWait to wait for it.
+func (s *ImagesServiceOp) Create(ctx context.Context, createRequest *CustomImageCreateRequest) (*Image, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, &ValidationError{Field: "createRequest", Reason: "cannot be nil"}
+	}
+	if createRequest.Url == "" {
+		return nil, nil, &ValidationError{Field: "url", Reason: "must not be empty"}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, imageBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	image, resp, err := DoEnvelope[Image](ctx, s.client, req, "image")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return image, resp, err
+}
+
+// CreateAndWait creates a custom image and waits until its import finished,
+// polling as configured by opts. A failed import is reported as an
+// *ImageImportError, and exceeding opts.Timeout as a *WaitTimeoutError with
+// the last status seen.
+func (s *ImagesServiceOp) CreateAndWait(ctx context.Context, createRequest *CustomImageCreateRequest, opts WaitOptions) (*Image, *Response, error) {
+	image, resp, err := s.Create(ctx, createRequest)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	imageID := image.ID
+	image, err = pollFrom(ctx, opts.withDefaultPollInterval(imageImportPollInterval), image, func(ctx context.Context) (*Image, error) {
+		latest, latestResp, err := s.GetByID(ctx, imageID)
+		resp = latestResp
+		return latest, err
+	}, func(image *Image) (bool, string, error) {
+		switch {
+		case image.ErrorMessage != "":
+			return false, image.Status, &ImageImportError{Image: image}
+		case image.Status == ImageAvailable:
+			return true, image.Status, nil
+		}
+		return false, image.Status, nil
+	})
+	return image, resp, err
+}
+
+// Update an image name.
+func (s *ImagesServiceOp) Update(ctx context.Context, imageID int, updateRequest *ImageUpdateRequest) (*Image, *Response, error) {
+	if imageID < 1 {
+		return nil, nil, &ValidationError{Field: "imageID", Reason: "must be positive"}
+	}
+	if updateRequest == nil {
+		return nil, nil, &ValidationError{Field: "updateRequest", Reason: "cannot be nil"}
+	}
+
+	path := fmt.Sprintf("%s/%d", imageBasePath, imageID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, updateRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	image, resp, err := DoEnvelope[Image](ctx, s.client, req, "image")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return image, resp, err
+}
+
+// Delete an image.
+func (s *ImagesServiceOp) Delete(ctx context.Context, imageID int) (*Response, error) {
+	if imageID < 1 {
+		return nil, &ValidationError{Field: "imageID", Reason: "must be positive"}
+	}
+
+	path := fmt.Sprintf("%s/%d", imageBasePath, imageID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// get retrieves an image by id or slug.
+func (s *ImagesServiceOp) get(ctx context.Context, idOrSlug string) (*Image, *Response, error) {
+	path := fmt.Sprintf("%s/%s", imageBasePath, idOrSlug)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	image, resp, err := DoEnvelope[Image](ctx, s.client, req, "image")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return image, resp, err
+}
+
+// list fetches a page of images with the filters of filter applied on top of
+// opt.
+func (s *ImagesServiceOp) list(ctx context.Context, opt *ListOptions, filter imageListOptions) ([]Image, *Response, error) {
+	o := filter
+	if opt != nil {
+		o.ListOptions = *opt
+		if filter.Type != "" {
+			o.Type = filter.Type
+		}
+		if filter.TagName != "" {
+			o.TagName = filter.TagName
+		}
+	}
+
+	path, err := addOptions(imageBasePath, &o)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	images, resp, err := DoEnvelope[[]Image](ctx, s.client, req, "images")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *images, resp, err
+}
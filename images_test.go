@@ -0,0 +1,212 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestImages_List(t *testing.T) {
+	c, mux := setup(t)
+
+	var queries []string
+	mux.HandleFunc("/v2/images", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		queries = append(queries, r.URL.RawQuery)
+		fmt.Fprint(w, `{"images":[{"id":1,"slug":"ubuntu-22-04-x64","type":"base"}]}`)
+	})
+
+	ctx := context.Background()
+	opt := &ListOptions{Page: 2}
+	calls := []func() ([]Image, *Response, error){
+		func() ([]Image, *Response, error) { return c.Images.List(ctx, nil) },
+		func() ([]Image, *Response, error) { return c.Images.ListDistribution(ctx, opt) },
+		func() ([]Image, *Response, error) { return c.Images.ListApplication(ctx, nil) },
+		func() ([]Image, *Response, error) { return c.Images.ListUser(ctx, opt) },
+		func() ([]Image, *Response, error) { return c.Images.ListByTag(ctx, "prod", opt) },
+	}
+	for i, call := range calls {
+		images, _, err := call()
+		if err != nil {
+			t.Fatalf("call %d returned error: %v", i, err)
+		}
+		if len(images) != 1 || images[0].Slug != "ubuntu-22-04-x64" {
+			t.Errorf("call %d: got images %+v", i, images)
+		}
+	}
+
+	want := []string{"", "page=2&type=distribution", "type=application", "page=2&private=true", "page=2&tag_name=prod"}
+	if fmt.Sprint(queries) != fmt.Sprint(want) {
+		t.Errorf("expected queries %q, got %q", want, queries)
+	}
+}
+
+func TestImages_Get(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/images/12", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"image":{"id":12,"name":"custom","regions":["nyc3","ams3"],"size_gigabytes":2.34}}`)
+	})
+	mux.HandleFunc("/v2/images/ubuntu-22-04-x64", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"image":{"id":1,"slug":"ubuntu-22-04-x64","public":true}}`)
+	})
+
+	image, _, err := c.Images.GetByID(context.Background(), 12)
+	if err != nil {
+		t.Fatalf("Images.GetByID returned error: %v", err)
+	}
+	if image.ID != 12 || len(image.Regions) != 2 || image.SizeGigaBytes != 2.34 {
+		t.Errorf("got image %+v", image)
+	}
+
+	image, _, err = c.Images.GetBySlug(context.Background(), "ubuntu-22-04-x64")
+	if err != nil {
+		t.Fatalf("Images.GetBySlug returned error: %v", err)
+	}
+	if image.ID != 1 || !image.Public {
+		t.Errorf("got image %+v", image)
+	}
+
+	var verr *ValidationError
+	if _, _, err := c.Images.GetByID(context.Background(), 0); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for image 0, got %v", err)
+	}
+	if _, _, err := c.Images.GetBySlug(context.Background(), ""); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for an empty slug, got %v", err)
+	}
+}
+
+func TestImages_Create(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/images", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var req CustomImageCreateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if req.Name != "custom" || req.Url != "https://example.com/custom.img" || req.Region != "nyc3" {
+			t.Errorf("got request %+v", req)
+		}
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"image":{"id":12,"name":"custom","status":"NEW"}}`)
+	})
+
+	image, _, err := c.Images.Create(context.Background(), &CustomImageCreateRequest{
+		Name:   "custom",
+		Url:    "https://example.com/custom.img",
+		Region: "nyc3",
+	})
+	if err != nil {
+		t.Fatalf("Images.Create returned error: %v", err)
+	}
+	if image.ID != 12 || image.Status != ImageNew {
+		t.Errorf("got image %+v", image)
+	}
+
+	var verr *ValidationError
+	if _, _, err := c.Images.Create(context.Background(), nil); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for a nil request, got %v", err)
+	}
+	if _, _, err := c.Images.Create(context.Background(), &CustomImageCreateRequest{Name: "custom"}); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError without a URL, got %v", err)
+	}
+}
+
+func TestImages_CreateAndWait(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []string
+		failed   bool
+	}{
+		{name: "available", statuses: []string{"pending", "available"}},
+		{name: "failed", statuses: []string{"pending", "failed"}, failed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, mux := setup(t)
+
+			mux.HandleFunc("/v2/images", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusAccepted)
+				fmt.Fprint(w, `{"image":{"id":12,"status":"NEW"}}`)
+			})
+			polls := 0
+			mux.HandleFunc("/v2/images/12", func(w http.ResponseWriter, r *http.Request) {
+				status := tt.statuses[polls]
+				polls++
+				var message string
+				if status == "failed" {
+					message = "download failed"
+				}
+				fmt.Fprintf(w, `{"image":{"id":12,"status":%q,"error_message":%q}}`, status, message)
+			})
+
+			image, _, err := c.Images.CreateAndWait(context.Background(),
+				&CustomImageCreateRequest{Name: "custom", Url: "https://example.com/custom.img", Region: "nyc3"},
+				WaitOptions{PollInterval: time.Millisecond})
+			if tt.failed {
+				var ierr *ImageImportError
+				if !errors.As(err, &ierr) || ierr.Image.ErrorMessage != "download failed" {
+					t.Errorf("expected an *ImageImportError, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Images.CreateAndWait returned error: %v", err)
+			}
+			if image.Status != ImageAvailable || polls != 2 {
+				t.Errorf("expected the image available after 2 polls, got %+v after %d", image, polls)
+			}
+		})
+	}
+}
+
+func TestImages_Update(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/images/12", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		var req ImageUpdateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		fmt.Fprintf(w, `{"image":{"id":12,"name":%q}}`, req.Name)
+	})
+
+	image, _, err := c.Images.Update(context.Background(), 12, &ImageUpdateRequest{Name: "renamed"})
+	if err != nil {
+		t.Fatalf("Images.Update returned error: %v", err)
+	}
+	if image.Name != "renamed" {
+		t.Errorf("got image %+v", image)
+	}
+
+	var verr *ValidationError
+	if _, _, err := c.Images.Update(context.Background(), 12, nil); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for a nil request, got %v", err)
+	}
+}
+
+func TestImages_Delete(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/images/12", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := c.Images.Delete(context.Background(), 12); err != nil {
+		t.Fatalf("Images.Delete returned error: %v", err)
+	}
+
+	var verr *ValidationError
+	if _, err := c.Images.Delete(context.Background(), 0); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for image 0, got %v", err)
+	}
+}
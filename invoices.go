@@ -0,0 +1,231 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const invoicesBasePath = "v2/customers/my/invoices"
+
+// Invoice represents a DigitalOcean Invoice
+type Invoice struct {
+	InvoiceItems []InvoiceItem `json:"invoice_items"`
+}
+
+// InvoiceItem represents a line-item on a DigitalOcean Invoice
+type InvoiceItem struct {
+	Product          string    `json:"product"`
+	ResourceID       string    `json:"resource_id"`
+	ResourceUUID     string    `json:"resource_uuid"`
+	GroupDescription string    `json:"group_description"`
+	Description      string    `json:"description"`
+	Amount           Amount    `json:"amount"`
+	Duration         string    `json:"duration"`
+	DurationUnit     string    `json:"duration_unit"`
+	StartTime        time.Time `json:"start_time"`
+	EndTime          time.Time `json:"end_time"`
+	ProjectName      string    `json:"project_name"`
+	Category         string    `json:"category"`
+}
+
+// InvoiceList contains a paginated list of all of a customer's invoices.
+// The InvoicePreview is the month-to-date usage generated by DigitalOcean.
+type InvoiceList struct {
+	Invoices       []InvoiceListItem `json:"invoices"`
+	InvoicePreview InvoiceListItem   `json:"invoice_preview"`
+}
+
+// InvoiceListItem contains a small list of information about a customer's invoice.
+// More information can be found in the Invoice or InvoiceSummary
+type InvoiceListItem struct {
+	InvoiceUUID   string    `json:"invoice_uuid"`
+	Amount        Amount    `json:"amount"`
+	InvoicePeriod string    `json:"invoice_period"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// InvoiceSummary contains metadata and summarized usage for an invoice.
+type InvoiceSummary struct {
+	InvoiceUUID           string                  `json:"invoice_uuid"`
+	BillingPeriod         string                  `json:"billing_period"`
+	Amount                Amount                  `json:"amount"`
+	UserName              string                  `json:"user_name"`
+	UserBillingAddress    Address                 `json:"user_billing_address"`
+	UserCompany           string                  `json:"user_company"`
+	UserEmail             string                  `json:"user_email"`
+	ProductCharges        InvoiceSummaryBreakdown `json:"product_charges"`
+	Overages              InvoiceSummaryBreakdown `json:"overages"`
+	Taxes                 InvoiceSummaryBreakdown `json:"taxes"`
+	CreditsAndAdjustments InvoiceSummaryBreakdown `json:"credits_and_adjustments"`
+}
+
+// Address represents the billing address of a customer
+type Address struct {
+	AddressLine1    string    `json:"address_line1"`
+	AddressLine2    string    `json:"address_line2"`
+	City            string    `json:"city"`
+	Region          string    `json:"region"`
+	PostalCode      string    `json:"postal_code"`
+	CountryISO2Code string    `json:"country_iso2_code"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// InvoiceSummaryBreakdown is a grouped set of InvoiceItems from an invoice
+type InvoiceSummaryBreakdown struct {
+	Name   string                        `json:"name"`
+	Amount Amount                        `json:"amount"`
+	Items  []InvoiceSummaryBreakdownItem `json:"items"`
+}
+
+// InvoiceSummaryBreakdownItem further breaks down the InvoiceSummary by product
+type InvoiceSummaryBreakdownItem struct {
+	Name   string `json:"name"`
+	Amount Amount `json:"amount"`
+	Count  string `json:"count"`
+}
+
+type invoicesRoot struct {
+	InvoiceList
+	Links *Links `json:"links"`
+	Meta  *Meta  `json:"meta"`
+}
+
+/* SERVICE */
+
+// InvoicesService is an interface for interfacing with the Invoice
+// endpoints of the DigitalOcean API
+// See: https://docs.digitalocean.com/reference/api/api-reference/#tag/Billing
+type InvoicesService interface {
+	Get(context.Context, string, *ListOptions) (*Invoice, *Response, error)
+	GetPDF(context.Context, string, io.Writer) (*Response, error)
+	GetCSV(context.Context, string, io.Writer) (*Response, error)
+	List(context.Context, *ListOptions) (*InvoiceList, *Response, error)
+	ListAll(context.Context, *ListAllOptions) ([]InvoiceListItem, *Response, error)
+	GetSummary(context.Context, string) (*InvoiceSummary, *Response, error)
+}
+
+// InvoicesServiceOp handles communication with the Invoice related methods of
+// the DigitalOcean API.
+type InvoicesServiceOp struct {
+	client *Client
+}
+
+var _ InvoicesService = &InvoicesServiceOp{}
+
+// Get detailed invoice items for an Invoice
+func (s *InvoicesServiceOp) Get(ctx context.Context, invoiceUUID string, opt *ListOptions) (*Invoice, *Response, error) {
+	path, err := invoicePath(invoiceUUID, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	path, err = addOptions(path, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	items, resp, err := DoEnvelope[[]InvoiceItem](ctx, s.client, req, "invoice_items")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &Invoice{InvoiceItems: *items}, resp, err
+}
+
+// List invoices for a customer
+func (s *InvoicesServiceOp) List(ctx context.Context, opt *ListOptions) (*InvoiceList, *Response, error) {
+	path, err := addOptions(invoicesBasePath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root, resp, err := Do[invoicesRoot](ctx, s.client, req)
+	if err != nil {
+		return nil, resp, err
+	}
+	resp.Links = root.Links
+	resp.Meta = root.Meta
+
+	return &root.InvoiceList, resp, err
+}
+
+// ListAll invoices across all pages, without the invoice preview
+func (s *InvoicesServiceOp) ListAll(ctx context.Context, opt *ListAllOptions) ([]InvoiceListItem, *Response, error) {
+	return ListAll[InvoiceListItem](ctx, func(ctx context.Context, opt *ListOptions) ([]InvoiceListItem, *Response, error) {
+		list, resp, err := s.List(ctx, opt)
+		if err != nil {
+			return nil, resp, err
+		}
+		return list.Invoices, resp, err
+	}, opt)
+}
+
+// GetSummary returns a summary of metadata and summarized usage for an Invoice
+func (s *InvoicesServiceOp) GetSummary(ctx context.Context, invoiceUUID string) (*InvoiceSummary, *Response, error) {
+	path, err := invoicePath(invoiceUUID, "summary")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return Do[InvoiceSummary](ctx, s.client, req)
+}
+
+// GetPDF writes the PDF of an Invoice to w as it is downloaded.
+func (s *InvoicesServiceOp) GetPDF(ctx context.Context, invoiceUUID string, w io.Writer) (*Response, error) {
+	return s.download(ctx, invoiceUUID, "pdf", w)
+}
+
+// GetCSV writes the CSV of an Invoice to w as it is downloaded.
+func (s *InvoicesServiceOp) GetCSV(ctx context.Context, invoiceUUID string, w io.Writer) (*Response, error) {
+	return s.download(ctx, invoiceUUID, "csv", w)
+}
+
+func (s *InvoicesServiceOp) download(ctx context.Context, invoiceUUID, format string, w io.Writer) (*Response, error) {
+	if w == nil {
+		return nil, &ValidationError{Field: "w", Reason: "cannot be nil"}
+	}
+
+	path, err := invoicePath(invoiceUUID, format)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, w)
+}
+
+// invoicePath returns the path of an invoice, or of its artifact when
+// artifact is not empty.
+func invoicePath(invoiceUUID, artifact string) (string, error) {
+	if invoiceUUID == "" {
+		return "", &ValidationError{Field: "invoiceUUID", Reason: "must not be empty"}
+	}
+	path := fmt.Sprintf("%s/%s", invoicesBasePath, invoiceUUID)
+	if artifact != "" {
+		path += "/" + artifact
+	}
+	return path, nil
+}
@@ -0,0 +1,139 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestInvoices_List(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/customers/my/invoices", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"invoices":[{"invoice_uuid":"22737513","amount":"12.34","invoice_period":"2019-12"}],"invoice_preview":{"invoice_uuid":"1afe95e6","amount":"34.56","invoice_period":"2020-02"},"meta":{"total":1}}`)
+	})
+
+	list, resp, err := c.Invoices.List(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Invoices.List returned error: %v", err)
+	}
+	if len(list.Invoices) != 1 || list.Invoices[0].InvoicePeriod != "2019-12" || list.InvoicePreview.Amount != "34.56" {
+		t.Errorf("got invoices %+v", list)
+	}
+	if resp.Meta == nil || resp.Meta.Total != 1 {
+		t.Errorf("expected the meta set on the response, got %+v", resp.Meta)
+	}
+}
+
+func TestInvoices_ListAll(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/customers/my/invoices", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"invoices":[{"invoice_uuid":"2"}],"invoice_preview":{"invoice_uuid":"preview"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"invoices":[{"invoice_uuid":"1"}],"invoice_preview":{"invoice_uuid":"preview"},"links":{"pages":{"next":"https://api.example.com/v2/customers/my/invoices?page=2"}}}`)
+	})
+
+	invoices, _, err := c.Invoices.ListAll(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Invoices.ListAll returned error: %v", err)
+	}
+	if len(invoices) != 2 || invoices[1].InvoiceUUID != "2" {
+		t.Errorf("got invoices %+v", invoices)
+	}
+}
+
+func TestInvoices_Get(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/customers/my/invoices/22737513", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("per_page") != "20" {
+			t.Errorf("got query %q", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"invoice_items":[{"product":"Droplets","resource_uuid":"711157cb","amount":"12.34","duration":"744","duration_unit":"Hours","project_name":"web"}]}`)
+	})
+
+	invoice, _, err := c.Invoices.Get(context.Background(), "22737513", &ListOptions{PerPage: 20})
+	if err != nil {
+		t.Fatalf("Invoices.Get returned error: %v", err)
+	}
+	if len(invoice.InvoiceItems) != 1 || invoice.InvoiceItems[0].Product != "Droplets" || invoice.InvoiceItems[0].DurationUnit != "Hours" {
+		t.Errorf("got invoice %+v", invoice)
+	}
+}
+
+func TestInvoices_GetSummary(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/customers/my/invoices/22737513/summary", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"invoice_uuid":"22737513","billing_period":"2020-01","amount":"27.13","user_billing_address":{"city":"New York","country_iso2_code":"US"},"product_charges":{"name":"Product usage charges","amount":"12.34","items":[{"name":"Spaces Subscription","amount":"10.00","count":"1"}]}}`)
+	})
+
+	summary, _, err := c.Invoices.GetSummary(context.Background(), "22737513")
+	if err != nil {
+		t.Fatalf("Invoices.GetSummary returned error: %v", err)
+	}
+	if summary.BillingPeriod != "2020-01" || summary.UserBillingAddress.CountryISO2Code != "US" {
+		t.Errorf("got summary %+v", summary)
+	}
+	if items := summary.ProductCharges.Items; len(items) != 1 || items[0].Count != "1" {
+		t.Errorf("got product charges %+v", summary.ProductCharges)
+	}
+}
+
+func TestInvoices_download(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+
+	mux.HandleFunc("/v2/customers/my/invoices/22737513/pdf", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.Header().Set("Content-Type", "application/pdf")
+		fmt.Fprint(w, "%PDF-1.4")
+	})
+	mux.HandleFunc("/v2/customers/my/invoices/22737513/csv", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.Header().Set("Content-Type", "text/csv")
+		fmt.Fprint(w, "product,amount\nDroplets,12.34\n")
+	})
+
+	var pdf, csv bytes.Buffer
+	if _, err := c.Invoices.GetPDF(ctx, "22737513", &pdf); err != nil {
+		t.Fatalf("Invoices.GetPDF returned error: %v", err)
+	}
+	if pdf.String() != "%PDF-1.4" {
+		t.Errorf("got PDF %q", pdf.String())
+	}
+	if _, err := c.Invoices.GetCSV(ctx, "22737513", &csv); err != nil {
+		t.Fatalf("Invoices.GetCSV returned error: %v", err)
+	}
+	if csv.String() != "product,amount\nDroplets,12.34\n" {
+		t.Errorf("got CSV %q", csv.String())
+	}
+}
+
+func TestInvoices_validation(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+	s := c.Invoices
+
+	calls := map[string]func() error{
+		"get empty uuid":     func() error { _, _, err := s.Get(ctx, "", nil); return err },
+		"summary empty uuid": func() error { _, _, err := s.GetSummary(ctx, ""); return err },
+		"pdf empty uuid":     func() error { _, err := s.GetPDF(ctx, "", &bytes.Buffer{}); return err },
+		"csv nil writer":     func() error { _, err := s.GetCSV(ctx, "22737513", nil); return err },
+	}
+	for name, call := range calls {
+		var verr *ValidationError
+		if err := call(); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
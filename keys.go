@@ -0,0 +1,242 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const keysBasePath = "v2/account/keys"
+
+// sshKeyTypes are the public key algorithms accepted by the API.
+var sshKeyTypes = []string{
+	"ssh-rsa",
+	"ssh-ed25519",
+	"ecdsa-sha2-nistp256",
+	"ecdsa-sha2-nistp384",
+	"ecdsa-sha2-nistp521",
+}
+
+// Key represents a DigitalOcean Key.
+type Key struct {
+	ID          int    `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Fingerprint string `json:"fingerprint,omitempty"`
+	PublicKey   string `json:"public_key,omitempty"`
+}
+
+// KeyUpdateRequest represents a request to update an SSH key stored in a DigitalOcean account.
+type KeyUpdateRequest struct {
+	Name string `json:"name"`
+}
+
+// KeyCreateRequest represents a request to create a new SSH key.
+type KeyCreateRequest struct {
+	Name      string `json:"name"`
+	PublicKey string `json:"public_key"`
+}
+
+/* SERVICE */
+
+// KeysService is an interface for interfacing with the SSH keys
+// endpoints of the DigitalOcean API
+type KeysService interface {
+	List(context.Context, *ListOptions) ([]Key, *Response, error)
+	ListAll(context.Context, *ListAllOptions) ([]Key, *Response, error)
+	GetByID(context.Context, int) (*Key, *Response, error)
+	GetByFingerprint(context.Context, string) (*Key, *Response, error)
+	Create(context.Context, *KeyCreateRequest) (*Key, *Response, error)
+	UpdateByID(context.Context, int, *KeyUpdateRequest) (*Key, *Response, error)
+	UpdateByFingerprint(context.Context, string, *KeyUpdateRequest) (*Key, *Response, error)
+	DeleteByID(context.Context, int) (*Response, error)
+	DeleteByFingerprint(context.Context, string) (*Response, error)
+}
+
+// KeysServiceOp handles communication with SSH key related method of the
+// DigitalOcean API.
+type KeysServiceOp struct {
+	client *Client
+}
+
+var _ KeysService = &KeysServiceOp{}
+
+// List all keys
+func (s *KeysServiceOp) List(ctx context.Context, opt *ListOptions) ([]Key, *Response, error) {
+	path, err := addOptions(keysBasePath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys, resp, err := DoEnvelope[[]Key](ctx, s.client, req, "ssh_keys")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *keys, resp, err
+}
+
+// ListAll keys across all pages
+func (s *KeysServiceOp) ListAll(ctx context.Context, opt *ListAllOptions) ([]Key, *Response, error) {
+	return ListAll[Key](ctx, s.List, opt)
+}
+
+// GetByID gets a Key by id
+func (s *KeysServiceOp) GetByID(ctx context.Context, keyID int) (*Key, *Response, error) {
+	if keyID < 1 {
+		return nil, nil, &ValidationError{Field: "keyID", Reason: "must be positive"}
+	}
+
+	return s.get(ctx, fmt.Sprintf("%s/%d", keysBasePath, keyID))
+}
+
+// GetByFingerprint gets a Key by fingerprint
+func (s *KeysServiceOp) GetByFingerprint(ctx context.Context, fingerprint string) (*Key, *Response, error) {
+	if fingerprint == "" {
+		return nil, nil, &ValidationError{Field: "fingerprint", Reason: "must not be empty"}
+	}
+
+	return s.get(ctx, fmt.Sprintf("%s/%s", keysBasePath, fingerprint))
+}
+
+// Create a key using a KeyCreateRequest. The public key is checked to be in
+// the authorized_keys format before it is sent.
+func (s *KeysServiceOp) Create(ctx context.Context, createRequest *KeyCreateRequest) (*Key, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, &ValidationError{Field: "createRequest", Reason: "cannot be nil"}
+	}
+	if createRequest.Name == "" {
+		return nil, nil, &ValidationError{Field: "name", Reason: "must not be empty"}
+	}
+	if err := validatePublicKey(createRequest.PublicKey); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, keysBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doKey(ctx, req)
+}
+
+// UpdateByID updates a key name by ID.
+func (s *KeysServiceOp) UpdateByID(ctx context.Context, keyID int, updateRequest *KeyUpdateRequest) (*Key, *Response, error) {
+	if keyID < 1 {
+		return nil, nil, &ValidationError{Field: "keyID", Reason: "must be positive"}
+	}
+
+	return s.update(ctx, fmt.Sprintf("%s/%d", keysBasePath, keyID), updateRequest)
+}
+
+// UpdateByFingerprint updates a key name by fingerprint.
+func (s *KeysServiceOp) UpdateByFingerprint(ctx context.Context, fingerprint string, updateRequest *KeyUpdateRequest) (*Key, *Response, error) {
+	if fingerprint == "" {
+		return nil, nil, &ValidationError{Field: "fingerprint", Reason: "must not be empty"}
+	}
+
+	return s.update(ctx, fmt.Sprintf("%s/%s", keysBasePath, fingerprint), updateRequest)
+}
+
+// DeleteByID deletes a key by its id
+func (s *KeysServiceOp) DeleteByID(ctx context.Context, keyID int) (*Response, error) {
+	if keyID < 1 {
+		return nil, &ValidationError{Field: "keyID", Reason: "must be positive"}
+	}
+
+	return s.delete(ctx, fmt.Sprintf("%s/%d", keysBasePath, keyID))
+}
+
+// DeleteByFingerprint deletes a key by its fingerprint
+func (s *KeysServiceOp) DeleteByFingerprint(ctx context.Context, fingerprint string) (*Response, error) {
+	if fingerprint == "" {
+		return nil, &ValidationError{Field: "fingerprint", Reason: "must not be empty"}
+	}
+
+	return s.delete(ctx, fmt.Sprintf("%s/%s", keysBasePath, fingerprint))
+}
+
+func (s *KeysServiceOp) get(ctx context.Context, path string) (*Key, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doKey(ctx, req)
+}
+
+func (s *KeysServiceOp) update(ctx context.Context, path string, updateRequest *KeyUpdateRequest) (*Key, *Response, error) {
+	if updateRequest == nil {
+		return nil, nil, &ValidationError{Field: "updateRequest", Reason: "cannot be nil"}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, updateRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doKey(ctx, req)
+}
+
+func (s *KeysServiceOp) delete(ctx context.Context, path string) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *KeysServiceOp) doKey(ctx context.Context, req *http.Request) (*Key, *Response, error) {
+	key, resp, err := DoEnvelope[Key](ctx, s.client, req, "ssh_key")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return key, resp, err
+}
+
+// validatePublicKey checks that key is a public key in the authorized_keys
+// format, "<type> <base64 key> [comment]", whose encoded key matches its type.
+func validatePublicKey(key string) error {
+	fields := strings.Fields(key)
+	if len(fields) < 2 {
+		return &ValidationError{Field: "public key", Reason: "must be in the authorized_keys format"}
+	}
+
+	typ := fields[0]
+	known := false
+	for _, t := range sshKeyTypes {
+		if typ == t {
+			known = true
+			break
+		}
+	}
+	if !known {
+		return &ValidationError{Field: "public key", Value: typ, Reason: fmt.Sprintf("unsupported key type, expected one of %q", sshKeyTypes)}
+	}
+
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return &ValidationError{Field: "public key", Reason: "key data is not valid base64"}
+	}
+
+	// the key data starts with the length prefixed key type
+	if len(blob) < 4 {
+		return &ValidationError{Field: "public key", Reason: "key data is truncated"}
+	}
+	n := binary.BigEndian.Uint32(blob)
+	if uint64(len(blob)-4) < uint64(n) || !bytes.Equal(blob[4:4+n], []byte(typ)) {
+		return &ValidationError{Field: "public key", Reason: "key data does not match the key type"}
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+// testPublicKey returns an authorized_keys line of the given type whose key
+// data carries typ as its key type.
+func testPublicKey(typ, keyType string) string {
+	blob := binary.BigEndian.AppendUint32(nil, uint32(len(keyType)))
+	blob = append(blob, keyType...)
+	blob = append(blob, make([]byte, 32)...)
+	return typ + " " + base64.StdEncoding.EncodeToString(blob) + " user@example.com"
+}
+
+func TestKeys_List(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/account/keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"ssh_keys":[{"id":1,"name":"laptop","fingerprint":"aa:bb"}]}`)
+	})
+
+	keys, _, err := c.Keys.List(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Keys.List returned error: %v", err)
+	}
+	if len(keys) != 1 || keys[0].Fingerprint != "aa:bb" {
+		t.Errorf("got keys %+v", keys)
+	}
+}
+
+func TestKeys_byIDAndFingerprint(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+
+	var got []string
+	handle := func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		fmt.Fprint(w, `{"ssh_key":{"id":1,"name":"laptop","fingerprint":"aa:bb"}}`)
+	}
+	mux.HandleFunc("/v2/account/keys/1", handle)
+	mux.HandleFunc("/v2/account/keys/aa:bb", handle)
+
+	if key, _, err := c.Keys.GetByID(ctx, 1); err != nil || key.Name != "laptop" {
+		t.Fatalf("Keys.GetByID returned %+v, %v", key, err)
+	}
+	if _, _, err := c.Keys.GetByFingerprint(ctx, "aa:bb"); err != nil {
+		t.Fatalf("Keys.GetByFingerprint returned error: %v", err)
+	}
+	if _, _, err := c.Keys.UpdateByID(ctx, 1, &KeyUpdateRequest{Name: "desktop"}); err != nil {
+		t.Fatalf("Keys.UpdateByID returned error: %v", err)
+	}
+	if _, _, err := c.Keys.UpdateByFingerprint(ctx, "aa:bb", &KeyUpdateRequest{Name: "desktop"}); err != nil {
+		t.Fatalf("Keys.UpdateByFingerprint returned error: %v", err)
+	}
+	if _, err := c.Keys.DeleteByID(ctx, 1); err != nil {
+		t.Fatalf("Keys.DeleteByID returned error: %v", err)
+	}
+	if _, err := c.Keys.DeleteByFingerprint(ctx, "aa:bb"); err != nil {
+		t.Fatalf("Keys.DeleteByFingerprint returned error: %v", err)
+	}
+
+	want := fmt.Sprint([]string{
+		"GET /v2/account/keys/1", "GET /v2/account/keys/aa:bb",
+		"PUT /v2/account/keys/1", "PUT /v2/account/keys/aa:bb",
+		"DELETE /v2/account/keys/1", "DELETE /v2/account/keys/aa:bb",
+	})
+	if fmt.Sprint(got) != want {
+		t.Errorf("expected %s, got %v", want, got)
+	}
+}
+
+func TestKeys_Create(t *testing.T) {
+	c, mux := setup(t)
+	publicKey := testPublicKey("ssh-ed25519", "ssh-ed25519")
+
+	mux.HandleFunc("/v2/account/keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var req KeyCreateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if req.Name != "laptop" || req.PublicKey != publicKey {
+			t.Errorf("got request %+v", req)
+		}
+		fmt.Fprint(w, `{"ssh_key":{"id":1,"name":"laptop"}}`)
+	})
+
+	key, _, err := c.Keys.Create(context.Background(), &KeyCreateRequest{Name: "laptop", PublicKey: publicKey})
+	if err != nil {
+		t.Fatalf("Keys.Create returned error: %v", err)
+	}
+	if key.ID != 1 {
+		t.Errorf("got key %+v", key)
+	}
+}
+
+func TestValidatePublicKey(t *testing.T) {
+	tests := []struct {
+		name  string
+		key   string
+		valid bool
+	}{
+		{"ed25519", testPublicKey("ssh-ed25519", "ssh-ed25519"), true},
+		{"rsa", testPublicKey("ssh-rsa", "ssh-rsa"), true},
+		{"without comment", "ssh-rsa " + base64.StdEncoding.EncodeToString(append([]byte{0, 0, 0, 7}, "ssh-rsa"...)), true},
+		{"empty", "", false},
+		{"type only", "ssh-rsa", false},
+		{"unknown type", testPublicKey("ssh-dss", "ssh-dss"), false},
+		{"not base64", "ssh-rsa !!!", false},
+		{"truncated", "ssh-rsa " + base64.StdEncoding.EncodeToString([]byte{0, 0}), false},
+		{"type mismatch", testPublicKey("ssh-rsa", "ssh-ed25519"), false},
+		{"length overflow", "ssh-rsa " + base64.StdEncoding.EncodeToString([]byte{0xff, 0xff, 0xff, 0xff, 's'}), false},
+	}
+
+	for _, tt := range tests {
+		err := validatePublicKey(tt.key)
+		if tt.valid && err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+		}
+		var verr *ValidationError
+		if !tt.valid && !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", tt.name, err)
+		}
+	}
+}
+
+func TestKeys_validation(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+	k := c.Keys
+
+	calls := map[string]func() error{
+		"get id 0":              func() error { _, _, err := k.GetByID(ctx, 0); return err },
+		"get empty fingerprint": func() error { _, _, err := k.GetByFingerprint(ctx, ""); return err },
+		"create nil":            func() error { _, _, err := k.Create(ctx, nil); return err },
+		"create empty name": func() error {
+			_, _, err := k.Create(ctx, &KeyCreateRequest{PublicKey: testPublicKey("ssh-rsa", "ssh-rsa")})
+			return err
+		},
+		"create invalid key": func() error {
+			_, _, err := k.Create(ctx, &KeyCreateRequest{Name: "laptop", PublicKey: "ssh-rsa"})
+			return err
+		},
+		"update nil":   func() error { _, _, err := k.UpdateByID(ctx, 1, nil); return err },
+		"delete id 0":  func() error { _, err := k.DeleteByID(ctx, 0); return err },
+		"delete empty": func() error { _, err := k.DeleteByFingerprint(ctx, ""); return err },
+	}
+	for name, call := range calls {
+		var verr *ValidationError
+		if err := call(); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,740 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	kubernetesBasePath     = "v2/kubernetes"
+	kubernetesClustersPath = kubernetesBasePath + "/clusters"
+	kubernetesOptionsPath  = kubernetesBasePath + "/options"
+)
+
+// KubernetesCluster represents a Kubernetes cluster.
+type KubernetesCluster struct {
+	ID            string   `json:"id,omitempty"`
+	Name          string   `json:"name,omitempty"`
+	RegionSlug    string   `json:"region,omitempty"`
+	VersionSlug   string   `json:"version,omitempty"`
+	ClusterSubnet string   `json:"cluster_subnet,omitempty"`
+	ServiceSubnet string   `json:"service_subnet,omitempty"`
+	IPv4          string   `json:"ipv4,omitempty"`
+	Endpoint      string   `json:"endpoint,omitempty"`
+	Tags          []string `json:"tags,omitempty"`
+	VPCUUID       string   `json:"vpc_uuid,omitempty"`
+
+	NodePools []*KubernetesNodePool `json:"node_pools,omitempty"`
+
+	MaintenancePolicy *KubernetesMaintenancePolicy `json:"maintenance_policy,omitempty"`
+	AutoUpgrade       bool                         `json:"auto_upgrade,omitempty"`
+	SurgeUpgrade      bool                         `json:"surge_upgrade,omitempty"`
+	HA                bool                         `json:"ha,omitempty"`
+
+	Status    *KubernetesClusterStatus `json:"status,omitempty"`
+	CreatedAt time.Time                `json:"created_at,omitempty"`
+	UpdatedAt time.Time                `json:"updated_at,omitempty"`
+}
+
+// URN returns the Kubernetes cluster in a valid DO API URN form.
+func (kc KubernetesCluster) URN() string {
+	return Resource{ID: kc.ID, Type: KubernetesResourceType}.URN()
+}
+
+// KubernetesClusterStatusState represents the state of a Kubernetes cluster.
+type KubernetesClusterStatusState string
+
+// Possible states of a Kubernetes cluster.
+const (
+	KubernetesClusterStatusProvisioning = KubernetesClusterStatusState("provisioning")
+	KubernetesClusterStatusRunning      = KubernetesClusterStatusState("running")
+	KubernetesClusterStatusDegraded     = KubernetesClusterStatusState("degraded")
+	KubernetesClusterStatusError        = KubernetesClusterStatusState("error")
+	KubernetesClusterStatusDeleted      = KubernetesClusterStatusState("deleted")
+	KubernetesClusterStatusUpgrading    = KubernetesClusterStatusState("upgrading")
+	KubernetesClusterStatusDeleting     = KubernetesClusterStatusState("deleting")
+	KubernetesClusterStatusInvalid      = KubernetesClusterStatusState("invalid")
+)
+
+// KubernetesClusterStatus describes the status of a cluster.
+type KubernetesClusterStatus struct {
+	State   KubernetesClusterStatusState `json:"state,omitempty"`
+	Message string                       `json:"message,omitempty"`
+}
+
+// KubernetesClusterProvisioningError occurs when a cluster which was waited
+// for fails to be provisioned.
+type KubernetesClusterProvisioningError struct {
+	Cluster *KubernetesCluster
+}
+
+func (e *KubernetesClusterProvisioningError) Error() string {
+	return fmt.Sprintf("provisioning kubernetes cluster %s failed: %s %s", e.Cluster.ID, e.Cluster.Status.State, e.Cluster.Status.Message)
+}
+
+// KubernetesMaintenancePolicyDay is the day of the week maintenance may
+// start on.
+type KubernetesMaintenancePolicyDay string
+
+// Possible days of a maintenance window, KubernetesMaintenanceDayAny lets the
+// API pick the day.
+const (
+	KubernetesMaintenanceDayAny       = KubernetesMaintenancePolicyDay("any")
+	KubernetesMaintenanceDayMonday    = KubernetesMaintenancePolicyDay("monday")
+	KubernetesMaintenanceDayTuesday   = KubernetesMaintenancePolicyDay("tuesday")
+	KubernetesMaintenanceDayWednesday = KubernetesMaintenancePolicyDay("wednesday")
+	KubernetesMaintenanceDayThursday  = KubernetesMaintenancePolicyDay("thursday")
+	KubernetesMaintenanceDayFriday    = KubernetesMaintenancePolicyDay("friday")
+	KubernetesMaintenanceDaySaturday  = KubernetesMaintenancePolicyDay("saturday")
+	KubernetesMaintenanceDaySunday    = KubernetesMaintenancePolicyDay("sunday")
+)
+
+// KubernetesMaintenancePolicy is the window in which automatic upgrades and
+// repairs of a cluster happen. StartTime is given as "HH:MM" in UTC.
+type KubernetesMaintenancePolicy struct {
+	StartTime string                         `json:"start_time,omitempty"`
+	Duration  string                         `json:"duration,omitempty"`
+	Day       KubernetesMaintenancePolicyDay `json:"day,omitempty"`
+}
+
+// KubernetesNodePool represents a node pool in a Kubernetes cluster.
+type KubernetesNodePool struct {
+	ID     string            `json:"id,omitempty"`
+	Name   string            `json:"name,omitempty"`
+	Size   string            `json:"size,omitempty"`
+	Count  int               `json:"count,omitempty"`
+	Tags   []string          `json:"tags,omitempty"`
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// With AutoScale the cluster autoscaler keeps Count between MinNodes
+	// and MaxNodes.
+	AutoScale bool `json:"auto_scale,omitempty"`
+	MinNodes  int  `json:"min_nodes,omitempty"`
+	MaxNodes  int  `json:"max_nodes,omitempty"`
+
+	Nodes []*KubernetesNode `json:"nodes,omitempty"`
+}
+
+// KubernetesNode represents a node in a Kubernetes node pool.
+type KubernetesNode struct {
+	ID        string                `json:"id,omitempty"`
+	Name      string                `json:"name,omitempty"`
+	Status    *KubernetesNodeStatus `json:"status,omitempty"`
+	DropletID string                `json:"droplet_id,omitempty"`
+	CreatedAt time.Time             `json:"created_at,omitempty"`
+	UpdatedAt time.Time             `json:"updated_at,omitempty"`
+}
+
+// KubernetesNodeStatus represents the status of a Kubernetes node.
+type KubernetesNodeStatus struct {
+	State   string `json:"state,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// KubernetesClusterCreateRequest represents a request to create a Kubernetes
+// cluster.
+type KubernetesClusterCreateRequest struct {
+	Name          string   `json:"name,omitempty"`
+	RegionSlug    string   `json:"region,omitempty"`
+	VersionSlug   string   `json:"version,omitempty"`
+	Tags          []string `json:"tags,omitempty"`
+	VPCUUID       string   `json:"vpc_uuid,omitempty"`
+	ClusterSubnet string   `json:"cluster_subnet,omitempty"`
+	ServiceSubnet string   `json:"service_subnet,omitempty"`
+
+	NodePools []*KubernetesNodePoolCreateRequest `json:"node_pools,omitempty"`
+
+	MaintenancePolicy *KubernetesMaintenancePolicy `json:"maintenance_policy,omitempty"`
+	AutoUpgrade       bool                         `json:"auto_upgrade,omitempty"`
+	SurgeUpgrade      bool                         `json:"surge_upgrade,omitempty"`
+	HA                bool                         `json:"ha,omitempty"`
+}
+
+// KubernetesClusterUpdateRequest represents a request to update a Kubernetes
+// cluster.
+type KubernetesClusterUpdateRequest struct {
+	Name              string                       `json:"name,omitempty"`
+	Tags              []string                     `json:"tags,omitempty"`
+	MaintenancePolicy *KubernetesMaintenancePolicy `json:"maintenance_policy,omitempty"`
+
+	// Pointers so that false can be sent to turn the settings off.
+	AutoUpgrade  *bool `json:"auto_upgrade,omitempty"`
+	SurgeUpgrade *bool `json:"surge_upgrade,omitempty"`
+	HA           *bool `json:"ha,omitempty"`
+}
+
+// KubernetesClusterUpgradeRequest represents a request to upgrade a
+// Kubernetes cluster.
+type KubernetesClusterUpgradeRequest struct {
+	VersionSlug string `json:"version,omitempty"`
+}
+
+// KubernetesNodePoolCreateRequest represents a request to create a node pool
+// for a Kubernetes cluster.
+type KubernetesNodePoolCreateRequest struct {
+	Name      string            `json:"name,omitempty"`
+	Size      string            `json:"size,omitempty"`
+	Count     int               `json:"count,omitempty"`
+	Tags      []string          `json:"tags,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	AutoScale bool              `json:"auto_scale,omitempty"`
+	MinNodes  int               `json:"min_nodes,omitempty"`
+	MaxNodes  int               `json:"max_nodes,omitempty"`
+}
+
+// KubernetesNodePoolUpdateRequest represents a request to update a node pool
+// in a Kubernetes cluster. Nil fields are left unchanged.
+type KubernetesNodePoolUpdateRequest struct {
+	Name      string            `json:"name,omitempty"`
+	Count     *int              `json:"count,omitempty"`
+	Tags      []string          `json:"tags,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	AutoScale *bool             `json:"auto_scale,omitempty"`
+	MinNodes  *int              `json:"min_nodes,omitempty"`
+	MaxNodes  *int              `json:"max_nodes,omitempty"`
+}
+
+// KubernetesNodePoolRecycleNodesRequest is a request to recycle a set of
+// nodes in a node pool. Recycled nodes are drained, deleted and replaced.
+type KubernetesNodePoolRecycleNodesRequest struct {
+	Nodes []string `json:"nodes,omitempty"`
+}
+
+// KubernetesNodeDeleteRequest is a request to delete a specific node in a
+// node pool. Replace creates a new node in its place and SkipDrain deletes
+// the node without evicting its pods first.
+type KubernetesNodeDeleteRequest struct {
+	Replace   bool `url:"replace,omitempty"`
+	SkipDrain bool `url:"skip_drain,omitempty"`
+}
+
+// KubernetesClusterCredentials represents Kubernetes cluster credentials.
+type KubernetesClusterCredentials struct {
+	Server                   string    `json:"server"`
+	CertificateAuthorityData []byte    `json:"certificate_authority_data"`
+	ClientCertificateData    []byte    `json:"client_certificate_data"`
+	ClientKeyData            []byte    `json:"client_key_data"`
+	Token                    string    `json:"token"`
+	ExpiresAt                time.Time `json:"expires_at"`
+}
+
+// KubernetesClusterCredentialsGetRequest is a request to get cluster
+// credentials. ExpirySeconds limits how long the credentials are valid, the
+// API default is seven days.
+type KubernetesClusterCredentialsGetRequest struct {
+	ExpirySeconds *int `url:"expiry_seconds,omitempty"`
+}
+
+// KubernetesClusterConfig is the content of a Kubernetes config file, which
+// can be used to interact with your Kubernetes cluster using `kubectl`.
+// See: https://kubernetes.io/docs/tasks/tools/install-kubectl/
+type KubernetesClusterConfig struct {
+	KubeconfigYAML []byte
+}
+
+// KubernetesVersion is a DigitalOcean Kubernetes release.
+type KubernetesVersion struct {
+	Slug              string   `json:"slug,omitempty"`
+	KubernetesVersion string   `json:"kubernetes_version,omitempty"`
+	SupportedFeatures []string `json:"supported_features,omitempty"`
+}
+
+// KubernetesRegion is a region usable by Kubernetes clusters.
+type KubernetesRegion struct {
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// KubernetesNodeSize is a node size usable by Kubernetes clusters.
+type KubernetesNodeSize struct {
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// KubernetesOptions represents the versions, regions and node sizes
+// Kubernetes clusters can be created with.
+type KubernetesOptions struct {
+	Versions []*KubernetesVersion  `json:"versions,omitempty"`
+	Regions  []*KubernetesRegion   `json:"regions,omitempty"`
+	Sizes    []*KubernetesNodeSize `json:"sizes,omitempty"`
+}
+
+/* SERVICE */
+
+// KubernetesService is an interface for interfacing with the Kubernetes endpoints
+// of the DigitalOcean API.
+// See: https://docs.digitalocean.com/reference/api/api-reference/#tag/Kubernetes
+type KubernetesService interface {
+	List(context.Context, *ListOptions) ([]*KubernetesCluster, *Response, error)
+	ListAll(context.Context, *ListAllOptions) ([]*KubernetesCluster, *Response, error)
+	Get(context.Context, string) (*KubernetesCluster, *Response, error)
+	Create(context.Context, *KubernetesClusterCreateRequest) (*KubernetesCluster, *Response, error)
+	CreateAndWait(context.Context, *KubernetesClusterCreateRequest, WaitOptions) (*KubernetesCluster, *Response, error)
+	Update(context.Context, string, *KubernetesClusterUpdateRequest) (*KubernetesCluster, *Response, error)
+	Delete(context.Context, string) (*Response, error)
+
+	GetKubeConfig(context.Context, string) (*KubernetesClusterConfig, *Response, error)
+	GetCredentials(context.Context, string, *KubernetesClusterCredentialsGetRequest) (*KubernetesClusterCredentials, *Response, error)
+
+	Upgrade(context.Context, string, *KubernetesClusterUpgradeRequest) (*Response, error)
+	GetUpgrades(context.Context, string) ([]*KubernetesVersion, *Response, error)
+	GetOptions(context.Context) (*KubernetesOptions, *Response, error)
+
+	CreateNodePool(ctx context.Context, clusterID string, req *KubernetesNodePoolCreateRequest) (*KubernetesNodePool, *Response, error)
+	GetNodePool(ctx context.Context, clusterID, poolID string) (*KubernetesNodePool, *Response, error)
+	ListNodePools(ctx context.Context, clusterID string, opts *ListOptions) ([]*KubernetesNodePool, *Response, error)
+	UpdateNodePool(ctx context.Context, clusterID, poolID string, req *KubernetesNodePoolUpdateRequest) (*KubernetesNodePool, *Response, error)
+	RecycleNodePoolNodes(ctx context.Context, clusterID, poolID string, req *KubernetesNodePoolRecycleNodesRequest) (*Response, error)
+	DeleteNodePool(ctx context.Context, clusterID, poolID string) (*Response, error)
+	DeleteNode(ctx context.Context, clusterID, poolID, nodeID string, req *KubernetesNodeDeleteRequest) (*Response, error)
+}
+
+// KubernetesServiceOp handles communication with Kubernetes methods of the
+// DigitalOcean API.
+type KubernetesServiceOp struct {
+	client *Client
+}
+
+var _ KubernetesService = &KubernetesServiceOp{}
+
+// List returns a list of the Kubernetes clusters visible with the caller's API token.
+func (s *KubernetesServiceOp) List(ctx context.Context, opt *ListOptions) ([]*KubernetesCluster, *Response, error) {
+	path, err := addOptions(kubernetesClustersPath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clusters, resp, err := DoEnvelope[[]*KubernetesCluster](ctx, s.client, req, "kubernetes_clusters")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *clusters, resp, err
+}
+
+// ListAll Kubernetes clusters across all pages
+func (s *KubernetesServiceOp) ListAll(ctx context.Context, opt *ListAllOptions) ([]*KubernetesCluster, *Response, error) {
+	return ListAll[*KubernetesCluster](ctx, s.List, opt)
+}
+
+// Get retrieves the details of a Kubernetes cluster.
+func (s *KubernetesServiceOp) Get(ctx context.Context, clusterID string) (*KubernetesCluster, *Response, error) {
+	path, err := kubernetesClusterPath(clusterID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doCluster(ctx, req)
+}
+
+// Create creates a Kubernetes cluster.
+func (s *KubernetesServiceOp) Create(ctx context.Context, createRequest *KubernetesClusterCreateRequest) (*KubernetesCluster, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, &ValidationError{Field: "createRequest", Reason: "cannot be nil"}
+	}
+	if createRequest.Name == "" {
+		return nil, nil, &ValidationError{Field: "name", Reason: "must not be empty"}
+	}
+	if createRequest.RegionSlug == "" {
+		return nil, nil, &ValidationError{Field: "region", Reason: "must not be empty"}
+	}
+	if createRequest.VersionSlug == "" {
+		return nil, nil, &ValidationError{Field: "version", Reason: "must not be empty"}
+	}
+	if len(createRequest.NodePools) == 0 {
+		return nil, nil, &ValidationError{Field: "node_pools", Reason: "must not be empty"}
+	}
+	for _, pool := range createRequest.NodePools {
+		if err := validateNodePoolCreate(pool); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, kubernetesClustersPath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doCluster(ctx, req)
+}
+
+// CreateAndWait creates a Kubernetes cluster and waits until it is running,
+// polling it as configured by opts. A cluster which ends up in the error or
+// invalid state is reported as a *KubernetesClusterProvisioningError.
+func (s *KubernetesServiceOp) CreateAndWait(ctx context.Context, createRequest *KubernetesClusterCreateRequest, opts WaitOptions) (*KubernetesCluster, *Response, error) {
+	cluster, resp, err := s.Create(ctx, createRequest)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	clusterID := cluster.ID
+	cluster, err = pollFrom(ctx, opts, cluster, func(ctx context.Context) (*KubernetesCluster, error) {
+		latest, latestResp, err := s.Get(ctx, clusterID)
+		resp = latestResp
+		return latest, err
+	}, func(cluster *KubernetesCluster) (bool, string, error) {
+		if cluster.Status == nil {
+			return false, "", nil
+		}
+		state := cluster.Status.State
+		switch state {
+		case KubernetesClusterStatusRunning:
+			return true, string(state), nil
+		case KubernetesClusterStatusError, KubernetesClusterStatusInvalid:
+			return false, string(state), &KubernetesClusterProvisioningError{Cluster: cluster}
+		}
+		return false, string(state), nil
+	})
+	return cluster, resp, err
+}
+
+// Update updates a Kubernetes cluster's properties.
+func (s *KubernetesServiceOp) Update(ctx context.Context, clusterID string, updateRequest *KubernetesClusterUpdateRequest) (*KubernetesCluster, *Response, error) {
+	path, err := kubernetesClusterPath(clusterID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if updateRequest == nil {
+		return nil, nil, &ValidationError{Field: "updateRequest", Reason: "cannot be nil"}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, updateRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doCluster(ctx, req)
+}
+
+// Delete deletes a Kubernetes cluster. There is no way to recover a cluster
+// once it has been destroyed.
+func (s *KubernetesServiceOp) Delete(ctx context.Context, clusterID string) (*Response, error) {
+	path, err := kubernetesClusterPath(clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// GetKubeConfig returns a Kubernetes config file for the specified cluster.
+func (s *KubernetesServiceOp) GetKubeConfig(ctx context.Context, clusterID string) (*KubernetesClusterConfig, *Response, error) {
+	path, err := kubernetesClusterPath(clusterID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path+"/kubeconfig", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configBytes := new(bytes.Buffer)
+	resp, err := s.client.Do(ctx, req, configBytes)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &KubernetesClusterConfig{KubeconfigYAML: configBytes.Bytes()}, resp, nil
+}
+
+// GetCredentials returns the credentials needed to access a Kubernetes
+// cluster. The credentials expire at ExpiresAt and must be fetched again
+// afterwards.
+func (s *KubernetesServiceOp) GetCredentials(ctx context.Context, clusterID string, getRequest *KubernetesClusterCredentialsGetRequest) (*KubernetesClusterCredentials, *Response, error) {
+	path, err := kubernetesClusterPath(clusterID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if getRequest != nil && getRequest.ExpirySeconds != nil && *getRequest.ExpirySeconds <= 0 {
+		return nil, nil, &ValidationError{Field: "expiry_seconds", Value: fmt.Sprint(*getRequest.ExpirySeconds), Reason: "must be positive"}
+	}
+
+	path, err = addOptions(path+"/credentials", getRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return Do[KubernetesClusterCredentials](ctx, s.client, req)
+}
+
+// Upgrade upgrades a Kubernetes cluster to a new version. Valid upgrade
+// versions for a given cluster can be retrieved with `GetUpgrades`.
+func (s *KubernetesServiceOp) Upgrade(ctx context.Context, clusterID string, upgradeRequest *KubernetesClusterUpgradeRequest) (*Response, error) {
+	path, err := kubernetesClusterPath(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if upgradeRequest == nil {
+		return nil, &ValidationError{Field: "upgradeRequest", Reason: "cannot be nil"}
+	}
+	if upgradeRequest.VersionSlug == "" {
+		return nil, &ValidationError{Field: "version", Reason: "must not be empty"}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path+"/upgrade", upgradeRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// GetUpgrades returns the versions a Kubernetes cluster can be upgraded to.
+func (s *KubernetesServiceOp) GetUpgrades(ctx context.Context, clusterID string) ([]*KubernetesVersion, *Response, error) {
+	path, err := kubernetesClusterPath(clusterID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path+"/upgrades", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	versions, resp, err := DoEnvelope[[]*KubernetesVersion](ctx, s.client, req, "available_upgrade_versions")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *versions, resp, err
+}
+
+// GetOptions returns the versions, regions and node sizes available to
+// Kubernetes clusters.
+func (s *KubernetesServiceOp) GetOptions(ctx context.Context) (*KubernetesOptions, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, kubernetesOptionsPath, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return DoEnvelope[KubernetesOptions](ctx, s.client, req, "options")
+}
+
+// CreateNodePool adds a node pool to a Kubernetes cluster.
+func (s *KubernetesServiceOp) CreateNodePool(ctx context.Context, clusterID string, createRequest *KubernetesNodePoolCreateRequest) (*KubernetesNodePool, *Response, error) {
+	path, err := kubernetesClusterPath(clusterID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := validateNodePoolCreate(createRequest); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path+"/node_pools", createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doNodePool(ctx, req)
+}
+
+// GetNodePool retrieves an existing node pool in a Kubernetes cluster.
+func (s *KubernetesServiceOp) GetNodePool(ctx context.Context, clusterID, poolID string) (*KubernetesNodePool, *Response, error) {
+	path, err := kubernetesNodePoolPath(clusterID, poolID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doNodePool(ctx, req)
+}
+
+// ListNodePools lists all the node pools found in a Kubernetes cluster.
+func (s *KubernetesServiceOp) ListNodePools(ctx context.Context, clusterID string, opt *ListOptions) ([]*KubernetesNodePool, *Response, error) {
+	path, err := kubernetesClusterPath(clusterID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	path, err = addOptions(path+"/node_pools", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pools, resp, err := DoEnvelope[[]*KubernetesNodePool](ctx, s.client, req, "node_pools")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *pools, resp, err
+}
+
+// UpdateNodePool updates the details of an existing node pool.
+func (s *KubernetesServiceOp) UpdateNodePool(ctx context.Context, clusterID, poolID string, updateRequest *KubernetesNodePoolUpdateRequest) (*KubernetesNodePool, *Response, error) {
+	path, err := kubernetesNodePoolPath(clusterID, poolID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if updateRequest == nil {
+		return nil, nil, &ValidationError{Field: "updateRequest", Reason: "cannot be nil"}
+	}
+	if min, max := updateRequest.MinNodes, updateRequest.MaxNodes; min != nil && max != nil {
+		if err := validateNodePoolAutoScale(*min, *max); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, updateRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doNodePool(ctx, req)
+}
+
+// RecycleNodePoolNodes schedules nodes in a node pool for recycling.
+//
+// Deprecated: use DeleteNode with Replace set instead.
+func (s *KubernetesServiceOp) RecycleNodePoolNodes(ctx context.Context, clusterID, poolID string, recycleRequest *KubernetesNodePoolRecycleNodesRequest) (*Response, error) {
+	path, err := kubernetesNodePoolPath(clusterID, poolID)
+	if err != nil {
+		return nil, err
+	}
+	if recycleRequest == nil || len(recycleRequest.Nodes) == 0 {
+		return nil, &ValidationError{Field: "nodes", Reason: "must not be empty"}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path+"/recycle", recycleRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// DeleteNodePool deletes a node pool, and subsequently all the nodes in that pool.
+func (s *KubernetesServiceOp) DeleteNodePool(ctx context.Context, clusterID, poolID string) (*Response, error) {
+	path, err := kubernetesNodePoolPath(clusterID, poolID)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// DeleteNode deletes a specific node in a node pool. By default the node is
+// drained first and not replaced, see KubernetesNodeDeleteRequest.
+func (s *KubernetesServiceOp) DeleteNode(ctx context.Context, clusterID, poolID, nodeID string, deleteRequest *KubernetesNodeDeleteRequest) (*Response, error) {
+	path, err := kubernetesNodePoolPath(clusterID, poolID)
+	if err != nil {
+		return nil, err
+	}
+	if nodeID == "" {
+		return nil, &ValidationError{Field: "nodeID", Reason: "must not be empty"}
+	}
+
+	path, err = addOptions(fmt.Sprintf("%s/nodes/%s", path, nodeID), deleteRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *KubernetesServiceOp) doNodePool(ctx context.Context, req *http.Request) (*KubernetesNodePool, *Response, error) {
+	pool, resp, err := DoEnvelope[KubernetesNodePool](ctx, s.client, req, "node_pool")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pool, resp, err
+}
+
+func (s *KubernetesServiceOp) doCluster(ctx context.Context, req *http.Request) (*KubernetesCluster, *Response, error) {
+	cluster, resp, err := DoEnvelope[KubernetesCluster](ctx, s.client, req, "kubernetes_cluster")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return cluster, resp, err
+}
+
+// kubernetesClusterPath returns the path of a cluster.
+func kubernetesClusterPath(clusterID string) (string, error) {
+	if clusterID == "" {
+		return "", &ValidationError{Field: "clusterID", Reason: "must not be empty"}
+	}
+	return fmt.Sprintf("%s/%s", kubernetesClustersPath, clusterID), nil
+}
+
+// kubernetesNodePoolPath returns the path of a node pool of a cluster.
+func kubernetesNodePoolPath(clusterID, poolID string) (string, error) {
+	path, err := kubernetesClusterPath(clusterID)
+	if err != nil {
+		return "", err
+	}
+	if poolID == "" {
+		return "", &ValidationError{Field: "poolID", Reason: "must not be empty"}
+	}
+	return fmt.Sprintf("%s/node_pools/%s", path, poolID), nil
+}
+
+func validateNodePoolCreate(pool *KubernetesNodePoolCreateRequest) error {
+	if pool == nil {
+		return &ValidationError{Field: "nodePool", Reason: "cannot be nil"}
+	}
+	if pool.Name == "" {
+		return &ValidationError{Field: "name", Reason: "must not be empty"}
+	}
+	if pool.Size == "" {
+		return &ValidationError{Field: "size", Reason: "must not be empty"}
+	}
+	if pool.AutoScale {
+		return validateNodePoolAutoScale(pool.MinNodes, pool.MaxNodes)
+	}
+	return nil
+}
+
+// validateNodePoolAutoScale checks the bounds of an autoscaled node pool.
+func validateNodePoolAutoScale(min, max int) error {
+	if min < 0 {
+		return &ValidationError{Field: "min_nodes", Value: fmt.Sprint(min), Reason: "must not be negative"}
+	}
+	if max < min {
+		return &ValidationError{Field: "max_nodes", Value: fmt.Sprint(max), Reason: "must not be less than min_nodes"}
+	}
+	return nil
+}
@@ -0,0 +1,438 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestKubernetes_List(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/kubernetes/clusters", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"kubernetes_clusters":[{"id":"bd5f5959","name":"prod","region":"nyc1","version":"1.29.1-do.0","status":{"state":"running"},"node_pools":[{"id":"cdda885e","size":"s-1vcpu-2gb","count":3}]}]}`)
+	})
+
+	clusters, _, err := c.Kubernetes.List(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Kubernetes.List returned error: %v", err)
+	}
+	if len(clusters) != 1 || clusters[0].RegionSlug != "nyc1" || clusters[0].Status.State != KubernetesClusterStatusRunning {
+		t.Fatalf("got clusters %+v", clusters)
+	}
+	if pools := clusters[0].NodePools; len(pools) != 1 || pools[0].Count != 3 {
+		t.Errorf("got node pools %+v", pools)
+	}
+	if got := clusters[0].URN(); got != "do:kubernetes:bd5f5959" {
+		t.Errorf("got URN %q", got)
+	}
+}
+
+func TestKubernetes_Create(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/kubernetes/clusters", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var req KubernetesClusterCreateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if req.Name != "prod" || len(req.NodePools) != 1 || req.NodePools[0].Size != "s-1vcpu-2gb" {
+			t.Errorf("got request %+v", req)
+		}
+		if req.MaintenancePolicy == nil || req.MaintenancePolicy.Day != KubernetesMaintenanceDaySunday {
+			t.Errorf("got maintenance policy %+v", req.MaintenancePolicy)
+		}
+		fmt.Fprint(w, `{"kubernetes_cluster":{"id":"bd5f5959","status":{"state":"provisioning"}}}`)
+	})
+
+	cluster, _, err := c.Kubernetes.Create(context.Background(), &KubernetesClusterCreateRequest{
+		Name:              "prod",
+		RegionSlug:        "nyc1",
+		VersionSlug:       "1.29.1-do.0",
+		NodePools:         []*KubernetesNodePoolCreateRequest{{Name: "workers", Size: "s-1vcpu-2gb", Count: 3}},
+		MaintenancePolicy: &KubernetesMaintenancePolicy{StartTime: "00:00", Day: KubernetesMaintenanceDaySunday},
+	})
+	if err != nil {
+		t.Fatalf("Kubernetes.Create returned error: %v", err)
+	}
+	if cluster.ID != "bd5f5959" || cluster.Status.State != KubernetesClusterStatusProvisioning {
+		t.Errorf("got cluster %+v", cluster)
+	}
+}
+
+func TestKubernetes_CreateAndWait(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/kubernetes/clusters", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"kubernetes_cluster":{"id":"bd5f5959","status":{"state":"provisioning"}}}`)
+	})
+	polls := 0
+	mux.HandleFunc("/v2/kubernetes/clusters/bd5f5959", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		polls++
+		state := KubernetesClusterStatusProvisioning
+		if polls == 2 {
+			state = KubernetesClusterStatusRunning
+		}
+		fmt.Fprintf(w, `{"kubernetes_cluster":{"id":"bd5f5959","endpoint":"https://bd5f5959.k8s.ondigitalocean.com","status":{"state":%q}}}`, state)
+	})
+
+	cluster, _, err := c.Kubernetes.CreateAndWait(context.Background(), &KubernetesClusterCreateRequest{
+		Name:        "prod",
+		RegionSlug:  "nyc1",
+		VersionSlug: "1.29.1-do.0",
+		NodePools:   []*KubernetesNodePoolCreateRequest{{Name: "workers", Size: "s-1vcpu-2gb", Count: 3}},
+	}, WaitOptions{PollInterval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Kubernetes.CreateAndWait returned error: %v", err)
+	}
+	if cluster.Status.State != KubernetesClusterStatusRunning || cluster.Endpoint == "" || polls != 2 {
+		t.Errorf("got cluster %+v after %d polls", cluster, polls)
+	}
+}
+
+func TestKubernetes_CreateAndWait_errored(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/kubernetes/clusters", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"kubernetes_cluster":{"id":"bd5f5959","status":{"state":"provisioning"}}}`)
+	})
+	mux.HandleFunc("/v2/kubernetes/clusters/bd5f5959", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"kubernetes_cluster":{"id":"bd5f5959","status":{"state":"error","message":"quota exceeded"}}}`)
+	})
+
+	var perr *KubernetesClusterProvisioningError
+	_, _, err := c.Kubernetes.CreateAndWait(context.Background(), &KubernetesClusterCreateRequest{
+		Name:        "prod",
+		RegionSlug:  "nyc1",
+		VersionSlug: "1.29.1-do.0",
+		NodePools:   []*KubernetesNodePoolCreateRequest{{Name: "workers", Size: "s-1vcpu-2gb", Count: 3}},
+	}, WaitOptions{PollInterval: time.Millisecond})
+	if !errors.As(err, &perr) || perr.Cluster.Status.Message != "quota exceeded" {
+		t.Errorf("expected *KubernetesClusterProvisioningError, got %v", err)
+	}
+}
+
+func TestKubernetes_Update(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/kubernetes/clusters/bd5f5959", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		var req map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if req["auto_upgrade"] != false {
+			t.Errorf("expected auto_upgrade turned off, got %v", req)
+		}
+		if _, ok := req["ha"]; ok {
+			t.Errorf("expected ha left unchanged, got %v", req)
+		}
+		fmt.Fprint(w, `{"kubernetes_cluster":{"id":"bd5f5959"}}`)
+	})
+
+	autoUpgrade := false
+	if _, _, err := c.Kubernetes.Update(context.Background(), "bd5f5959", &KubernetesClusterUpdateRequest{AutoUpgrade: &autoUpgrade}); err != nil {
+		t.Fatalf("Kubernetes.Update returned error: %v", err)
+	}
+}
+
+func TestKubernetes_GetDelete(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/kubernetes/clusters/bd5f5959", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"kubernetes_cluster":{"id":"bd5f5959","endpoint":"https://bd5f5959.k8s.ondigitalocean.com"}}`)
+	})
+
+	cluster, _, err := c.Kubernetes.Get(context.Background(), "bd5f5959")
+	if err != nil {
+		t.Fatalf("Kubernetes.Get returned error: %v", err)
+	}
+	if cluster.Endpoint != "https://bd5f5959.k8s.ondigitalocean.com" {
+		t.Errorf("got cluster %+v", cluster)
+	}
+	if _, err := c.Kubernetes.Delete(context.Background(), "bd5f5959"); err != nil {
+		t.Errorf("Kubernetes.Delete returned error: %v", err)
+	}
+}
+
+func TestKubernetes_GetKubeConfig(t *testing.T) {
+	c, mux := setup(t)
+
+	kubeconfig := "apiVersion: v1\nkind: Config\n"
+	mux.HandleFunc("/v2/kubernetes/clusters/bd5f5959/kubeconfig", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.Header().Set("Content-Type", "application/yaml")
+		fmt.Fprint(w, kubeconfig)
+	})
+
+	config, _, err := c.Kubernetes.GetKubeConfig(context.Background(), "bd5f5959")
+	if err != nil {
+		t.Fatalf("Kubernetes.GetKubeConfig returned error: %v", err)
+	}
+	if string(config.KubeconfigYAML) != kubeconfig {
+		t.Errorf("got kubeconfig %q", config.KubeconfigYAML)
+	}
+}
+
+func TestKubernetes_GetCredentials(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/kubernetes/clusters/bd5f5959/credentials", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("expiry_seconds") != "3600" {
+			t.Errorf("expected expiry_seconds=3600, got %q", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"server":"https://bd5f5959.k8s.ondigitalocean.com","certificate_authority_data":"Y2E=","token":"secret","expires_at":"2024-01-02T03:04:05Z"}`)
+	})
+
+	expiry := 3600
+	creds, _, err := c.Kubernetes.GetCredentials(context.Background(), "bd5f5959", &KubernetesClusterCredentialsGetRequest{ExpirySeconds: &expiry})
+	if err != nil {
+		t.Fatalf("Kubernetes.GetCredentials returned error: %v", err)
+	}
+	if string(creds.CertificateAuthorityData) != "ca" || creds.Token != "secret" || creds.ExpiresAt.Year() != 2024 {
+		t.Errorf("got credentials %+v", creds)
+	}
+}
+
+func TestKubernetes_upgrades(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/kubernetes/clusters/bd5f5959/upgrades", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"available_upgrade_versions":[{"slug":"1.30.0-do.0","kubernetes_version":"1.30.0"}]}`)
+	})
+	mux.HandleFunc("/v2/kubernetes/clusters/bd5f5959/upgrade", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var req KubernetesClusterUpgradeRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if req.VersionSlug != "1.30.0-do.0" {
+			t.Errorf("got request %+v", req)
+		}
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	versions, _, err := c.Kubernetes.GetUpgrades(context.Background(), "bd5f5959")
+	if err != nil {
+		t.Fatalf("Kubernetes.GetUpgrades returned error: %v", err)
+	}
+	if len(versions) != 1 || versions[0].KubernetesVersion != "1.30.0" {
+		t.Fatalf("got versions %+v", versions)
+	}
+	if _, err := c.Kubernetes.Upgrade(context.Background(), "bd5f5959", &KubernetesClusterUpgradeRequest{VersionSlug: versions[0].Slug}); err != nil {
+		t.Errorf("Kubernetes.Upgrade returned error: %v", err)
+	}
+}
+
+func TestKubernetes_GetOptions(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/kubernetes/options", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"options":{"versions":[{"slug":"1.29.1-do.0"}],"regions":[{"name":"New York 1","slug":"nyc1"}],"sizes":[{"name":"s-1vcpu-2gb","slug":"s-1vcpu-2gb"}]}}`)
+	})
+
+	options, _, err := c.Kubernetes.GetOptions(context.Background())
+	if err != nil {
+		t.Fatalf("Kubernetes.GetOptions returned error: %v", err)
+	}
+	if len(options.Versions) != 1 || len(options.Regions) != 1 || options.Sizes[0].Slug != "s-1vcpu-2gb" {
+		t.Errorf("got options %+v", options)
+	}
+}
+
+func TestKubernetes_validation(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+	k := c.Kubernetes
+	pools := []*KubernetesNodePoolCreateRequest{{Name: "workers", Size: "s-1vcpu-2gb"}}
+	zero := 0
+
+	calls := map[string]func() error{
+		"get empty id": func() error { _, _, err := k.Get(ctx, ""); return err },
+		"create nil":   func() error { _, _, err := k.Create(ctx, nil); return err },
+		"create no name": func() error {
+			_, _, err := k.Create(ctx, &KubernetesClusterCreateRequest{RegionSlug: "nyc1", VersionSlug: "latest", NodePools: pools})
+			return err
+		},
+		"create no pools": func() error {
+			_, _, err := k.Create(ctx, &KubernetesClusterCreateRequest{Name: "prod", RegionSlug: "nyc1", VersionSlug: "latest"})
+			return err
+		},
+		"update nil":      func() error { _, _, err := k.Update(ctx, "bd5f5959", nil); return err },
+		"delete empty id": func() error { _, err := k.Delete(ctx, ""); return err },
+		"kubeconfig":      func() error { _, _, err := k.GetKubeConfig(ctx, ""); return err },
+		"credentials": func() error {
+			_, _, err := k.GetCredentials(ctx, "bd5f5959", &KubernetesClusterCredentialsGetRequest{ExpirySeconds: &zero})
+			return err
+		},
+		"upgrade nil":     func() error { _, err := k.Upgrade(ctx, "bd5f5959", nil); return err },
+		"upgrade version": func() error { _, err := k.Upgrade(ctx, "bd5f5959", &KubernetesClusterUpgradeRequest{}); return err },
+	}
+	for name, call := range calls {
+		var verr *ValidationError
+		if err := call(); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
+
+func TestKubernetes_nodePools(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+
+	mux.HandleFunc("/v2/kubernetes/clusters/bd5f5959/node_pools", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			var req KubernetesNodePoolCreateRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("decoding request body: %v", err)
+			}
+			if !req.AutoScale || req.MinNodes != 1 || req.MaxNodes != 5 || req.Labels["tier"] != "web" {
+				t.Errorf("got request %+v", req)
+			}
+			fmt.Fprint(w, `{"node_pool":{"id":"cdda885e","name":"web","auto_scale":true,"min_nodes":1,"max_nodes":5}}`)
+			return
+		}
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"node_pools":[{"id":"cdda885e","nodes":[{"id":"478247f8","droplet_id":"205545370","status":{"state":"running"}}]}]}`)
+	})
+
+	var methods []string
+	mux.HandleFunc("/v2/kubernetes/clusters/bd5f5959/node_pools/cdda885e", func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		switch r.Method {
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodPut:
+			var req map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("decoding request body: %v", err)
+			}
+			if req["count"] != 0.0 {
+				t.Errorf("expected the count set to 0, got %v", req)
+			}
+			fmt.Fprint(w, `{"node_pool":{"id":"cdda885e"}}`)
+		default:
+			fmt.Fprint(w, `{"node_pool":{"id":"cdda885e","count":2}}`)
+		}
+	})
+
+	pool, _, err := c.Kubernetes.CreateNodePool(ctx, "bd5f5959", &KubernetesNodePoolCreateRequest{
+		Name: "web", Size: "s-1vcpu-2gb", Labels: map[string]string{"tier": "web"}, AutoScale: true, MinNodes: 1, MaxNodes: 5,
+	})
+	if err != nil {
+		t.Fatalf("Kubernetes.CreateNodePool returned error: %v", err)
+	}
+	if !pool.AutoScale || pool.MaxNodes != 5 {
+		t.Errorf("got node pool %+v", pool)
+	}
+
+	pools, _, err := c.Kubernetes.ListNodePools(ctx, "bd5f5959", nil)
+	if err != nil {
+		t.Fatalf("Kubernetes.ListNodePools returned error: %v", err)
+	}
+	if len(pools) != 1 || len(pools[0].Nodes) != 1 || pools[0].Nodes[0].Status.State != "running" {
+		t.Errorf("got node pools %+v", pools)
+	}
+
+	if pool, _, err = c.Kubernetes.GetNodePool(ctx, "bd5f5959", "cdda885e"); err != nil || pool.Count != 2 {
+		t.Fatalf("Kubernetes.GetNodePool returned %+v, %v", pool, err)
+	}
+	count := 0
+	if _, _, err := c.Kubernetes.UpdateNodePool(ctx, "bd5f5959", "cdda885e", &KubernetesNodePoolUpdateRequest{Count: &count}); err != nil {
+		t.Fatalf("Kubernetes.UpdateNodePool returned error: %v", err)
+	}
+	if _, err := c.Kubernetes.DeleteNodePool(ctx, "bd5f5959", "cdda885e"); err != nil {
+		t.Fatalf("Kubernetes.DeleteNodePool returned error: %v", err)
+	}
+
+	if fmt.Sprint(methods) != "[GET PUT DELETE]" {
+		t.Errorf("got methods %v", methods)
+	}
+}
+
+func TestKubernetes_nodes(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+
+	mux.HandleFunc("/v2/kubernetes/clusters/bd5f5959/node_pools/cdda885e/recycle", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var req KubernetesNodePoolRecycleNodesRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if fmt.Sprint(req.Nodes) != "[478247f8]" {
+			t.Errorf("got request %+v", req)
+		}
+		w.WriteHeader(http.StatusAccepted)
+	})
+	mux.HandleFunc("/v2/kubernetes/clusters/bd5f5959/node_pools/cdda885e/nodes/478247f8", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		q := r.URL.Query()
+		if q.Get("replace") != "true" || q.Get("skip_drain") != "" {
+			t.Errorf("expected replace=true only, got %q", r.URL.RawQuery)
+		}
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	if _, err := c.Kubernetes.RecycleNodePoolNodes(ctx, "bd5f5959", "cdda885e", &KubernetesNodePoolRecycleNodesRequest{Nodes: []string{"478247f8"}}); err != nil {
+		t.Fatalf("Kubernetes.RecycleNodePoolNodes returned error: %v", err)
+	}
+	if _, err := c.Kubernetes.DeleteNode(ctx, "bd5f5959", "cdda885e", "478247f8", &KubernetesNodeDeleteRequest{Replace: true}); err != nil {
+		t.Fatalf("Kubernetes.DeleteNode returned error: %v", err)
+	}
+}
+
+func TestKubernetes_nodePools_validation(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+	k := c.Kubernetes
+	one, zero := 1, 0
+
+	calls := map[string]func() error{
+		"create nil": func() error { _, _, err := k.CreateNodePool(ctx, "bd5f5959", nil); return err },
+		"create no size": func() error {
+			_, _, err := k.CreateNodePool(ctx, "bd5f5959", &KubernetesNodePoolCreateRequest{Name: "web"})
+			return err
+		},
+		"create max < min": func() error {
+			_, _, err := k.CreateNodePool(ctx, "bd5f5959", &KubernetesNodePoolCreateRequest{Name: "web", Size: "s-1vcpu-2gb", AutoScale: true, MinNodes: 3, MaxNodes: 1})
+			return err
+		},
+		"create min < 0": func() error {
+			_, _, err := k.CreateNodePool(ctx, "bd5f5959", &KubernetesNodePoolCreateRequest{Name: "web", Size: "s-1vcpu-2gb", AutoScale: true, MinNodes: -1})
+			return err
+		},
+		"get empty pool":  func() error { _, _, err := k.GetNodePool(ctx, "bd5f5959", ""); return err },
+		"list no cluster": func() error { _, _, err := k.ListNodePools(ctx, "", nil); return err },
+		"update nil":      func() error { _, _, err := k.UpdateNodePool(ctx, "bd5f5959", "cdda885e", nil); return err },
+		"update max < min": func() error {
+			_, _, err := k.UpdateNodePool(ctx, "bd5f5959", "cdda885e", &KubernetesNodePoolUpdateRequest{MinNodes: &one, MaxNodes: &zero})
+			return err
+		},
+		"recycle nil": func() error { _, err := k.RecycleNodePoolNodes(ctx, "bd5f5959", "cdda885e", nil); return err },
+		"delete pool": func() error { _, err := k.DeleteNodePool(ctx, "", "cdda885e"); return err },
+		"delete node": func() error { _, err := k.DeleteNode(ctx, "bd5f5959", "cdda885e", "", nil); return err },
+	}
+	for name, call := range calls {
+		var verr *ValidationError
+		if err := call(); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// LimitResource is a kind of resource the account limits the number of.
+type LimitResource string
+
+// Limited resources
+const (
+	LimitDroplets    LimitResource = "droplets"
+	LimitVolumes     LimitResource = "volumes"
+	LimitReservedIPs LimitResource = "reserved_ips"
+)
+
+// Limit is the number of resources of a kind the account may have, and the
+// number it has.
+type Limit struct {
+	Limit int
+	Used  int
+}
+
+// Headroom returns the number of resources which can still be created.
+func (l Limit) Headroom() int {
+	if l.Used >= l.Limit {
+		return 0
+	}
+	return l.Limit - l.Used
+}
+
+// Limits are the resource limits of the account and their current usage.
+type Limits struct {
+	Droplets    Limit
+	Volumes     Limit
+	ReservedIPs Limit
+}
+
+// LimitExceededError occurs when creating resources would exceed a limit of
+// the account.
+type LimitExceededError struct {
+	Resource  LimitResource
+	Requested int
+	Limit     Limit
+}
+
+func (e *LimitExceededError) Error() string {
+	return fmt.Sprintf("creating %d %s exceeds the limit of %d with %d in use", e.Requested, e.Resource, e.Limit.Limit, e.Limit.Used)
+}
+
+/* SERVICE */
+
+// LimitsService is an interface for checking the resource limits of the
+// account. The limits are read from the account and the usage is counted
+// with the list endpoints of the resources.
+type LimitsService interface {
+	Get(context.Context) (*Limits, *Response, error)
+	CheckHeadroom(context.Context, LimitResource, int) error
+}
+
+// LimitsServiceOp handles checking the resource limits of the account with
+// the DigitalOcean API.
+type LimitsServiceOp struct {
+	client *Client
+}
+
+var _ LimitsService = &LimitsServiceOp{}
+
+// Get returns the resource limits of the account and their usage.
+func (s *LimitsServiceOp) Get(ctx context.Context) (*Limits, *Response, error) {
+	account, resp, err := s.client.Account.Get(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	limits := &Limits{
+		Droplets:    Limit{Limit: account.DropletLimit},
+		Volumes:     Limit{Limit: account.VolumeLimit},
+		ReservedIPs: Limit{Limit: account.ReservedIPLimit},
+	}
+	for _, l := range []struct {
+		resource LimitResource
+		limit    *Limit
+	}{
+		{LimitDroplets, &limits.Droplets},
+		{LimitVolumes, &limits.Volumes},
+		{LimitReservedIPs, &limits.ReservedIPs},
+	} {
+		l.limit.Used, resp, err = s.count(ctx, l.resource)
+		if err != nil {
+			return nil, resp, err
+		}
+	}
+
+	return limits, resp, nil
+}
+
+// CheckHeadroom returns a *LimitExceededError if n more resources can not be
+// created without exceeding the limit of the account, so provisioning can
+// fail before creating any of them.
+func (s *LimitsServiceOp) CheckHeadroom(ctx context.Context, resource LimitResource, n int) error {
+	if n < 1 {
+		return &ValidationError{Field: "n", Reason: "must be positive"}
+	}
+
+	account, _, err := s.client.Account.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	var limit Limit
+	switch resource {
+	case LimitDroplets:
+		limit.Limit = account.DropletLimit
+	case LimitVolumes:
+		limit.Limit = account.VolumeLimit
+	case LimitReservedIPs:
+		limit.Limit = account.ReservedIPLimit
+	default:
+		return &ValidationError{Field: "resource", Value: string(resource), Reason: "must be droplets, volumes or reserved_ips"}
+	}
+
+	limit.Used, _, err = s.count(ctx, resource)
+	if err != nil {
+		return err
+	}
+	if n > limit.Headroom() {
+		return &LimitExceededError{Resource: resource, Requested: n, Limit: limit}
+	}
+	return nil
+}
+
+// count returns the number of resources of the account.
+func (s *LimitsServiceOp) count(ctx context.Context, resource LimitResource) (int, *Response, error) {
+	switch resource {
+	case LimitDroplets:
+		return Count(ctx, s.client.Droplets.List, nil)
+	case LimitVolumes:
+		return Count(ctx, func(ctx context.Context, opt *ListOptions) ([]Volume, *Response, error) {
+			return s.client.Storage.ListVolumes(ctx, &VolumeListOptions{ListOptions: *opt})
+		}, nil)
+	default:
+		return Count(ctx, s.client.ReservedIPs.List, nil)
+	}
+}
@@ -0,0 +1,91 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func setupLimits(t *testing.T, droplets, volumes, reservedIPs int) *Client {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"account":{"droplet_limit":10,"volume_limit":5,"reserved_ip_limit":3}}`)
+	})
+	count := func(key string, total int) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			if r.URL.Query().Get("per_page") != "1" {
+				t.Errorf("expected the count to request a single item, got %q", r.URL.RawQuery)
+			}
+			fmt.Fprintf(w, `{%q:[],"meta":{"total":%d}}`, key, total)
+		}
+	}
+	mux.HandleFunc("/v2/droplets", count("droplets", droplets))
+	mux.HandleFunc("/v2/volumes", count("volumes", volumes))
+	mux.HandleFunc("/v2/reserved_ips", count("reserved_ips", reservedIPs))
+
+	return c
+}
+
+func TestLimits_Get(t *testing.T) {
+	c := setupLimits(t, 4, 5, 7)
+
+	limits, _, err := c.Limits.Get(context.Background())
+	if err != nil {
+		t.Fatalf("Limits.Get returned error: %v", err)
+	}
+	want := Limits{
+		Droplets:    Limit{Limit: 10, Used: 4},
+		Volumes:     Limit{Limit: 5, Used: 5},
+		ReservedIPs: Limit{Limit: 3, Used: 7},
+	}
+	if *limits != want {
+		t.Errorf("expected %+v, got %+v", want, *limits)
+	}
+	if limits.Droplets.Headroom() != 6 || limits.Volumes.Headroom() != 0 || limits.ReservedIPs.Headroom() != 0 {
+		t.Errorf("got headroom %d, %d and %d", limits.Droplets.Headroom(), limits.Volumes.Headroom(), limits.ReservedIPs.Headroom())
+	}
+}
+
+func TestLimits_CheckHeadroom(t *testing.T) {
+	c := setupLimits(t, 4, 5, 1)
+	ctx := context.Background()
+
+	if err := c.Limits.CheckHeadroom(ctx, LimitDroplets, 6); err != nil {
+		t.Errorf("expected room for 6 droplets, got %v", err)
+	}
+	if err := c.Limits.CheckHeadroom(ctx, LimitReservedIPs, 2); err != nil {
+		t.Errorf("expected room for 2 reserved IPs, got %v", err)
+	}
+
+	var lerr *LimitExceededError
+	if err := c.Limits.CheckHeadroom(ctx, LimitDroplets, 7); !errors.As(err, &lerr) {
+		t.Fatalf("expected *LimitExceededError, got %v", err)
+	}
+	if lerr.Resource != LimitDroplets || lerr.Requested != 7 || lerr.Limit != (Limit{Limit: 10, Used: 4}) {
+		t.Errorf("got error %+v", lerr)
+	}
+	if err := c.Limits.CheckHeadroom(ctx, LimitVolumes, 1); !errors.As(err, &lerr) {
+		t.Errorf("expected *LimitExceededError, got %v", err)
+	}
+}
+
+func TestLimits_CheckHeadroom_invalid(t *testing.T) {
+	c := setupLimits(t, 0, 0, 0)
+	ctx := context.Background()
+
+	calls := map[string]func() error{
+		"zero":             func() error { return c.Limits.CheckHeadroom(ctx, LimitDroplets, 0) },
+		"unknown resource": func() error { return c.Limits.CheckHeadroom(ctx, "kubernetes", 1) },
+	}
+	for name, call := range calls {
+		var verr *ValidationError
+		if err := call(); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
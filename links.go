@@ -0,0 +1,125 @@
+package client
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Links manages links that are returned along with a List
+type Links struct {
+	Pages   *Pages       `json:"pages,omitempty"`
+	Actions []LinkAction `json:"actions,omitempty"`
+}
+
+// Pages are pages specified in Links
+type Pages struct {
+	First string `json:"first,omitempty"`
+	Prev  string `json:"prev,omitempty"`
+	Last  string `json:"last,omitempty"`
+	Next  string `json:"next,omitempty"`
+}
+
+// LinkAction is a pointer to an action
+type LinkAction struct {
+	ID   int    `json:"id,omitempty"`
+	Rel  string `json:"rel,omitempty"`
+	HREF string `json:"href,omitempty"`
+}
+
+// linkedActionID returns the ID of the action with the relation rel linked
+// from resp, or zero if there is none.
+func linkedActionID(resp *Response, rel string) int {
+	if resp == nil || resp.Links == nil {
+		return 0
+	}
+	for _, a := range resp.Links.Actions {
+		if a.Rel == rel {
+			return a.ID
+		}
+	}
+	return 0
+}
+
+// CurrentPage is current page of the list
+func (l *Links) CurrentPage() (int, error) {
+	if l == nil {
+		return 1, nil
+	}
+	return l.Pages.current()
+}
+
+// IsLastPage returns true if the current page is the last
+func (l *Links) IsLastPage() bool {
+	if l == nil || l.Pages == nil {
+		return true
+	}
+	return l.Pages.isLast()
+}
+
+// NextPageToken returns the opaque page token of the next page link, or an
+// empty string if there is no next page or the link is page number based.
+func (l *Links) NextPageToken() (string, error) {
+	if l.IsLastPage() {
+		return "", nil
+	}
+	u, err := parseLink(l.Pages.Next)
+	if err != nil {
+		return "", err
+	}
+	return u.Query().Get(pageTokenParam), nil
+}
+
+func (p *Pages) current() (int, error) {
+	switch {
+	case p == nil:
+		return 1, nil
+	case p.Prev == "" && p.Next != "":
+		return 1, nil
+	case p.Prev != "":
+		prevPage, err := pageForURL(p.Prev)
+		if err != nil {
+			return 0, err
+		}
+		return prevPage + 1, nil
+	case p.Last != "":
+		// neither previous nor next page, so this is the only page
+		return pageForURL(p.Last)
+	}
+
+	return 1, nil
+}
+
+func (p *Pages) isLast() bool {
+	return p.Next == ""
+}
+
+// pageForURL returns the page number of a page link.
+func pageForURL(urlText string) (int, error) {
+	u, err := parseLink(urlText)
+	if err != nil {
+		return 0, err
+	}
+
+	pageStr := u.Query().Get(pageParam)
+	if pageStr == "" {
+		return 0, fmt.Errorf("page link %q has no %s parameter", urlText, pageParam)
+	}
+	page, err := strconv.Atoi(pageStr)
+	if err != nil {
+		return 0, fmt.Errorf("page link %q has invalid %s parameter: %w", urlText, pageParam, err)
+	}
+	if page < 1 {
+		return 0, fmt.Errorf("page link %q has invalid %s parameter %d", urlText, pageParam, page)
+	}
+	return page, nil
+}
+
+// parseLink parses a page link returned by the API.
+func parseLink(urlText string) (*url.URL, error) {
+	u, err := url.Parse(urlText)
+	if err != nil {
+		return nil, fmt.Errorf("parsing page link %q: %w", urlText, err)
+	}
+	return u, nil
+}
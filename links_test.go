@@ -0,0 +1,106 @@
+package client
+
+import (
+	"testing"
+)
+
+func TestLinks_CurrentPage(t *testing.T) {
+	tests := []struct {
+		name    string
+		links   *Links
+		want    int
+		wantErr bool
+	}{
+		{name: "nil links", links: nil, want: 1},
+		{name: "no pages", links: &Links{}, want: 1},
+		{name: "first page", links: &Links{Pages: &Pages{
+			Next: "https://api.example.com/v2/items?page=2",
+			Last: "https://api.example.com/v2/items?page=3",
+		}}, want: 1},
+		{name: "middle page", links: &Links{Pages: &Pages{
+			Prev: "https://api.example.com/v2/items?page=1",
+			Next: "https://api.example.com/v2/items?page=3",
+		}}, want: 2},
+		{name: "last page", links: &Links{Pages: &Pages{
+			First: "https://api.example.com/v2/items?page=1",
+			Prev:  "https://api.example.com/v2/items?page=2",
+		}}, want: 3},
+		{name: "only page", links: &Links{Pages: &Pages{
+			Last: "https://api.example.com/v2/items?page=1",
+		}}, want: 1},
+		{name: "invalid page", links: &Links{Pages: &Pages{
+			Prev: "https://api.example.com/v2/items?page=two",
+		}}, wantErr: true},
+		{name: "missing page", links: &Links{Pages: &Pages{
+			Prev: "https://api.example.com/v2/items",
+		}}, wantErr: true},
+		{name: "page below one", links: &Links{Pages: &Pages{
+			Prev: "https://api.example.com/v2/items?page=0",
+		}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.links.CurrentPage()
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected an error, got page %d", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CurrentPage returned error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected page %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLinks_IsLastPage(t *testing.T) {
+	tests := []struct {
+		name  string
+		links *Links
+		want  bool
+	}{
+		{name: "nil links", links: nil, want: true},
+		{name: "no pages", links: &Links{}, want: true},
+		{name: "next page", links: &Links{Pages: &Pages{Next: "https://api.example.com/v2/items?page=2"}}, want: false},
+		{name: "no next page", links: &Links{Pages: &Pages{Prev: "https://api.example.com/v2/items?page=1"}}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.links.IsLastPage(); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLinks_NextPageToken(t *testing.T) {
+	tests := []struct {
+		name    string
+		links   *Links
+		want    string
+		wantErr bool
+	}{
+		{name: "last page", links: &Links{Pages: &Pages{}}, want: ""},
+		{name: "page number", links: &Links{Pages: &Pages{Next: "https://api.example.com/v2/items?page=2"}}, want: ""},
+		{name: "page token", links: &Links{Pages: &Pages{Next: "https://api.example.com/v2/items?page_token=abc"}}, want: "abc"},
+		{name: "invalid link", links: &Links{Pages: &Pages{Next: "://bad"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.links.NextPageToken()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("expected token %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
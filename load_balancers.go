@@ -0,0 +1,234 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const loadBalancersBasePath = "v2/load_balancers"
+
+// Load balancer statuses
+const (
+	// LoadBalancerNew is the status of a load balancer which is being created.
+	LoadBalancerNew = "new"
+	// LoadBalancerActive is the status of a load balancer serving traffic.
+	LoadBalancerActive = "active"
+	// LoadBalancerErrored is the status of a load balancer which failed to be
+	// created.
+	LoadBalancerErrored = "errored"
+)
+
+// LoadBalancer represents a DigitalOcean load balancer configuration.
+type LoadBalancer struct {
+	ID              string           `json:"id,omitempty"`
+	Name            string           `json:"name,omitempty"`
+	IP              string           `json:"ip,omitempty"`
+	IPv6            string           `json:"ipv6,omitempty"`
+	SizeSlug        string           `json:"size,omitempty"`
+	SizeUnit        uint32           `json:"size_unit,omitempty"`
+	Status          string           `json:"status,omitempty"`
+	Created         string           `json:"created_at,omitempty"`
+	ForwardingRules []ForwardingRule `json:"forwarding_rules,omitempty"`
+	HealthCheck     *HealthCheck     `json:"health_check,omitempty"`
+	Region          *Region          `json:"region,omitempty"`
+	DropletIDs      []int            `json:"droplet_ids,omitempty"`
+	Tag             string           `json:"tag,omitempty"`
+	VPCUUID         string           `json:"vpc_uuid,omitempty"`
+	ProjectID       string           `json:"project_id,omitempty"`
+}
+
+// ForwardingRule represents load balancer forwarding rules.
+type ForwardingRule struct {
+	EntryProtocol  string `json:"entry_protocol,omitempty"`
+	EntryPort      int    `json:"entry_port,omitempty"`
+	TargetProtocol string `json:"target_protocol,omitempty"`
+	TargetPort     int    `json:"target_port,omitempty"`
+	CertificateID  string `json:"certificate_id,omitempty"`
+	TlsPassthrough bool   `json:"tls_passthrough,omitempty"`
+}
+
+// HealthCheck represents optional load balancer health check rules.
+type HealthCheck struct {
+	Protocol               string `json:"protocol,omitempty"`
+	Port                   int    `json:"port,omitempty"`
+	Path                   string `json:"path,omitempty"`
+	CheckIntervalSeconds   int    `json:"check_interval_seconds,omitempty"`
+	ResponseTimeoutSeconds int    `json:"response_timeout_seconds,omitempty"`
+	HealthyThreshold       int    `json:"healthy_threshold,omitempty"`
+	UnhealthyThreshold     int    `json:"unhealthy_threshold,omitempty"`
+}
+
+// LoadBalancerRequest represents the configuration to be applied to an
+// existing or a new load balancer. Droplets are added either by DropletIDs or
+// by Tag.
+type LoadBalancerRequest struct {
+	Name            string           `json:"name,omitempty"`
+	Region          string           `json:"region,omitempty"`
+	SizeSlug        string           `json:"size,omitempty"`
+	SizeUnit        uint32           `json:"size_unit,omitempty"`
+	ForwardingRules []ForwardingRule `json:"forwarding_rules,omitempty"`
+	HealthCheck     *HealthCheck     `json:"health_check,omitempty"`
+	DropletIDs      []int            `json:"droplet_ids,omitempty"`
+	Tag             string           `json:"tag,omitempty"`
+	VPCUUID         string           `json:"vpc_uuid,omitempty"`
+	ProjectID       string           `json:"project_id,omitempty"`
+}
+
+// LoadBalancerProvisioningError occurs when a load balancer which was waited
+// for fails to be created.
+type LoadBalancerProvisioningError struct {
+	LoadBalancer *LoadBalancer
+}
+
+func (e *LoadBalancerProvisioningError) Error() string {
+	return fmt.Sprintf("creating load balancer %s failed", e.LoadBalancer.ID)
+}
+
+/* SERVICE */
+
+// LoadBalancersService is an interface for managing load balancers with the
+// DigitalOcean API.
+// See: https://docs.digitalocean.com/reference/api/api-reference/#tag/Load-Balancers
+type LoadBalancersService interface {
+	List(context.Context, *ListOptions) ([]LoadBalancer, *Response, error)
+	ListAll(context.Context, *ListAllOptions) ([]LoadBalancer, *Response, error)
+	Get(context.Context, string) (*LoadBalancer, *Response, error)
+	Create(context.Context, *LoadBalancerRequest) (*LoadBalancer, *Response, error)
+	CreateAndWait(context.Context, *LoadBalancerRequest, WaitOptions) (*LoadBalancer, *Response, error)
+	Delete(context.Context, string) (*Response, error)
+}
+
+// LoadBalancersServiceOp handles communication with load balancer methods of
+// the DigitalOcean API.
+type LoadBalancersServiceOp struct {
+	client *Client
+}
+
+var _ LoadBalancersService = &LoadBalancersServiceOp{}
+
+// List load balancers.
+func (s *LoadBalancersServiceOp) List(ctx context.Context, opt *ListOptions) ([]LoadBalancer, *Response, error) {
+	path, err := addOptions(loadBalancersBasePath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lbs, resp, err := DoEnvelope[[]LoadBalancer](ctx, s.client, req, "load_balancers")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *lbs, resp, err
+}
+
+// ListAll load balancers across all pages
+func (s *LoadBalancersServiceOp) ListAll(ctx context.Context, opt *ListAllOptions) ([]LoadBalancer, *Response, error) {
+	return ListAll[LoadBalancer](ctx, s.List, opt)
+}
+
+// Get an existing load balancer by its identifier.
+func (s *LoadBalancersServiceOp) Get(ctx context.Context, lbID string) (*LoadBalancer, *Response, error) {
+	path, err := loadBalancerPath(lbID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doLoadBalancer(ctx, req)
+}
+
+// Create a new load balancer with a given configuration. Its IP is allocated
+// once it is active, use CreateAndWait to wait for it.
+func (s *LoadBalancersServiceOp) Create(ctx context.Context, lbr *LoadBalancerRequest) (*LoadBalancer, *Response, error) {
+	if lbr == nil {
+		return nil, nil, &ValidationError{Field: "createRequest", Reason: "cannot be nil"}
+	}
+	if lbr.Name == "" {
+		return nil, nil, &ValidationError{Field: "name", Reason: "must not be empty"}
+	}
+	if lbr.Region == "" {
+		return nil, nil, &ValidationError{Field: "region", Reason: "must not be empty"}
+	}
+	if len(lbr.ForwardingRules) == 0 {
+		return nil, nil, &ValidationError{Field: "forwarding_rules", Reason: "must not be empty"}
+	}
+	if len(lbr.DropletIDs) > 0 && lbr.Tag != "" {
+		return nil, nil, &ValidationError{Field: "tag", Value: lbr.Tag, Reason: "must be empty when droplet_ids are set"}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, loadBalancersBasePath, lbr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doLoadBalancer(ctx, req)
+}
+
+// CreateAndWait creates a load balancer and waits until it is active,
+// returning it with its allocated IP. It polls as configured by opts. A load
+// balancer which errored is reported as a *LoadBalancerProvisioningError, and
+// exceeding opts.Timeout as a *WaitTimeoutError with the last status seen.
+func (s *LoadBalancersServiceOp) CreateAndWait(ctx context.Context, lbr *LoadBalancerRequest, opts WaitOptions) (*LoadBalancer, *Response, error) {
+	lb, resp, err := s.Create(ctx, lbr)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	lbID := lb.ID
+	lb, err = pollFrom(ctx, opts, lb, func(ctx context.Context) (*LoadBalancer, error) {
+		latest, latestResp, err := s.Get(ctx, lbID)
+		resp = latestResp
+		return latest, err
+	}, func(lb *LoadBalancer) (bool, string, error) {
+		switch lb.Status {
+		case LoadBalancerActive:
+			return true, lb.Status, nil
+		case LoadBalancerErrored:
+			return false, lb.Status, &LoadBalancerProvisioningError{LoadBalancer: lb}
+		}
+		return false, lb.Status, nil
+	})
+	return lb, resp, err
+}
+
+// Delete a load balancer by its identifier.
+func (s *LoadBalancersServiceOp) Delete(ctx context.Context, lbID string) (*Response, error) {
+	path, err := loadBalancerPath(lbID)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *LoadBalancersServiceOp) doLoadBalancer(ctx context.Context, req *http.Request) (*LoadBalancer, *Response, error) {
+	lb, resp, err := DoEnvelope[LoadBalancer](ctx, s.client, req, "load_balancer")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return lb, resp, err
+}
+
+// loadBalancerPath returns the path of a load balancer.
+func loadBalancerPath(lbID string) (string, error) {
+	if lbID == "" {
+		return "", &ValidationError{Field: "lbID", Reason: "must not be empty"}
+	}
+	return fmt.Sprintf("%s/%s", loadBalancersBasePath, lbID), nil
+}
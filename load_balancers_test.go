@@ -0,0 +1,170 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestLoadBalancers_ListGetDelete(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+
+	mux.HandleFunc("/v2/load_balancers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"load_balancers":[{"id":"4de7ac8b","name":"web"}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"load_balancers":[{"id":"b8f8a3b4","name":"api"}],"links":{"pages":{"next":"https://api.example.com/v2/load_balancers?page=2"}}}`)
+	})
+	var methods []string
+	mux.HandleFunc("/v2/load_balancers/4de7ac8b", func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		fmt.Fprint(w, `{"load_balancer":{"id":"4de7ac8b","name":"web","ip":"104.131.186.241","status":"active","forwarding_rules":[{"entry_protocol":"http","entry_port":80,"target_protocol":"http","target_port":80}]}}`)
+	})
+
+	lbs, _, err := c.LoadBalancers.ListAll(ctx, nil)
+	if err != nil {
+		t.Fatalf("LoadBalancers.ListAll returned error: %v", err)
+	}
+	if len(lbs) != 2 || lbs[1].ID != "4de7ac8b" {
+		t.Errorf("got load balancers %+v", lbs)
+	}
+
+	lb, _, err := c.LoadBalancers.Get(ctx, "4de7ac8b")
+	if err != nil {
+		t.Fatalf("LoadBalancers.Get returned error: %v", err)
+	}
+	if lb.IP != "104.131.186.241" || len(lb.ForwardingRules) != 1 || lb.ForwardingRules[0].EntryPort != 80 {
+		t.Errorf("got load balancer %+v", lb)
+	}
+
+	if _, err := c.LoadBalancers.Delete(ctx, "4de7ac8b"); err != nil {
+		t.Fatalf("LoadBalancers.Delete returned error: %v", err)
+	}
+	if fmt.Sprint(methods) != "[GET DELETE]" {
+		t.Errorf("got methods %v", methods)
+	}
+}
+
+func TestLoadBalancers_CreateAndWait(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/load_balancers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var req LoadBalancerRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if req.Name != "web" || req.Tag != "web" || len(req.ForwardingRules) != 1 {
+			t.Errorf("got request %+v", req)
+		}
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"load_balancer":{"id":"4de7ac8b","name":"web","status":"new"}}`)
+	})
+	polls := 0
+	mux.HandleFunc("/v2/load_balancers/4de7ac8b", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		polls++
+		if polls < 3 {
+			fmt.Fprint(w, `{"load_balancer":{"id":"4de7ac8b","name":"web","status":"new"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"load_balancer":{"id":"4de7ac8b","name":"web","ip":"104.131.186.241","status":"active"}}`)
+	})
+
+	lb, _, err := c.LoadBalancers.CreateAndWait(context.Background(), &LoadBalancerRequest{
+		Name:            "web",
+		Region:          "nyc3",
+		ForwardingRules: []ForwardingRule{{EntryProtocol: "http", EntryPort: 80, TargetProtocol: "http", TargetPort: 80}},
+		Tag:             "web",
+	}, WaitOptions{PollInterval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("LoadBalancers.CreateAndWait returned error: %v", err)
+	}
+	if lb.Status != LoadBalancerActive || lb.IP != "104.131.186.241" || polls != 3 {
+		t.Errorf("got load balancer %+v after %d polls", lb, polls)
+	}
+}
+
+func TestLoadBalancers_CreateAndWait_failed(t *testing.T) {
+	c, mux := setup(t)
+
+	status := LoadBalancerErrored
+	mux.HandleFunc("/v2/load_balancers", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"load_balancer":{"id":"4de7ac8b","status":"new"}}`)
+	})
+	mux.HandleFunc("/v2/load_balancers/4de7ac8b", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"load_balancer":{"id":"4de7ac8b","status":%q}}`, status)
+	})
+
+	lbr := &LoadBalancerRequest{
+		Name:            "web",
+		Region:          "nyc3",
+		ForwardingRules: []ForwardingRule{{EntryProtocol: "http", EntryPort: 80, TargetProtocol: "http", TargetPort: 80}},
+	}
+
+	var perr *LoadBalancerProvisioningError
+	if _, _, err := c.LoadBalancers.CreateAndWait(context.Background(), lbr, WaitOptions{PollInterval: time.Millisecond}); !errors.As(err, &perr) {
+		t.Fatalf("expected *LoadBalancerProvisioningError, got %v", err)
+	}
+	if perr.LoadBalancer.ID != "4de7ac8b" {
+		t.Errorf("got load balancer %+v", perr.LoadBalancer)
+	}
+
+	status = LoadBalancerNew
+	var terr *WaitTimeoutError
+	_, _, err := c.LoadBalancers.CreateAndWait(context.Background(), lbr, WaitOptions{PollInterval: time.Millisecond, Timeout: 20 * time.Millisecond})
+	if !errors.As(err, &terr) {
+		t.Fatalf("expected *WaitTimeoutError, got %v", err)
+	}
+	if terr.LastStatus != LoadBalancerNew {
+		t.Errorf("got last status %q", terr.LastStatus)
+	}
+}
+
+func TestLoadBalancers_validation(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+
+	create := func(change func(*LoadBalancerRequest)) func() error {
+		return func() error {
+			lbr := &LoadBalancerRequest{
+				Name:            "web",
+				Region:          "nyc3",
+				ForwardingRules: []ForwardingRule{{EntryProtocol: "http", EntryPort: 80, TargetProtocol: "http", TargetPort: 80}},
+			}
+			change(lbr)
+			_, _, err := c.LoadBalancers.Create(ctx, lbr)
+			return err
+		}
+	}
+	calls := map[string]func() error{
+		"get empty id":    func() error { _, _, err := c.LoadBalancers.Get(ctx, ""); return err },
+		"delete empty id": func() error { _, err := c.LoadBalancers.Delete(ctx, ""); return err },
+		"create nil":      func() error { _, _, err := c.LoadBalancers.Create(ctx, nil); return err },
+		"empty name":      create(func(lbr *LoadBalancerRequest) { lbr.Name = "" }),
+		"empty region":    create(func(lbr *LoadBalancerRequest) { lbr.Region = "" }),
+		"no rules":        create(func(lbr *LoadBalancerRequest) { lbr.ForwardingRules = nil }),
+		"ids and tag": create(func(lbr *LoadBalancerRequest) {
+			lbr.DropletIDs = []int{1}
+			lbr.Tag = "web"
+		}),
+	}
+	for name, call := range calls {
+		var verr *ValidationError
+		if err := call(); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
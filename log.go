@@ -0,0 +1,27 @@
+package client
+
+import (
+	"context"
+	"log/slog"
+)
+
+// SetLogger is a client option for emitting structured logs: request start
+// and finish at debug level, retries and rate limit waits at info level.
+func SetLogger(logger *slog.Logger) ClientOpt {
+	return func(c *Client) error {
+		c.logger = logger
+		return nil
+	}
+}
+
+func (c *Client) logDebug(ctx context.Context, msg string, args ...any) {
+	if c.logger != nil {
+		c.logger.DebugContext(ctx, msg, args...)
+	}
+}
+
+func (c *Client) logInfo(ctx context.Context, msg string, args ...any) {
+	if c.logger != nil {
+		c.logger.InfoContext(ctx, msg, args...)
+	}
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// logEntries decodes the JSON log lines written to buf.
+func logEntries(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var entries []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		entry := make(map[string]any)
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("invalid log line %q: %v", line, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestSetLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	c, mux := setup(t,
+		SetLogger(logger),
+		SetRateLimitStrategy(RateLimitRetryWithBackoff(1, time.Millisecond)))
+
+	var attempts int32
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"id":"too_many_requests","message":"slow down"}`)
+			return
+		}
+		fmt.Fprint(w, `{"account":{}}`)
+	})
+
+	req, err := c.NewRequest(context.Background(), http.MethodGet, "v2/account?access_token=secret", nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	if _, err := c.Do(context.Background(), req, nil); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+
+	var got []string
+	for _, e := range logEntries(t, &buf) {
+		got = append(got, fmt.Sprintf("%v %v %v", e["level"], e["msg"], e["status"]))
+		if url, _ := e["url"].(string); strings.Contains(url, "secret") || !strings.Contains(url, redacted) {
+			t.Errorf("expected the token redacted, got url %q", url)
+		}
+	}
+	want := []string{
+		"DEBUG request started <nil>",
+		"DEBUG request finished 429",
+		"INFO retrying rate limited request <nil>",
+		"DEBUG request started <nil>",
+		"DEBUG request finished 200",
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("expected log entries %q, got %q", want, got)
+	}
+}
+
+func TestSetLogger_level(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+	c, mux := setup(t, SetLogger(logger))
+
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"account":{}}`)
+	})
+
+	if _, _, err := c.Account.Get(context.Background()); err != nil {
+		t.Fatalf("Account.Get returned error: %v", err)
+	}
+	if entries := logEntries(t, &buf); len(entries) != 0 {
+		t.Errorf("expected no request logs at info level, got %v", entries)
+	}
+}
@@ -0,0 +1,78 @@
+// Package metrics exports the state of an API client as Prometheus metrics.
+package metrics
+
+import (
+	"time"
+
+	"client"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const namespace = "api_client"
+
+// RateLimitCollector is a prometheus.Collector exporting the rate limit state
+// observed by a client, labeled by endpoint prefix.
+type RateLimitCollector struct {
+	client *client.Client
+
+	limit       *prometheus.Desc
+	remaining   *prometheus.Desc
+	reset       *prometheus.Desc
+	rateLimited *prometheus.Desc
+}
+
+var _ prometheus.Collector = &RateLimitCollector{}
+
+// NewRateLimitCollector returns a collector for the rate limits of c. Register
+// it with a prometheus.Registerer to export the metrics.
+func NewRateLimitCollector(c *client.Client) *RateLimitCollector {
+	labels := []string{"endpoint"}
+	return &RateLimitCollector{
+		client: c,
+		limit: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "rate_limit", "limit"),
+			"Number of requests allowed in the current rate limit window.",
+			labels, nil),
+		remaining: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "rate_limit", "remaining"),
+			"Number of requests remaining in the current rate limit window.",
+			labels, nil),
+		reset: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "rate_limit", "reset_seconds"),
+			"Seconds until the current rate limit window resets.",
+			labels, nil),
+		rateLimited: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "rate_limit", "exceeded_total"),
+			"Number of requests rejected by the rate limit.",
+			labels, nil),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (rc *RateLimitCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- rc.limit
+	ch <- rc.remaining
+	ch <- rc.reset
+	ch <- rc.rateLimited
+}
+
+// Collect implements prometheus.Collector.
+func (rc *RateLimitCollector) Collect(ch chan<- prometheus.Metric) {
+	for endpoint, rate := range rc.client.RatesSnapshot() {
+		ch <- prometheus.MustNewConstMetric(rc.limit, prometheus.GaugeValue, float64(rate.Limit), endpoint)
+		ch <- prometheus.MustNewConstMetric(rc.remaining, prometheus.GaugeValue, float64(rate.Remaining), endpoint)
+
+		var reset float64
+		if !rate.Reset.IsZero() {
+			if d := time.Until(rate.Reset.Time); d > 0 {
+				reset = d.Seconds()
+			}
+		}
+		ch <- prometheus.MustNewConstMetric(rc.reset, prometheus.GaugeValue, reset, endpoint)
+	}
+
+	for endpoint, n := range rc.client.RateLimitedCounts() {
+		ch <- prometheus.MustNewConstMetric(rc.rateLimited, prometheus.CounterValue, float64(n), endpoint)
+	}
+}
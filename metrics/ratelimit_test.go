@@ -0,0 +1,105 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"client"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// setup returns a client sending its requests to a test server, and the mux
+// the test registers its handlers with.
+func setup(t *testing.T, opts ...client.ClientOpt) (*client.Client, *http.ServeMux) {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	c, err := client.New(nil, opts...)
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+	c.BaseURL, _ = url.Parse(server.URL + "/")
+	return c, mux
+}
+
+// gather returns the values of the metrics collected by collector, keyed by
+// metric name and label values, e.g. "api_client_rate_limit_limit{/v2/account}".
+func gather(t *testing.T, collector prometheus.Collector) map[string]float64 {
+	t.Helper()
+
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(collector)
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("Gather returned error: %v", err)
+	}
+
+	values := make(map[string]float64)
+	for _, family := range families {
+		for _, m := range family.GetMetric() {
+			var labels []string
+			for _, l := range m.GetLabel() {
+				labels = append(labels, l.GetValue())
+			}
+			key := fmt.Sprintf("%s%v", family.GetName(), labels)
+			switch {
+			case m.GetGauge() != nil:
+				values[key] = m.GetGauge().GetValue()
+			case m.GetCounter() != nil:
+				values[key] = m.GetCounter().GetValue()
+			case m.GetHistogram() != nil:
+				values[key] = float64(m.GetHistogram().GetSampleCount())
+			}
+		}
+	}
+	return values
+}
+
+func TestRateLimitCollector(t *testing.T) {
+	c, mux := setup(t, client.SetRateLimitStrategy(client.RateLimitStrategy{}))
+
+	reset := time.Now().Add(time.Minute)
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("RateLimit-Limit", "5000")
+		w.Header().Set("RateLimit-Remaining", "4321")
+		w.Header().Set("RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		fmt.Fprint(w, `{"account":{}}`)
+	})
+	mux.HandleFunc("/v2/sizes", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{"id":"too_many_requests","message":"slow down"}`)
+	})
+
+	if _, _, err := c.Account.Get(context.Background()); err != nil {
+		t.Fatalf("Account.Get returned error: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, _, err := c.Sizes.List(context.Background(), nil); err == nil {
+			t.Fatal("expected a rate limit error")
+		}
+	}
+
+	values := gather(t, NewRateLimitCollector(c))
+	if got := values["api_client_rate_limit_limit[/v2/account]"]; got != 5000 {
+		t.Errorf("expected limit 5000, got %v", got)
+	}
+	if got := values["api_client_rate_limit_remaining[/v2/account]"]; got != 4321 {
+		t.Errorf("expected 4321 remaining, got %v", got)
+	}
+	if got := values["api_client_rate_limit_reset_seconds[/v2/account]"]; got <= 0 || got > 60 {
+		t.Errorf("expected the reset within a minute, got %v seconds", got)
+	}
+	if got := values["api_client_rate_limit_exceeded_total[/v2/sizes]"]; got != 2 {
+		t.Errorf("expected 2 rate limited requests, got %v", got)
+	}
+}
@@ -0,0 +1,148 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"client"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// RequestCollector is a prometheus.Collector instrumenting the requests sent
+// by a client. Metrics are labeled by service, the resource collection
+// following the API version in the request path (e.g. "tags"), and by HTTP
+// method. Install it with client.SetMiddleware(collector.Middleware()).
+// Connection metrics are recorded when the client is created with
+// client.SetConnTrace(true).
+type RequestCollector struct {
+	requests    *prometheus.CounterVec
+	latency     *prometheus.HistogramVec
+	inFlight    *prometheus.GaugeVec
+	retries     *prometheus.CounterVec
+	rateLimited *prometheus.CounterVec
+	connPhases  *prometheus.HistogramVec
+	connections *prometheus.CounterVec
+}
+
+var _ prometheus.Collector = &RequestCollector{}
+
+// NewRequestCollector returns a new RequestCollector.
+func NewRequestCollector() *RequestCollector {
+	labels := []string{"service", "method"}
+	return &RequestCollector{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "requests_total",
+			Help:      "Number of requests sent, by response status code.",
+		}, append(labels, "code")),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "request_duration_seconds",
+			Help:      "Time until the response headers of a request were received.",
+			Buckets:   prometheus.DefBuckets,
+		}, labels),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "requests_in_flight",
+			Help:      "Number of requests waiting for a response.",
+		}, labels),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "retries_total",
+			Help:      "Number of requests sent again after a failed attempt.",
+		}, labels),
+		rateLimited: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "rate_limited_total",
+			Help:      "Number of requests rejected by the rate limit.",
+		}, labels),
+		connPhases: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "connection_phase_seconds",
+			Help:      "Time spent in DNS lookup, connect, TLS handshake and waiting for the first response byte.",
+			Buckets:   prometheus.DefBuckets,
+		}, append(labels, "phase")),
+		connections: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "connections_total",
+			Help:      "Number of connections requests were sent on, by whether they were reused.",
+		}, append(labels, "reused")),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (rc *RequestCollector) Describe(ch chan<- *prometheus.Desc) {
+	rc.requests.Describe(ch)
+	rc.latency.Describe(ch)
+	rc.inFlight.Describe(ch)
+	rc.retries.Describe(ch)
+	rc.rateLimited.Describe(ch)
+	rc.connPhases.Describe(ch)
+	rc.connections.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (rc *RequestCollector) Collect(ch chan<- prometheus.Metric) {
+	rc.requests.Collect(ch)
+	rc.latency.Collect(ch)
+	rc.inFlight.Collect(ch)
+	rc.retries.Collect(ch)
+	rc.rateLimited.Collect(ch)
+	rc.connPhases.Collect(ch)
+	rc.connections.Collect(ch)
+}
+
+// Middleware returns the client middleware recording the metrics.
+func (rc *RequestCollector) Middleware() client.Middleware {
+	return func(next client.Doer) client.Doer {
+		return client.DoerFunc(func(req *http.Request) (*http.Response, error) {
+			labels := prometheus.Labels{"service": client.RequestService(req), "method": req.Method}
+
+			if client.RequestAttempt(req) > 0 {
+				rc.retries.With(labels).Inc()
+			}
+
+			inFlight := rc.inFlight.With(labels)
+			inFlight.Inc()
+			defer inFlight.Dec()
+
+			start := time.Now()
+			resp, err := next.Do(req)
+			rc.latency.With(labels).Observe(time.Since(start).Seconds())
+
+			code := "error"
+			if err == nil {
+				code = strconv.Itoa(resp.StatusCode)
+				if client.IsRateLimited(resp) {
+					rc.rateLimited.With(labels).Inc()
+				}
+			}
+			rc.requests.MustCurryWith(labels).WithLabelValues(code).Inc()
+			if trace, ok := client.RequestConnTrace(req); ok && err == nil {
+				rc.observeConnTrace(labels, trace)
+			}
+
+			return resp, err
+		})
+	}
+}
+
+// observeConnTrace records the connection timings of a request.
+func (rc *RequestCollector) observeConnTrace(labels prometheus.Labels, trace client.ConnTrace) {
+	rc.connections.MustCurryWith(labels).WithLabelValues(strconv.FormatBool(trace.Reused)).Inc()
+
+	phases := rc.connPhases.MustCurryWith(labels)
+	if !trace.Reused {
+		// no lookup or handshake happens for IP addresses and plain HTTP
+		if trace.DNS > 0 {
+			phases.WithLabelValues("dns").Observe(trace.DNS.Seconds())
+		}
+		phases.WithLabelValues("connect").Observe(trace.Connect.Seconds())
+		if trace.TLSHandshake > 0 {
+			phases.WithLabelValues("tls").Observe(trace.TLSHandshake.Seconds())
+		}
+	}
+	phases.WithLabelValues("first_byte").Observe(trace.TimeToFirstByte.Seconds())
+}
@@ -0,0 +1,131 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"client"
+)
+
+func TestRequestCollector(t *testing.T) {
+	collector := NewRequestCollector()
+	c, mux := setup(t,
+		client.SetMiddleware(collector.Middleware()),
+		client.SetRateLimitStrategy(client.RateLimitRetryWithBackoff(1, time.Millisecond)))
+
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"account":{}}`)
+	})
+	var attempts int32
+	mux.HandleFunc("/v2/sizes", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"id":"too_many_requests","message":"slow down"}`)
+			return
+		}
+		fmt.Fprint(w, `{"sizes":[]}`)
+	})
+	mux.HandleFunc("/v2/droplets/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"id":"not_found","message":"not found"}`)
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := c.Account.Get(context.Background()); err != nil {
+			t.Fatalf("Account.Get returned error: %v", err)
+		}
+	}
+	if _, _, err := c.Sizes.List(context.Background(), nil); err != nil {
+		t.Fatalf("expected the rate limited request retried, got %v", err)
+	}
+	if _, _, err := c.Droplets.Get(context.Background(), 1); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	values := gather(t, collector)
+	for key, want := range map[string]float64{
+		"api_client_requests_total[200 GET account]":       2,
+		"api_client_requests_total[429 GET sizes]":         1,
+		"api_client_requests_total[200 GET sizes]":         1,
+		"api_client_requests_total[404 GET droplets]":      1,
+		"api_client_request_duration_seconds[GET account]": 2,
+		"api_client_requests_in_flight[GET account]":       0,
+		"api_client_retries_total[GET sizes]":              1,
+		"api_client_rate_limited_total[GET sizes]":         1,
+	} {
+		if got, ok := values[key]; !ok || got != want {
+			t.Errorf("expected %s %v, got %v", key, want, got)
+		}
+	}
+	if _, ok := values["api_client_rate_limited_total[GET droplets]"]; ok {
+		t.Error("expected a 404 not counted as rate limited")
+	}
+}
+
+func TestRequestCollector_forbiddenWithoutRemaining(t *testing.T) {
+	collector := NewRequestCollector()
+	c, mux := setup(t,
+		client.SetMiddleware(collector.Middleware()),
+		client.SetRateLimitStrategy(client.RateLimitStrategy{}))
+
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("RateLimit-Remaining", "0")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"id":"forbidden","message":"rate limited"}`)
+	})
+	mux.HandleFunc("/v2/sizes", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"id":"forbidden","message":"no access"}`)
+	})
+
+	if _, _, err := c.Account.Get(context.Background()); err == nil {
+		t.Fatal("expected an error")
+	}
+	if _, _, err := c.Sizes.List(context.Background(), nil); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	values := gather(t, collector)
+	if got := values["api_client_rate_limited_total[GET account]"]; got != 1 {
+		t.Errorf("expected the 403 without requests remaining counted, got %v", got)
+	}
+	if _, ok := values["api_client_rate_limited_total[GET sizes]"]; ok {
+		t.Error("expected a plain 403 not counted as rate limited")
+	}
+}
+
+func TestRequestCollector_connTrace(t *testing.T) {
+	collector := NewRequestCollector()
+	c, mux := setup(t, client.SetMiddleware(collector.Middleware()), client.SetConnTrace(true))
+
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"account":{}}`)
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := c.Account.Get(context.Background()); err != nil {
+			t.Fatalf("Account.Get returned error: %v", err)
+		}
+	}
+
+	values := gather(t, collector)
+	for key, want := range map[string]float64{
+		"api_client_connections_total[GET false account]":             1,
+		"api_client_connections_total[GET true account]":              1,
+		"api_client_connection_phase_seconds[GET connect account]":    1,
+		"api_client_connection_phase_seconds[GET first_byte account]": 2,
+	} {
+		if got, ok := values[key]; !ok || got != want {
+			t.Errorf("expected %s %v, got %v", key, want, got)
+		}
+	}
+	for _, phase := range []string{"dns", "tls"} {
+		if _, ok := values["api_client_connection_phase_seconds[GET "+phase+" account]"]; ok {
+			t.Errorf("expected no %s phase observed for a plain HTTP server on an IP address", phase)
+		}
+	}
+}
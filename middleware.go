@@ -0,0 +1,40 @@
+package client
+
+import "net/http"
+
+// Doer sends an HTTP request and returns its response. *http.Client
+// implements it.
+type Doer interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
+// DoerFunc is an adapter to allow the use of ordinary functions as Doer.
+type DoerFunc func(*http.Request) (*http.Response, error)
+
+// Do calls f(req).
+func (f DoerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps the Doer sending requests to the API, so cross-cutting
+// concerns such as authentication, metrics or fault injection can be layered
+// around every request without forking the client.
+type Middleware func(next Doer) Doer
+
+// SetMiddleware is a client option appending middleware to the chain run
+// around every request. The first middleware is the outermost one.
+func SetMiddleware(middleware ...Middleware) ClientOpt {
+	return func(c *Client) error {
+		c.middleware = append(c.middleware, middleware...)
+		return nil
+	}
+}
+
+// doer returns the HTTP client wrapped in the middleware chain.
+func (c *Client) doer() Doer {
+	var d Doer = c.client
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		d = c.middleware[i](d)
+	}
+	return d
+}
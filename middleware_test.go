@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestSetMiddleware(t *testing.T) {
+	var order []string
+	trace := func(name string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(func(req *http.Request) (*http.Response, error) {
+				order = append(order, name+" before")
+				req.Header.Add("X-Middleware", name)
+				resp, err := next.Do(req)
+				order = append(order, name+" after")
+				return resp, err
+			})
+		}
+	}
+	c, mux := setup(t, SetMiddleware(trace("outer"), trace("inner")))
+
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Values("X-Middleware"); fmt.Sprint(got) != "[outer inner]" {
+			t.Errorf("expected headers set by outer then inner, got %v", got)
+		}
+		fmt.Fprint(w, `{"account":{}}`)
+	})
+
+	if _, _, err := c.Account.Get(context.Background()); err != nil {
+		t.Fatalf("Account.Get returned error: %v", err)
+	}
+	want := "[outer before inner before inner after outer after]"
+	if fmt.Sprint(order) != want {
+		t.Errorf("expected %s, got %v", want, order)
+	}
+}
+
+func TestSetMiddleware_shortCircuit(t *testing.T) {
+	c, mux := setup(t, SetMiddleware(func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+				Body:       io.NopCloser(strings.NewReader(`{"account":{"email":"fake@example.com"}}`)),
+				Request:    req,
+			}, nil
+		})
+	}))
+
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("expected the request answered by the middleware")
+	})
+
+	account, _, err := c.Account.Get(context.Background())
+	if err != nil {
+		t.Fatalf("Account.Get returned error: %v", err)
+	}
+	if account.Email != "fake@example.com" {
+		t.Errorf("expected the response of the middleware, got %+v", account)
+	}
+}
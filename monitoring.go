@@ -0,0 +1,479 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	monitoringBasePath  = "v2/monitoring"
+	alertPolicyBasePath = monitoringBasePath + "/alerts"
+
+	dropletMetricsBasePath = monitoringBasePath + "/metrics/droplet"
+	appMetricsBasePath     = monitoringBasePath + "/metrics/apps"
+)
+
+// Metric types of alert policies.
+const (
+	DropletCPUUtilizationPercent      = "v1/insights/droplet/cpu"
+	DropletMemoryUtilizationPercent   = "v1/insights/droplet/memory_utilization_percent"
+	DropletDiskUtilizationPercent     = "v1/insights/droplet/disk_utilization_percent"
+	DropletPublicOutboundBandwidth    = "v1/insights/droplet/public_outbound_bandwidth"
+	DropletPublicInboundBandwidth     = "v1/insights/droplet/public_inbound_bandwidth"
+	DropletPrivateOutboundBandwidth   = "v1/insights/droplet/private_outbound_bandwidth"
+	DropletPrivateInboundBandwidth    = "v1/insights/droplet/private_inbound_bandwidth"
+	DropletDiskReadRate               = "v1/insights/droplet/disk_read"
+	DropletDiskWriteRate              = "v1/insights/droplet/disk_write"
+	DropletOneMinuteLoadAverage       = "v1/insights/droplet/load_1"
+	DropletFiveMinuteLoadAverage      = "v1/insights/droplet/load_5"
+	DropletFifteenMinuteLoadAverage   = "v1/insights/droplet/load_15"
+	DbaasFifteenMinuteLoadAverage     = "v1/dbaas/alerts/load_15_alerts"
+	DbaasMemoryUtilizationPercent     = "v1/dbaas/alerts/memory_utilization_alerts"
+	DbaasDiskUtilizationPercent       = "v1/dbaas/alerts/disk_utilization_alerts"
+	DbaasCPUUtilizationPercent        = "v1/dbaas/alerts/cpu_alerts"
+	LoadBalancerCPUUtilizationPercent = "v1/insights/lbaas/avg_cpu_utilization_percent"
+)
+
+// AlertPolicyComp is the comparison of an alert policy's metric with its
+// threshold.
+type AlertPolicyComp string
+
+// Comparisons of alert policies.
+const (
+	GreaterThan AlertPolicyComp = "GreaterThan"
+	LessThan    AlertPolicyComp = "LessThan"
+)
+
+// alertPolicyWindows are the windows a metric can be averaged over.
+var alertPolicyWindows = []string{"5m", "10m", "30m", "1h"}
+
+// AlertPolicy represents a DigitalOcean alert policy. An alert fires when
+// the metric of Type, averaged over Window, compares to Value as given by
+// Compare on any of the Entities or of the resources tagged with Tags.
+type AlertPolicy struct {
+	UUID        string          `json:"uuid"`
+	Type        string          `json:"type"`
+	Description string          `json:"description"`
+	Compare     AlertPolicyComp `json:"compare"`
+	Value       float32         `json:"value"`
+	Window      string          `json:"window"`
+	Entities    []string        `json:"entities"`
+	Tags        []string        `json:"tags"`
+	Alerts      Alerts          `json:"alerts"`
+	Enabled     bool            `json:"enabled"`
+}
+
+// Alerts represents the channels an alert policy notifies.
+type Alerts struct {
+	Slack []SlackDetails `json:"slack"`
+	Email []string       `json:"email"`
+}
+
+// SlackDetails represents the details required to send a slack alert.
+type SlackDetails struct {
+	URL     string `json:"url"`
+	Channel string `json:"channel"`
+}
+
+// AlertPolicyCreateRequest holds the info for creating a new alert policy.
+type AlertPolicyCreateRequest struct {
+	Type        string          `json:"type"`
+	Description string          `json:"description"`
+	Compare     AlertPolicyComp `json:"compare"`
+	Value       float32         `json:"value"`
+	Window      string          `json:"window"`
+	Entities    []string        `json:"entities"`
+	Tags        []string        `json:"tags"`
+	Alerts      Alerts          `json:"alerts"`
+	Enabled     *bool           `json:"enabled"`
+}
+
+// AlertPolicyUpdateRequest holds the info for updating an existing alert
+// policy. The policy is replaced as a whole.
+type AlertPolicyUpdateRequest struct {
+	Type        string          `json:"type"`
+	Description string          `json:"description"`
+	Compare     AlertPolicyComp `json:"compare"`
+	Value       float32         `json:"value"`
+	Window      string          `json:"window"`
+	Entities    []string        `json:"entities"`
+	Tags        []string        `json:"tags"`
+	Alerts      Alerts          `json:"alerts"`
+	Enabled     *bool           `json:"enabled"`
+}
+
+// DropletMetricsRequest holds the information needed to retrieve a Droplet
+// metric over the time range from Start to End.
+type DropletMetricsRequest struct {
+	HostID string    `url:"host_id"`
+	Start  time.Time `url:"start,unix"`
+	End    time.Time `url:"end,unix"`
+}
+
+// DropletBandwidthMetricsRequest holds the information needed to retrieve
+// Droplet bandwidth metrics. Interface is "public" or "private", Direction
+// "inbound" or "outbound".
+type DropletBandwidthMetricsRequest struct {
+	DropletMetricsRequest
+	Interface string `url:"interface"`
+	Direction string `url:"direction"`
+}
+
+// AppMetricsRequest holds the information needed to retrieve an App metric
+// over the time range from Start to End. The metric covers all components of
+// the app unless Component is given.
+type AppMetricsRequest struct {
+	AppID     string    `url:"app_id"`
+	Component string    `url:"app_component,omitempty"`
+	Start     time.Time `url:"start,unix"`
+	End       time.Time `url:"end,unix"`
+}
+
+// MetricsResponse holds a metrics query result.
+type MetricsResponse struct {
+	Status string      `json:"status"`
+	Data   MetricsData `json:"data"`
+}
+
+// MetricsData holds the series of a metrics query result. ResultType is
+// "matrix" for range queries.
+type MetricsData struct {
+	ResultType string          `json:"resultType"`
+	Result     []MetricsSeries `json:"result"`
+}
+
+// MetricsSeries is the timeseries of one combination of metric labels, e.g.
+// of one CPU mode.
+type MetricsSeries struct {
+	Metric map[string]string `json:"metric"`
+	Values []MetricsSample   `json:"values"`
+}
+
+// MetricsSample is the value of a metric at a point in time.
+type MetricsSample struct {
+	Time  time.Time
+	Value float64
+}
+
+// UnmarshalJSON decodes a sample given as a [unix time, "value"] pair.
+func (s *MetricsSample) UnmarshalJSON(b []byte) error {
+	var pair [2]json.RawMessage
+	if err := json.Unmarshal(b, &pair); err != nil {
+		return err
+	}
+
+	var seconds float64
+	if err := json.Unmarshal(pair[0], &seconds); err != nil {
+		return fmt.Errorf("decoding sample time: %w", err)
+	}
+	var value string
+	if err := json.Unmarshal(pair[1], &value); err != nil {
+		return fmt.Errorf("decoding sample value: %w", err)
+	}
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return fmt.Errorf("decoding sample value: %w", err)
+	}
+
+	whole, frac := math.Modf(seconds)
+	s.Time = time.Unix(int64(whole), int64(frac*1e9)).UTC()
+	s.Value = v
+	return nil
+}
+
+/* SERVICE */
+
+// MonitoringService is an interface for interfacing with the
+// monitoring endpoints of the DigitalOcean API
+// See: https://docs.digitalocean.com/reference/api/api-reference/#tag/Monitoring
+type MonitoringService interface {
+	ListAlertPolicies(context.Context, *ListOptions) ([]AlertPolicy, *Response, error)
+	GetAlertPolicy(context.Context, string) (*AlertPolicy, *Response, error)
+	CreateAlertPolicy(context.Context, *AlertPolicyCreateRequest) (*AlertPolicy, *Response, error)
+	UpdateAlertPolicy(context.Context, string, *AlertPolicyUpdateRequest) (*AlertPolicy, *Response, error)
+	DeleteAlertPolicy(context.Context, string) (*Response, error)
+
+	GetDropletBandwidth(context.Context, *DropletBandwidthMetricsRequest) (*MetricsResponse, *Response, error)
+	GetDropletCPU(context.Context, *DropletMetricsRequest) (*MetricsResponse, *Response, error)
+	GetDropletTotalMemory(context.Context, *DropletMetricsRequest) (*MetricsResponse, *Response, error)
+	GetDropletFreeMemory(context.Context, *DropletMetricsRequest) (*MetricsResponse, *Response, error)
+	GetDropletAvailableMemory(context.Context, *DropletMetricsRequest) (*MetricsResponse, *Response, error)
+	GetDropletCachedMemory(context.Context, *DropletMetricsRequest) (*MetricsResponse, *Response, error)
+	GetDropletFilesystemFree(context.Context, *DropletMetricsRequest) (*MetricsResponse, *Response, error)
+	GetDropletFilesystemSize(context.Context, *DropletMetricsRequest) (*MetricsResponse, *Response, error)
+	GetDropletLoad1(context.Context, *DropletMetricsRequest) (*MetricsResponse, *Response, error)
+	GetDropletLoad5(context.Context, *DropletMetricsRequest) (*MetricsResponse, *Response, error)
+	GetDropletLoad15(context.Context, *DropletMetricsRequest) (*MetricsResponse, *Response, error)
+
+	GetAppCPUPercentage(context.Context, *AppMetricsRequest) (*MetricsResponse, *Response, error)
+	GetAppMemoryPercentage(context.Context, *AppMetricsRequest) (*MetricsResponse, *Response, error)
+	GetAppRestartCount(context.Context, *AppMetricsRequest) (*MetricsResponse, *Response, error)
+}
+
+// MonitoringServiceOp handles communication with monitoring related methods of the
+// DigitalOcean API.
+type MonitoringServiceOp struct {
+	client *Client
+}
+
+var _ MonitoringService = &MonitoringServiceOp{}
+
+// ListAlertPolicies all alert policies
+func (s *MonitoringServiceOp) ListAlertPolicies(ctx context.Context, opt *ListOptions) ([]AlertPolicy, *Response, error) {
+	path, err := addOptions(alertPolicyBasePath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policies, resp, err := DoEnvelope[[]AlertPolicy](ctx, s.client, req, "policies")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *policies, resp, err
+}
+
+// GetAlertPolicy gets a single alert policy
+func (s *MonitoringServiceOp) GetAlertPolicy(ctx context.Context, uuid string) (*AlertPolicy, *Response, error) {
+	path, err := alertPolicyPath(uuid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doAlertPolicy(ctx, req)
+}
+
+// CreateAlertPolicy creates a new alert policy
+func (s *MonitoringServiceOp) CreateAlertPolicy(ctx context.Context, createRequest *AlertPolicyCreateRequest) (*AlertPolicy, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, &ValidationError{Field: "createRequest", Reason: "cannot be nil"}
+	}
+	if err := validateAlertPolicy(createRequest.Type, createRequest.Compare, createRequest.Window); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, alertPolicyBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doAlertPolicy(ctx, req)
+}
+
+// UpdateAlertPolicy updates an existing alert policy
+func (s *MonitoringServiceOp) UpdateAlertPolicy(ctx context.Context, uuid string, updateRequest *AlertPolicyUpdateRequest) (*AlertPolicy, *Response, error) {
+	path, err := alertPolicyPath(uuid)
+	if err != nil {
+		return nil, nil, err
+	}
+	if updateRequest == nil {
+		return nil, nil, &ValidationError{Field: "updateRequest", Reason: "cannot be nil"}
+	}
+	if err := validateAlertPolicy(updateRequest.Type, updateRequest.Compare, updateRequest.Window); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, updateRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doAlertPolicy(ctx, req)
+}
+
+// DeleteAlertPolicy deletes an existing alert policy
+func (s *MonitoringServiceOp) DeleteAlertPolicy(ctx context.Context, uuid string) (*Response, error) {
+	path, err := alertPolicyPath(uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// GetDropletBandwidth retrieves Droplet bandwidth metrics.
+func (s *MonitoringServiceOp) GetDropletBandwidth(ctx context.Context, args *DropletBandwidthMetricsRequest) (*MetricsResponse, *Response, error) {
+	if args == nil {
+		return nil, nil, &ValidationError{Field: "args", Reason: "cannot be nil"}
+	}
+	if args.Interface != "public" && args.Interface != "private" {
+		return nil, nil, &ValidationError{Field: "interface", Value: args.Interface, Reason: "must be public or private"}
+	}
+	if args.Direction != "inbound" && args.Direction != "outbound" {
+		return nil, nil, &ValidationError{Field: "direction", Value: args.Direction, Reason: "must be inbound or outbound"}
+	}
+	if err := validateMetricsRange(args.Start, args.End); err != nil {
+		return nil, nil, err
+	}
+
+	return s.getMetrics(ctx, dropletMetricsBasePath+"/bandwidth", args)
+}
+
+// GetDropletCPU retrieves Droplet CPU metrics.
+func (s *MonitoringServiceOp) GetDropletCPU(ctx context.Context, args *DropletMetricsRequest) (*MetricsResponse, *Response, error) {
+	return s.getDropletMetrics(ctx, "cpu", args)
+}
+
+// GetDropletTotalMemory retrieves Droplet total memory metrics.
+func (s *MonitoringServiceOp) GetDropletTotalMemory(ctx context.Context, args *DropletMetricsRequest) (*MetricsResponse, *Response, error) {
+	return s.getDropletMetrics(ctx, "memory_total", args)
+}
+
+// GetDropletFreeMemory retrieves Droplet free memory metrics.
+func (s *MonitoringServiceOp) GetDropletFreeMemory(ctx context.Context, args *DropletMetricsRequest) (*MetricsResponse, *Response, error) {
+	return s.getDropletMetrics(ctx, "memory_free", args)
+}
+
+// GetDropletAvailableMemory retrieves Droplet available memory metrics.
+func (s *MonitoringServiceOp) GetDropletAvailableMemory(ctx context.Context, args *DropletMetricsRequest) (*MetricsResponse, *Response, error) {
+	return s.getDropletMetrics(ctx, "memory_available", args)
+}
+
+// GetDropletCachedMemory retrieves Droplet cached memory metrics.
+func (s *MonitoringServiceOp) GetDropletCachedMemory(ctx context.Context, args *DropletMetricsRequest) (*MetricsResponse, *Response, error) {
+	return s.getDropletMetrics(ctx, "memory_cached", args)
+}
+
+// GetDropletFilesystemFree retrieves Droplet filesystem free metrics.
+func (s *MonitoringServiceOp) GetDropletFilesystemFree(ctx context.Context, args *DropletMetricsRequest) (*MetricsResponse, *Response, error) {
+	return s.getDropletMetrics(ctx, "filesystem_free", args)
+}
+
+// GetDropletFilesystemSize retrieves Droplet filesystem size metrics.
+func (s *MonitoringServiceOp) GetDropletFilesystemSize(ctx context.Context, args *DropletMetricsRequest) (*MetricsResponse, *Response, error) {
+	return s.getDropletMetrics(ctx, "filesystem_size", args)
+}
+
+// GetDropletLoad1 retrieves Droplet load 1 metrics.
+func (s *MonitoringServiceOp) GetDropletLoad1(ctx context.Context, args *DropletMetricsRequest) (*MetricsResponse, *Response, error) {
+	return s.getDropletMetrics(ctx, "load_1", args)
+}
+
+// GetDropletLoad5 retrieves Droplet load 5 metrics.
+func (s *MonitoringServiceOp) GetDropletLoad5(ctx context.Context, args *DropletMetricsRequest) (*MetricsResponse, *Response, error) {
+	return s.getDropletMetrics(ctx, "load_5", args)
+}
+
+// GetDropletLoad15 retrieves Droplet load 15 metrics.
+func (s *MonitoringServiceOp) GetDropletLoad15(ctx context.Context, args *DropletMetricsRequest) (*MetricsResponse, *Response, error) {
+	return s.getDropletMetrics(ctx, "load_15", args)
+}
+
+// GetAppCPUPercentage retrieves App CPU percentage metrics.
+func (s *MonitoringServiceOp) GetAppCPUPercentage(ctx context.Context, args *AppMetricsRequest) (*MetricsResponse, *Response, error) {
+	return s.getAppMetrics(ctx, "cpu_percentage", args)
+}
+
+// GetAppMemoryPercentage retrieves App memory percentage metrics.
+func (s *MonitoringServiceOp) GetAppMemoryPercentage(ctx context.Context, args *AppMetricsRequest) (*MetricsResponse, *Response, error) {
+	return s.getAppMetrics(ctx, "memory_percentage", args)
+}
+
+// GetAppRestartCount retrieves App restart count metrics.
+func (s *MonitoringServiceOp) GetAppRestartCount(ctx context.Context, args *AppMetricsRequest) (*MetricsResponse, *Response, error) {
+	return s.getAppMetrics(ctx, "restart_count", args)
+}
+
+func (s *MonitoringServiceOp) getDropletMetrics(ctx context.Context, metric string, args *DropletMetricsRequest) (*MetricsResponse, *Response, error) {
+	if args == nil {
+		return nil, nil, &ValidationError{Field: "args", Reason: "cannot be nil"}
+	}
+	if args.HostID == "" {
+		return nil, nil, &ValidationError{Field: "host_id", Reason: "must not be empty"}
+	}
+	if err := validateMetricsRange(args.Start, args.End); err != nil {
+		return nil, nil, err
+	}
+
+	return s.getMetrics(ctx, dropletMetricsBasePath+"/"+metric, args)
+}
+
+func (s *MonitoringServiceOp) getAppMetrics(ctx context.Context, metric string, args *AppMetricsRequest) (*MetricsResponse, *Response, error) {
+	if args == nil {
+		return nil, nil, &ValidationError{Field: "args", Reason: "cannot be nil"}
+	}
+	if args.AppID == "" {
+		return nil, nil, &ValidationError{Field: "app_id", Reason: "must not be empty"}
+	}
+	if err := validateMetricsRange(args.Start, args.End); err != nil {
+		return nil, nil, err
+	}
+
+	return s.getMetrics(ctx, appMetricsBasePath+"/"+metric, args)
+}
+
+func (s *MonitoringServiceOp) getMetrics(ctx context.Context, path string, args interface{}) (*MetricsResponse, *Response, error) {
+	path, err := addOptions(path, args)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return Do[MetricsResponse](ctx, s.client, req)
+}
+
+func (s *MonitoringServiceOp) doAlertPolicy(ctx context.Context, req *http.Request) (*AlertPolicy, *Response, error) {
+	policy, resp, err := DoEnvelope[AlertPolicy](ctx, s.client, req, "policy")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return policy, resp, err
+}
+
+// alertPolicyPath returns the path of an alert policy.
+func alertPolicyPath(uuid string) (string, error) {
+	if uuid == "" {
+		return "", &ValidationError{Field: "uuid", Reason: "must not be empty"}
+	}
+	return fmt.Sprintf("%s/%s", alertPolicyBasePath, uuid), nil
+}
+
+// validateAlertPolicy checks the fields required by every alert policy.
+func validateAlertPolicy(typ string, compare AlertPolicyComp, window string) error {
+	if typ == "" {
+		return &ValidationError{Field: "type", Reason: "must not be empty"}
+	}
+	if compare != GreaterThan && compare != LessThan {
+		return &ValidationError{Field: "compare", Value: string(compare), Reason: fmt.Sprintf("must be %s or %s", GreaterThan, LessThan)}
+	}
+	if !containsString(alertPolicyWindows, window) {
+		return &ValidationError{Field: "window", Value: window, Reason: fmt.Sprintf("must be one of %v", alertPolicyWindows)}
+	}
+	return nil
+}
+
+// validateMetricsRange checks the time range of a metrics query.
+func validateMetricsRange(start, end time.Time) error {
+	if start.IsZero() || end.IsZero() {
+		return &ValidationError{Field: "start", Reason: "start and end must be set"}
+	}
+	if !end.After(start) {
+		return &ValidationError{Field: "end", Value: end.Format(time.RFC3339), Reason: "must be after start"}
+	}
+	return nil
+}
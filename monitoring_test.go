@@ -0,0 +1,274 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestMonitoring_ListAlertPolicies(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/monitoring/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"policies":[{"uuid":"669adfc9","type":"v1/insights/droplet/cpu","compare":"GreaterThan","value":80,"window":"5m","entities":["192018292"],"alerts":{"email":["bob@example.com"],"slack":[{"channel":"#alerts","url":"https://hooks.slack.com/services/T1"}]},"enabled":true}]}`)
+	})
+
+	policies, _, err := c.Monitoring.ListAlertPolicies(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Monitoring.ListAlertPolicies returned error: %v", err)
+	}
+	if len(policies) != 1 {
+		t.Fatalf("expected 1 policy, got %d", len(policies))
+	}
+	p := policies[0]
+	if p.Type != DropletCPUUtilizationPercent || p.Compare != GreaterThan || p.Value != 80 || !p.Enabled {
+		t.Errorf("got policy %+v", p)
+	}
+	if len(p.Alerts.Email) != 1 || len(p.Alerts.Slack) != 1 || p.Alerts.Slack[0].Channel != "#alerts" {
+		t.Errorf("got alerts %+v", p.Alerts)
+	}
+}
+
+func TestMonitoring_alertPolicies(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+	enabled := false
+
+	mux.HandleFunc("/v2/monitoring/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var req map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if req["type"] != DropletMemoryUtilizationPercent || req["window"] != "10m" || req["enabled"] != false {
+			t.Errorf("got request %v", req)
+		}
+		fmt.Fprint(w, `{"policy":{"uuid":"669adfc9","type":"v1/insights/droplet/memory_utilization_percent"}}`)
+	})
+
+	var methods []string
+	mux.HandleFunc("/v2/monitoring/alerts/669adfc9", func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		switch r.Method {
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodPut:
+			var req AlertPolicyUpdateRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("decoding request body: %v", err)
+			}
+			if req.Compare != LessThan || req.Value != 10 || fmt.Sprint(req.Tags) != "[web]" {
+				t.Errorf("got request %+v", req)
+			}
+			fmt.Fprint(w, `{"policy":{"uuid":"669adfc9","compare":"LessThan","value":10}}`)
+		default:
+			fmt.Fprint(w, `{"policy":{"uuid":"669adfc9","compare":"LessThan","value":10}}`)
+		}
+	})
+
+	policy, _, err := c.Monitoring.CreateAlertPolicy(ctx, &AlertPolicyCreateRequest{
+		Type:    DropletMemoryUtilizationPercent,
+		Compare: GreaterThan,
+		Value:   90,
+		Window:  "10m",
+		Enabled: &enabled,
+	})
+	if err != nil {
+		t.Fatalf("Monitoring.CreateAlertPolicy returned error: %v", err)
+	}
+	if policy.UUID != "669adfc9" {
+		t.Errorf("got policy %+v", policy)
+	}
+
+	policy, _, err = c.Monitoring.UpdateAlertPolicy(ctx, "669adfc9", &AlertPolicyUpdateRequest{
+		Type:    DropletMemoryUtilizationPercent,
+		Compare: LessThan,
+		Value:   10,
+		Window:  "1h",
+		Tags:    []string{"web"},
+	})
+	if err != nil {
+		t.Fatalf("Monitoring.UpdateAlertPolicy returned error: %v", err)
+	}
+	if policy.Compare != LessThan {
+		t.Errorf("got policy %+v", policy)
+	}
+	if _, _, err := c.Monitoring.GetAlertPolicy(ctx, "669adfc9"); err != nil {
+		t.Fatalf("Monitoring.GetAlertPolicy returned error: %v", err)
+	}
+	if _, err := c.Monitoring.DeleteAlertPolicy(ctx, "669adfc9"); err != nil {
+		t.Fatalf("Monitoring.DeleteAlertPolicy returned error: %v", err)
+	}
+
+	if fmt.Sprint(methods) != "[PUT GET DELETE]" {
+		t.Errorf("got methods %v", methods)
+	}
+}
+
+func TestMonitoring_alertPolicies_validation(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+	s := c.Monitoring
+	valid := AlertPolicyCreateRequest{Type: DropletCPUUtilizationPercent, Compare: GreaterThan, Window: "5m"}
+
+	create := func(change func(*AlertPolicyCreateRequest)) func() error {
+		return func() error {
+			req := valid
+			change(&req)
+			_, _, err := s.CreateAlertPolicy(ctx, &req)
+			return err
+		}
+	}
+
+	calls := map[string]func() error{
+		"get empty uuid":     func() error { _, _, err := s.GetAlertPolicy(ctx, ""); return err },
+		"delete empty uuid":  func() error { _, err := s.DeleteAlertPolicy(ctx, ""); return err },
+		"create nil":         func() error { _, _, err := s.CreateAlertPolicy(ctx, nil); return err },
+		"create no type":     create(func(r *AlertPolicyCreateRequest) { r.Type = "" }),
+		"create bad compare": create(func(r *AlertPolicyCreateRequest) { r.Compare = "Equal" }),
+		"create bad window":  create(func(r *AlertPolicyCreateRequest) { r.Window = "2m" }),
+		"update empty uuid":  func() error { _, _, err := s.UpdateAlertPolicy(ctx, "", &AlertPolicyUpdateRequest{}); return err },
+		"update nil":         func() error { _, _, err := s.UpdateAlertPolicy(ctx, "669adfc9", nil); return err },
+		"update no window": func() error {
+			_, _, err := s.UpdateAlertPolicy(ctx, "669adfc9", &AlertPolicyUpdateRequest{Type: DropletCPUUtilizationPercent, Compare: LessThan})
+			return err
+		},
+	}
+	for name, call := range calls {
+		var verr *ValidationError
+		if err := call(); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
+
+func TestMonitoring_dropletMetrics(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+	start := time.Unix(1700000000, 0)
+	end := start.Add(time.Hour)
+
+	mux.HandleFunc("/v2/monitoring/metrics/droplet/cpu", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.RawQuery != "end=1700003600&host_id=222651441&start=1700000000" {
+			t.Errorf("got query %q", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"status":"success","data":{"resultType":"matrix","result":[{"metric":{"host_id":"222651441","mode":"idle"},"values":[[1700000000.5,"123.45"],[1700000060,"130"]]}]}}`)
+	})
+	mux.HandleFunc("/v2/monitoring/metrics/droplet/bandwidth", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if q := r.URL.Query(); q.Get("interface") != "public" || q.Get("direction") != "inbound" || q.Get("host_id") != "222651441" {
+			t.Errorf("got query %q", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"status":"success","data":{"resultType":"matrix","result":[]}}`)
+	})
+
+	metrics, _, err := c.Monitoring.GetDropletCPU(ctx, &DropletMetricsRequest{HostID: "222651441", Start: start, End: end})
+	if err != nil {
+		t.Fatalf("Monitoring.GetDropletCPU returned error: %v", err)
+	}
+	if metrics.Status != "success" || metrics.Data.ResultType != "matrix" || len(metrics.Data.Result) != 1 {
+		t.Fatalf("got metrics %+v", metrics)
+	}
+	series := metrics.Data.Result[0]
+	if series.Metric["mode"] != "idle" || len(series.Values) != 2 {
+		t.Fatalf("got series %+v", series)
+	}
+	want := MetricsSample{Time: time.Unix(1700000000, 5e8).UTC(), Value: 123.45}
+	if !series.Values[0].Time.Equal(want.Time) || series.Values[0].Value != want.Value {
+		t.Errorf("expected sample %v, got %v", want, series.Values[0])
+	}
+
+	_, _, err = c.Monitoring.GetDropletBandwidth(ctx, &DropletBandwidthMetricsRequest{
+		DropletMetricsRequest: DropletMetricsRequest{HostID: "222651441", Start: start, End: end},
+		Interface:             "public",
+		Direction:             "inbound",
+	})
+	if err != nil {
+		t.Fatalf("Monitoring.GetDropletBandwidth returned error: %v", err)
+	}
+}
+
+func TestMonitoring_appMetrics(t *testing.T) {
+	c, mux := setup(t)
+	start := time.Unix(1700000000, 0)
+
+	mux.HandleFunc("/v2/monitoring/metrics/apps/restart_count", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if q := r.URL.Query(); q.Get("app_id") != "c2a93513" || q.Get("app_component") != "web" {
+			t.Errorf("got query %q", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"status":"success","data":{"resultType":"matrix","result":[{"metric":{"app_component":"web"},"values":[[1700000000,"2"]]}]}}`)
+	})
+
+	metrics, _, err := c.Monitoring.GetAppRestartCount(context.Background(), &AppMetricsRequest{AppID: "c2a93513", Component: "web", Start: start, End: start.Add(time.Hour)})
+	if err != nil {
+		t.Fatalf("Monitoring.GetAppRestartCount returned error: %v", err)
+	}
+	if len(metrics.Data.Result) != 1 || metrics.Data.Result[0].Values[0].Value != 2 {
+		t.Errorf("got metrics %+v", metrics)
+	}
+}
+
+func TestMetricsSample_UnmarshalJSON_invalid(t *testing.T) {
+	for _, sample := range []string{`[1700000000]`, `["1700000000","1"]`, `[1700000000,1]`, `[1700000000,"one"]`} {
+		var s MetricsSample
+		if err := json.Unmarshal([]byte(sample), &s); err == nil {
+			t.Errorf("expected an error decoding %s", sample)
+		}
+	}
+}
+
+func TestMonitoring_metrics_validation(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+	s := c.Monitoring
+	start := time.Unix(1700000000, 0)
+	end := start.Add(time.Hour)
+	droplet := DropletMetricsRequest{HostID: "222651441", Start: start, End: end}
+
+	calls := map[string]func() error{
+		"droplet nil": func() error { _, _, err := s.GetDropletLoad1(ctx, nil); return err },
+		"droplet no host": func() error {
+			_, _, err := s.GetDropletLoad5(ctx, &DropletMetricsRequest{Start: start, End: end})
+			return err
+		},
+		"droplet no range": func() error {
+			_, _, err := s.GetDropletLoad15(ctx, &DropletMetricsRequest{HostID: "222651441"})
+			return err
+		},
+		"droplet reversed": func() error {
+			_, _, err := s.GetDropletFreeMemory(ctx, &DropletMetricsRequest{HostID: "222651441", Start: end, End: start})
+			return err
+		},
+		"bandwidth nil": func() error { _, _, err := s.GetDropletBandwidth(ctx, nil); return err },
+		"bandwidth bad interface": func() error {
+			_, _, err := s.GetDropletBandwidth(ctx, &DropletBandwidthMetricsRequest{DropletMetricsRequest: droplet, Interface: "vpc", Direction: "inbound"})
+			return err
+		},
+		"bandwidth bad direction": func() error {
+			_, _, err := s.GetDropletBandwidth(ctx, &DropletBandwidthMetricsRequest{DropletMetricsRequest: droplet, Interface: "public", Direction: "both"})
+			return err
+		},
+		"app nil": func() error { _, _, err := s.GetAppCPUPercentage(ctx, nil); return err },
+		"app no id": func() error {
+			_, _, err := s.GetAppMemoryPercentage(ctx, &AppMetricsRequest{Start: start, End: end})
+			return err
+		},
+		"app no end": func() error {
+			_, _, err := s.GetAppRestartCount(ctx, &AppMetricsRequest{AppID: "c2a93513", Start: start})
+			return err
+		},
+	}
+	for name, call := range calls {
+		var verr *ValidationError
+		if err := call(); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+const oneClickBasePath = "v2/1-clicks"
+
+// Types of 1-Click applications.
+const (
+	OneClickTypeDroplet    = "droplet"
+	OneClickTypeKubernetes = "kubernetes"
+)
+
+// OneClick is the structure of a 1-Click
+type OneClick struct {
+	Slug string `json:"slug"`
+	Type string `json:"type"`
+}
+
+// InstallKubernetesAppsRequest represents a request required to install 1-click kubernetes apps
+type InstallKubernetesAppsRequest struct {
+	Slugs       []string `json:"addon_slugs"`
+	ClusterUUID string   `json:"cluster_uuid"`
+}
+
+// InstallKubernetesAppsResponse is the response of a kubernetes 1-click install request
+type InstallKubernetesAppsResponse struct {
+	Message string `json:"message"`
+}
+
+type oneClickListOptions struct {
+	Type string `url:"type,omitempty"`
+}
+
+/* SERVICE */
+
+// OneClickService is an interface for interacting with 1-clicks with the
+// DigitalOcean API.
+// See: https://docs.digitalocean.com/reference/api/api-reference/#tag/1-Click-Applications
+type OneClickService interface {
+	List(context.Context, string) ([]*OneClick, *Response, error)
+	InstallKubernetes(context.Context, *InstallKubernetesAppsRequest) (*InstallKubernetesAppsResponse, *Response, error)
+}
+
+// OneClickServiceOp interfaces with 1-click endpoints in the DigitalOcean API.
+type OneClickServiceOp struct {
+	client *Client
+}
+
+var _ OneClickService = &OneClickServiceOp{}
+
+// List returns a list of the available 1-click applications of oneClickType,
+// or of all types when it is empty.
+func (s *OneClickServiceOp) List(ctx context.Context, oneClickType string) ([]*OneClick, *Response, error) {
+	switch oneClickType {
+	case "", OneClickTypeDroplet, OneClickTypeKubernetes:
+	default:
+		return nil, nil, &ValidationError{Field: "type", Value: oneClickType, Reason: "must be droplet or kubernetes"}
+	}
+
+	path, err := addOptions(oneClickBasePath, &oneClickListOptions{Type: oneClickType})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	oneClicks, resp, err := DoEnvelope[[]*OneClick](ctx, s.client, req, "1_clicks")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *oneClicks, resp, err
+}
+
+// InstallKubernetes installs the 1-click applications of the given slugs
+// into a Kubernetes cluster.
+func (s *OneClickServiceOp) InstallKubernetes(ctx context.Context, installRequest *InstallKubernetesAppsRequest) (*InstallKubernetesAppsResponse, *Response, error) {
+	if installRequest == nil {
+		return nil, nil, &ValidationError{Field: "installRequest", Reason: "cannot be nil"}
+	}
+	if installRequest.ClusterUUID == "" {
+		return nil, nil, &ValidationError{Field: "cluster_uuid", Reason: "must not be empty"}
+	}
+	if len(installRequest.Slugs) == 0 {
+		return nil, nil, &ValidationError{Field: "addon_slugs", Reason: "must not be empty"}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, oneClickBasePath+"/kubernetes", installRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return Do[InstallKubernetesAppsResponse](ctx, s.client, req)
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestOneClick_List(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+
+	var queries []string
+	mux.HandleFunc("/v2/1-clicks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		queries = append(queries, r.URL.RawQuery)
+		fmt.Fprint(w, `{"1_clicks":[{"slug":"monitoring","type":"kubernetes"}]}`)
+	})
+
+	oneClicks, _, err := c.OneClick.List(ctx, OneClickTypeKubernetes)
+	if err != nil {
+		t.Fatalf("OneClick.List returned error: %v", err)
+	}
+	if len(oneClicks) != 1 || oneClicks[0].Slug != "monitoring" || oneClicks[0].Type != OneClickTypeKubernetes {
+		t.Errorf("got 1-clicks %+v", oneClicks)
+	}
+	if _, _, err := c.OneClick.List(ctx, ""); err != nil {
+		t.Fatalf("OneClick.List returned error: %v", err)
+	}
+
+	if fmt.Sprint(queries) != "[type=kubernetes ]" {
+		t.Errorf("expected the type only sent when given, got %q", queries)
+	}
+}
+
+func TestOneClick_InstallKubernetes(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/1-clicks/kubernetes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var req map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if req["cluster_uuid"] != "50a994b6" || fmt.Sprint(req["addon_slugs"]) != "[kube-state-metrics loki]" {
+			t.Errorf("got request %v", req)
+		}
+		fmt.Fprint(w, `{"message":"Successfully kicked off addon job."}`)
+	})
+
+	resp, _, err := c.OneClick.InstallKubernetes(context.Background(), &InstallKubernetesAppsRequest{ClusterUUID: "50a994b6", Slugs: []string{"kube-state-metrics", "loki"}})
+	if err != nil {
+		t.Fatalf("OneClick.InstallKubernetes returned error: %v", err)
+	}
+	if resp.Message != "Successfully kicked off addon job." {
+		t.Errorf("got response %+v", resp)
+	}
+}
+
+func TestOneClick_validation(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+	s := c.OneClick
+
+	calls := map[string]func() error{
+		"list unknown type": func() error { _, _, err := s.List(ctx, "app"); return err },
+		"install nil":       func() error { _, _, err := s.InstallKubernetes(ctx, nil); return err },
+		"install no cluster": func() error {
+			_, _, err := s.InstallKubernetes(ctx, &InstallKubernetesAppsRequest{Slugs: []string{"loki"}})
+			return err
+		},
+		"install no slugs": func() error {
+			_, _, err := s.InstallKubernetes(ctx, &InstallKubernetesAppsRequest{ClusterUUID: "50a994b6"})
+			return err
+		},
+	}
+	for name, call := range calls {
+		var verr *ValidationError
+		if err := call(); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,451 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	pageParam      = "page"
+	pageTokenParam = "page_token"
+)
+
+// ListFunc fetches a single page of a list endpoint, e.g. TagsService.List.
+type ListFunc[T any] func(context.Context, *ListOptions) ([]T, *Response, error)
+
+// ErrNoMorePages is returned by Paginator.Next after the last page.
+var ErrNoMorePages = errors.New("no more pages")
+
+// Paginator walks the pages of any list endpoint, following page numbers or
+// page tokens from one response to the next, so that new services get
+// pagination helpers without per-type code:
+//
+//	p := NewPaginator(client.Tags.List, nil)
+//	for p.HasNext() {
+//		tags, _, err := p.Next(ctx)
+//		// ...
+//	}
+type Paginator[T any] struct {
+	list ListFunc[T]
+	opt  ListOptions
+
+	resp *Response
+	last bool
+
+	// cur holds the options the most recently fetched page was requested with.
+	cur ListOptions
+
+	tuning *PageSizeTuning
+}
+
+// NewPaginator returns a Paginator over the pages returned by list, starting
+// at the page given in opt. A nil opt starts at the first page.
+func NewPaginator[T any](list ListFunc[T], opt *ListOptions) *Paginator[T] {
+	p := &Paginator[T]{list: list}
+	if opt != nil {
+		p.opt = *opt
+	}
+	return p
+}
+
+// HasNext reports whether there is another page to fetch.
+func (p *Paginator[T]) HasNext() bool {
+	return !p.last
+}
+
+// Next fetches the next page. It returns ErrNoMorePages once the last page
+// has been fetched. A failed page, including one whose links to the next page
+// can not be parsed, returns no items and can be retried by calling Next
+// again.
+func (p *Paginator[T]) Next(ctx context.Context) ([]T, *Response, error) {
+	if p.last {
+		return nil, p.resp, ErrNoMorePages
+	}
+
+	opt := p.opt
+	start := time.Now()
+	items, resp, err := p.list(ctx, &opt)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	next := p.opt
+	more, err := nextPage(resp, &next)
+	if err != nil {
+		return nil, resp, err
+	}
+	p.resp = resp
+	p.cur = p.opt
+	p.opt = next
+	p.last = !more
+	if more && p.tuning != nil {
+		p.tunePageSize(time.Since(start), len(items), opt.PerPage)
+	}
+	return items, resp, nil
+}
+
+// Pages calls fn with each remaining page until the last page has been
+// processed or fn returns an error.
+func (p *Paginator[T]) Pages(ctx context.Context, fn func([]T, *Response) error) error {
+	for p.HasNext() {
+		items, resp, err := p.Next(ctx)
+		if err != nil {
+			return err
+		}
+		if err := fn(items, resp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// All fetches the remaining pages and returns their aggregated items along
+// with the response of the last page fetched.
+func (p *Paginator[T]) All(ctx context.Context) ([]T, *Response, error) {
+	var all []T
+	err := p.Pages(ctx, func(items []T, _ *Response) error {
+		all = append(all, items...)
+		return nil
+	})
+	return all, p.resp, err
+}
+
+// Response returns the response of the most recently fetched page.
+func (p *Paginator[T]) Response() *Response {
+	return p.resp
+}
+
+const (
+	// defaultPerPage is the page size used by the API when none is given.
+	defaultPerPage = 20
+
+	// maxPerPage is the largest page size accepted by the API.
+	maxPerPage = 200
+)
+
+// PageSizeTuning configures automatic tuning of ListOptions.PerPage between
+// pages. Pages which come back full and faster than half the target latency
+// double the page size, pages slower than the target latency halve it.
+type PageSizeTuning struct {
+	// Min and Max bound the page size. They default to 20 and 200, the
+	// default and largest page sizes of the API.
+	Min int
+	Max int
+
+	// TargetLatency is the latency a page request should take. It defaults
+	// to one second.
+	TargetLatency time.Duration
+}
+
+// TunePageSize enables automatic page size tuning and returns p.
+func (p *Paginator[T]) TunePageSize(tuning PageSizeTuning) *Paginator[T] {
+	if tuning.Min <= 0 {
+		tuning.Min = defaultPerPage
+	}
+	if tuning.Max <= 0 || tuning.Max > maxPerPage {
+		tuning.Max = maxPerPage
+	}
+	if tuning.Max < tuning.Min {
+		tuning.Max = tuning.Min
+	}
+	if tuning.TargetLatency <= 0 {
+		tuning.TargetLatency = time.Second
+	}
+	p.tuning = &tuning
+
+	if p.opt.PerPage < tuning.Min {
+		p.opt.PerPage = tuning.Min
+	} else if p.opt.PerPage > tuning.Max {
+		p.opt.PerPage = tuning.Max
+	}
+	return p
+}
+
+// tunePageSize adapts the page size of the next page to the latency of a page
+// of n items requested with perPage items per page.
+func (p *Paginator[T]) tunePageSize(latency time.Duration, n, perPage int) {
+	size := perPage
+	switch {
+	case latency > p.tuning.TargetLatency:
+		size = perPage / 2
+		if size < p.tuning.Min {
+			size = p.tuning.Min
+		}
+	case latency < p.tuning.TargetLatency/2 && n >= perPage:
+		size = perPage * 2
+		if size > p.tuning.Max {
+			size = p.tuning.Max
+		}
+	}
+	if size == perPage {
+		return
+	}
+
+	if p.opt.PageToken != "" {
+		// cursors are independent of the page size
+		p.opt.PerPage = size
+		return
+	}
+
+	// page numbers depend on the page size, so the size can only change
+	// when the items seen so far end on a page boundary of the new size
+	offset := (p.opt.Page - 1) * perPage
+	if offset%size != 0 {
+		return
+	}
+	p.opt.PerPage = size
+	p.opt.Page = offset/size + 1
+}
+
+// Iterator walks every item of a list endpoint, transparently following
+// Links.Pages.Next across pages:
+//
+//	it := NewIterator(client.Tags.List, nil)
+//	for it.Next(ctx) {
+//		tag := it.Value()
+//		// ...
+//	}
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+type Iterator[T any] struct {
+	pages *Paginator[T]
+
+	items []T
+	index int
+	err   error
+
+	// skip is the number of items of the first page already consumed before
+	// the iterator was resumed from a checkpoint.
+	skip int
+}
+
+// NewIterator returns an Iterator over the items returned by list, starting
+// at the page given in opt. A nil opt starts at the first page.
+func NewIterator[T any](list ListFunc[T], opt *ListOptions) *Iterator[T] {
+	return &Iterator[T]{pages: NewPaginator(list, opt), index: -1}
+}
+
+// Next advances the iterator to the next item, fetching the next page when
+// the current one is used up. It returns false when there are no more items
+// or an error occurred, see Err.
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+
+	for it.index+1 >= len(it.items) {
+		if !it.pages.HasNext() {
+			return false
+		}
+		items, _, err := it.pages.Next(ctx)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.items = items
+		it.index = -1
+		if it.skip > 0 {
+			if it.skip > len(items) {
+				it.skip = len(items)
+			}
+			it.index += it.skip
+			it.skip = 0
+		}
+	}
+
+	it.index++
+	return true
+}
+
+// TunePageSize enables automatic page size tuning and returns it.
+func (it *Iterator[T]) TunePageSize(tuning PageSizeTuning) *Iterator[T] {
+	it.pages.TunePageSize(tuning)
+	return it
+}
+
+// Value returns the current item. It is only valid after Next returned true.
+func (it *Iterator[T]) Value() T {
+	return it.items[it.index]
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Response returns the response of the most recently fetched page.
+func (it *Iterator[T]) Response() *Response {
+	return it.pages.Response()
+}
+
+// checkpoint is the content of the opaque checkpoints of Paginator and
+// Iterator.
+type checkpoint struct {
+	Options ListOptions `json:"options"`
+	Last    bool        `json:"last,omitempty"`
+	Skip    int         `json:"skip,omitempty"`
+}
+
+func (cp checkpoint) encode() (string, error) {
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeCheckpoint(s string) (checkpoint, error) {
+	var cp checkpoint
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return cp, fmt.Errorf("invalid pagination checkpoint: %w", err)
+	}
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return cp, fmt.Errorf("invalid pagination checkpoint: %w", err)
+	}
+	return cp, nil
+}
+
+// Checkpoint returns an opaque token recording the position of p: the page or
+// cursor of the next page along with the options, such as filters, it is
+// requested with. Long running exports can store it and continue later with
+// ResumePaginator instead of starting from the first page.
+func (p *Paginator[T]) Checkpoint() (string, error) {
+	return checkpoint{Options: p.opt, Last: p.last}.encode()
+}
+
+// ResumePaginator returns a Paginator over list continuing from a checkpoint
+// returned by Paginator.Checkpoint.
+func ResumePaginator[T any](list ListFunc[T], cp string) (*Paginator[T], error) {
+	c, err := decodeCheckpoint(cp)
+	if err != nil {
+		return nil, err
+	}
+	p := NewPaginator(list, &c.Options)
+	p.last = c.Last
+	return p, nil
+}
+
+// Checkpoint returns an opaque token recording the position of it, down to
+// the items already consumed from the current page. Resume from it with
+// ResumeIterator.
+func (it *Iterator[T]) Checkpoint() (string, error) {
+	if it.index+1 < len(it.items) {
+		// refetch the current page and skip the items already consumed
+		return checkpoint{Options: it.pages.cur, Skip: it.index + 1}.encode()
+	}
+	return it.pages.Checkpoint()
+}
+
+// ResumeIterator returns an Iterator over list continuing from a checkpoint
+// returned by Iterator.Checkpoint.
+func ResumeIterator[T any](list ListFunc[T], cp string) (*Iterator[T], error) {
+	c, err := decodeCheckpoint(cp)
+	if err != nil {
+		return nil, err
+	}
+	it := NewIterator(list, &c.Options)
+	it.pages.last = c.Last
+	it.skip = c.Skip
+	return it, nil
+}
+
+// nextPage points opt at the page following resp and reports whether there
+// is one. Opaque page tokens, from Meta or the next page link, take
+// precedence over page numbers.
+func nextPage(resp *Response, opt *ListOptions) (bool, error) {
+	if resp != nil && resp.Meta != nil && resp.Meta.NextPageToken != "" {
+		opt.PageToken = resp.Meta.NextPageToken
+		return true, nil
+	}
+	if resp == nil || resp.Links.IsLastPage() {
+		return false, nil
+	}
+
+	token, err := resp.Links.NextPageToken()
+	if err != nil {
+		return false, err
+	}
+	if token != "" {
+		opt.PageToken = token
+		return true, nil
+	}
+	page, err := pageForURL(resp.Links.Pages.Next)
+	if err != nil {
+		return false, err
+	}
+	opt.Page = page
+	return true, nil
+}
+
+// ErrListLimitExceeded is returned by ListAll when a list has more pages or
+// items than the limits set in ListAllOptions allow.
+var ErrListLimitExceeded = errors.New("list exceeds the configured page or item limit")
+
+// ListAllOptions specifies the options of ListAll.
+type ListAllOptions struct {
+	ListOptions
+
+	// MaxPages is the maximum number of pages fetched. Zero means no limit.
+	MaxPages int
+
+	// MaxItems is the maximum number of items returned. Zero means no limit.
+	MaxItems int
+}
+
+// ListAll walks every page returned by list and returns the aggregated items
+// along with the response of the last page fetched. When the list exceeds
+// MaxPages or MaxItems, the items gathered within the limits are returned
+// together with ErrListLimitExceeded.
+func ListAll[T any](ctx context.Context, list ListFunc[T], opt *ListAllOptions) ([]T, *Response, error) {
+	if opt == nil {
+		opt = &ListAllOptions{}
+	}
+	p := NewPaginator(list, &opt.ListOptions)
+
+	var all []T
+	pages := 0
+	err := p.Pages(ctx, func(items []T, _ *Response) error {
+		pages++
+		all = append(all, items...)
+		if opt.MaxItems > 0 && len(all) > opt.MaxItems {
+			all = all[:opt.MaxItems]
+			return ErrListLimitExceeded
+		}
+		if opt.MaxPages > 0 && pages >= opt.MaxPages && p.HasNext() {
+			return ErrListLimitExceeded
+		}
+		return nil
+	})
+	return all, p.Response(), err
+}
+
+// ErrNoTotal is returned by Count when the API response carries no total.
+var ErrNoTotal = errors.New("response has no meta.total")
+
+// Count returns the total number of items of a list endpoint, as reported in
+// Meta.Total, fetching a single page with one item to read it. Filters set in
+// opt are honoured, paging options are ignored.
+func Count[T any](ctx context.Context, list ListFunc[T], opt *ListOptions) (int, *Response, error) {
+	var countOpt ListOptions
+	if opt != nil {
+		countOpt = *opt
+	}
+	countOpt.Page = 0
+	countOpt.PageToken = ""
+	countOpt.PerPage = 1
+
+	_, resp, err := list(ctx, &countOpt)
+	if err != nil {
+		return 0, resp, err
+	}
+	if resp == nil || resp.Meta == nil {
+		return 0, resp, ErrNoTotal
+	}
+	return resp.Meta.Total, resp, nil
+}
@@ -0,0 +1,559 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakePages returns a ListFunc serving pages of two items each and recording
+// the pages requested. The pages in failures are answered by fn as many times
+// as given before they succeed.
+func fakePages(pages int, requested *[]int, failures map[int]int, fn func(page int) ([]int, *Response, error)) ListFunc[int] {
+	return func(ctx context.Context, opt *ListOptions) ([]int, *Response, error) {
+		page := opt.Page
+		if page == 0 {
+			page = 1
+		}
+		*requested = append(*requested, page)
+
+		if failures[page] > 0 {
+			failures[page]--
+			return fn(page)
+		}
+
+		links := &Links{Pages: &Pages{}}
+		if page > 1 {
+			links.Pages.Prev = fmt.Sprintf("https://api.example.com/v2/items?page=%d", page-1)
+		}
+		if page < pages {
+			links.Pages.Next = fmt.Sprintf("https://api.example.com/v2/items?page=%d", page+1)
+			links.Pages.Last = fmt.Sprintf("https://api.example.com/v2/items?page=%d", pages)
+		}
+		return []int{2*page - 1, 2 * page}, &Response{Links: links}, nil
+	}
+}
+
+func TestPaginator_All(t *testing.T) {
+	var requested []int
+	items, _, err := NewPaginator(fakePages(3, &requested, nil, nil), nil).All(context.Background())
+	if err != nil {
+		t.Fatalf("All returned error: %v", err)
+	}
+	if fmt.Sprint(items) != "[1 2 3 4 5 6]" {
+		t.Errorf("got items %v", items)
+	}
+	if fmt.Sprint(requested) != "[1 2 3]" {
+		t.Errorf("got pages %v", requested)
+	}
+}
+
+func TestPaginator_listErrorIsRetried(t *testing.T) {
+	failed := errors.New("unavailable")
+	var requested []int
+	p := NewPaginator(fakePages(3, &requested, map[int]int{2: 1}, func(page int) ([]int, *Response, error) {
+		return nil, nil, failed
+	}), nil)
+
+	var all []int
+	for p.HasNext() {
+		items, _, err := p.Next(context.Background())
+		if err != nil && err != failed {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		all = append(all, items...)
+	}
+
+	if fmt.Sprint(all) != "[1 2 3 4 5 6]" {
+		t.Errorf("expected no duplicate items, got %v", all)
+	}
+	if fmt.Sprint(requested) != "[1 2 2 3]" {
+		t.Errorf("expected page 2 requested again, got %v", requested)
+	}
+}
+
+func TestPaginator_badNextLinkIsRetried(t *testing.T) {
+	var requested []int
+	p := NewPaginator(fakePages(3, &requested, map[int]int{2: 1}, func(page int) ([]int, *Response, error) {
+		links := &Links{Pages: &Pages{
+			Prev: "https://api.example.com/v2/items?page=1",
+			Next: "https://api.example.com/v2/items?page=oops",
+			Last: "https://api.example.com/v2/items?page=3",
+		}}
+		return []int{3, 4}, &Response{Links: links}, nil
+	}), nil)
+
+	var all []int
+	var errs int
+	for p.HasNext() {
+		items, _, err := p.Next(context.Background())
+		if err != nil {
+			errs++
+			if items != nil {
+				t.Errorf("expected no items with the error, got %v", items)
+			}
+			continue
+		}
+		all = append(all, items...)
+	}
+
+	if errs != 1 {
+		t.Errorf("expected 1 error, got %d", errs)
+	}
+	if fmt.Sprint(all) != "[1 2 3 4 5 6]" {
+		t.Errorf("expected no duplicate items, got %v", all)
+	}
+	if fmt.Sprint(requested) != "[1 2 2 3]" {
+		t.Errorf("expected page 2 requested again, got %v", requested)
+	}
+}
+
+func TestPaginator_noMorePages(t *testing.T) {
+	var requested []int
+	p := NewPaginator(fakePages(1, &requested, nil, nil), nil)
+
+	if _, _, err := p.Next(context.Background()); err != nil {
+		t.Fatalf("Next returned error: %v", err)
+	}
+	if p.HasNext() {
+		t.Error("expected no next page")
+	}
+	if _, _, err := p.Next(context.Background()); err != ErrNoMorePages {
+		t.Errorf("expected ErrNoMorePages, got %v", err)
+	}
+}
+
+func TestPaginator_Pages(t *testing.T) {
+	stop := errors.New("stop")
+	var requested []int
+	var seen []int
+	err := NewPaginator(fakePages(3, &requested, nil, nil), nil).Pages(context.Background(), func(items []int, resp *Response) error {
+		seen = append(seen, items...)
+		if len(seen) == 4 {
+			return stop
+		}
+		return nil
+	})
+	if err != stop {
+		t.Errorf("expected the error of fn, got %v", err)
+	}
+	if fmt.Sprint(seen) != "[1 2 3 4]" {
+		t.Errorf("got items %v", seen)
+	}
+	if fmt.Sprint(requested) != "[1 2]" {
+		t.Errorf("expected no page fetched after fn failed, got %v", requested)
+	}
+}
+
+func TestPaginator_startPage(t *testing.T) {
+	var requested []int
+	p := NewPaginator(fakePages(3, &requested, nil, nil), &ListOptions{Page: 2})
+
+	items, resp, err := p.All(context.Background())
+	if err != nil {
+		t.Fatalf("All returned error: %v", err)
+	}
+	if fmt.Sprint(items) != "[3 4 5 6]" {
+		t.Errorf("got items %v", items)
+	}
+	if resp != p.Response() || !resp.Links.IsLastPage() {
+		t.Errorf("expected the response of the last page, got %+v", resp)
+	}
+}
+
+// fakeItems returns a ListFunc serving the items 1 to n, honouring the page
+// and page size requested, and recording the options of every request.
+func fakeItems(n int, requested *[]ListOptions) ListFunc[int] {
+	return func(ctx context.Context, opt *ListOptions) ([]int, *Response, error) {
+		*requested = append(*requested, *opt)
+
+		page, perPage := opt.Page, opt.PerPage
+		if page == 0 {
+			page = 1
+		}
+		var items []int
+		for i := (page-1)*perPage + 1; i <= n && len(items) < perPage; i++ {
+			items = append(items, i)
+		}
+		links := &Links{Pages: &Pages{}}
+		if page*perPage < n {
+			links.Pages.Next = fmt.Sprintf("https://api.example.com/v2/items?page=%d&per_page=%d", page+1, perPage)
+		}
+		return items, &Response{Links: links}, nil
+	}
+}
+
+func TestPaginator_TunePageSize_grow(t *testing.T) {
+	var requested []ListOptions
+	p := NewPaginator(fakeItems(100, &requested), nil).
+		TunePageSize(PageSizeTuning{Min: 10, Max: 40, TargetLatency: time.Hour})
+
+	items, _, err := p.All(context.Background())
+	if err != nil {
+		t.Fatalf("All returned error: %v", err)
+	}
+	if len(items) != 100 || items[0] != 1 || items[99] != 100 {
+		t.Errorf("expected the items 1 to 100 once, got %d items", len(items))
+	}
+
+	var got []string
+	for _, opt := range requested {
+		got = append(got, fmt.Sprintf("%d/%d", opt.Page, opt.PerPage))
+	}
+	// the size doubles whenever the items seen end on a page boundary
+	want := "[0/10 2/10 2/20 2/40 3/40]"
+	if fmt.Sprint(got) != want {
+		t.Errorf("expected pages %s, got %v", want, got)
+	}
+}
+
+func TestPaginator_TunePageSize_shrink(t *testing.T) {
+	var requested []ListOptions
+	p := NewPaginator(fakeItems(100, &requested), &ListOptions{PerPage: 50}).
+		TunePageSize(PageSizeTuning{Min: 25, TargetLatency: time.Nanosecond})
+
+	items, _, err := p.All(context.Background())
+	if err != nil {
+		t.Fatalf("All returned error: %v", err)
+	}
+	if len(items) != 100 {
+		t.Errorf("expected 100 items, got %d", len(items))
+	}
+
+	var got []string
+	for _, opt := range requested {
+		got = append(got, fmt.Sprintf("%d/%d", opt.Page, opt.PerPage))
+	}
+	want := "[0/50 3/25 4/25]"
+	if fmt.Sprint(got) != want {
+		t.Errorf("expected pages %s, got %v", want, got)
+	}
+}
+
+func TestPaginator_TunePageSize_defaults(t *testing.T) {
+	p := NewPaginator(fakeItems(1, new([]ListOptions)), &ListOptions{PerPage: 500}).TunePageSize(PageSizeTuning{})
+
+	if p.tuning.Min != 20 || p.tuning.Max != 200 || p.tuning.TargetLatency != time.Second {
+		t.Errorf("got tuning %+v", *p.tuning)
+	}
+	if p.opt.PerPage != 200 {
+		t.Errorf("expected the page size clamped to 200, got %d", p.opt.PerPage)
+	}
+}
+
+func TestPaginator_Checkpoint(t *testing.T) {
+	var requested []int
+	list := fakePages(3, &requested, nil, nil)
+	p := NewPaginator(list, nil)
+	if _, _, err := p.Next(context.Background()); err != nil {
+		t.Fatalf("Next returned error: %v", err)
+	}
+	cp, err := p.Checkpoint()
+	if err != nil {
+		t.Fatalf("Checkpoint returned error: %v", err)
+	}
+
+	resumed, err := ResumePaginator(list, cp)
+	if err != nil {
+		t.Fatalf("ResumePaginator returned error: %v", err)
+	}
+	items, _, err := resumed.All(context.Background())
+	if err != nil {
+		t.Fatalf("All returned error: %v", err)
+	}
+	if fmt.Sprint(items) != "[3 4 5 6]" {
+		t.Errorf("expected the items after the first page, got %v", items)
+	}
+
+	// a checkpoint taken after the last page stays done
+	cp, err = resumed.Checkpoint()
+	if err != nil {
+		t.Fatalf("Checkpoint returned error: %v", err)
+	}
+	done, err := ResumePaginator(list, cp)
+	if err != nil {
+		t.Fatalf("ResumePaginator returned error: %v", err)
+	}
+	if done.HasNext() {
+		t.Error("expected no next page after resuming a finished paginator")
+	}
+}
+
+func TestIterator_Checkpoint(t *testing.T) {
+	var requested []int
+	list := fakePages(3, &requested, nil, nil)
+	it := NewIterator(list, nil)
+	for i := 0; i < 3; i++ {
+		if !it.Next(context.Background()) {
+			t.Fatalf("Next returned false: %v", it.Err())
+		}
+	}
+	cp, err := it.Checkpoint()
+	if err != nil {
+		t.Fatalf("Checkpoint returned error: %v", err)
+	}
+
+	resumed, err := ResumeIterator(list, cp)
+	if err != nil {
+		t.Fatalf("ResumeIterator returned error: %v", err)
+	}
+	var rest []int
+	for resumed.Next(context.Background()) {
+		rest = append(rest, resumed.Value())
+	}
+	if err := resumed.Err(); err != nil {
+		t.Fatalf("Err returned %v", err)
+	}
+	if fmt.Sprint(rest) != "[4 5 6]" {
+		t.Errorf("expected the items after the third, got %v", rest)
+	}
+}
+
+func TestResumePaginator_invalid(t *testing.T) {
+	list := fakePages(1, new([]int), nil, nil)
+	for _, cp := range []string{"not base64!", "bm90IGpzb24"} {
+		if _, err := ResumePaginator(list, cp); err == nil {
+			t.Errorf("expected an error for checkpoint %q", cp)
+		}
+		if _, err := ResumeIterator(list, cp); err == nil {
+			t.Errorf("expected an error for checkpoint %q", cp)
+		}
+	}
+}
+
+func TestCount(t *testing.T) {
+	var got ListOptions
+	list := func(ctx context.Context, opt *ListOptions) ([]int, *Response, error) {
+		got = *opt
+		return []int{1}, &Response{Meta: &Meta{Total: 42}}, nil
+	}
+
+	n, _, err := Count(context.Background(), list, &ListOptions{Page: 3, PerPage: 50, PageToken: "t", TagName: "prod"})
+	if err != nil {
+		t.Fatalf("Count returned error: %v", err)
+	}
+	if n != 42 {
+		t.Errorf("expected 42, got %d", n)
+	}
+	if got.Page != 0 || got.PageToken != "" || got.PerPage != 1 {
+		t.Errorf("expected a single item of the first page requested, got %+v", got)
+	}
+	if got.TagName != "prod" {
+		t.Errorf("expected the filter kept, got %q", got.TagName)
+	}
+}
+
+func TestCount_noTotal(t *testing.T) {
+	list := func(ctx context.Context, opt *ListOptions) ([]int, *Response, error) {
+		return nil, &Response{}, nil
+	}
+	if _, _, err := Count(context.Background(), list, nil); err != ErrNoTotal {
+		t.Errorf("expected ErrNoTotal, got %v", err)
+	}
+}
+
+func TestListAll_limits(t *testing.T) {
+	var requested []int
+	items, _, err := ListAll(context.Background(), fakePages(5, &requested, nil, nil), &ListAllOptions{MaxPages: 2})
+	if err != ErrListLimitExceeded {
+		t.Errorf("expected ErrListLimitExceeded, got %v", err)
+	}
+	if fmt.Sprint(items) != "[1 2 3 4]" {
+		t.Errorf("expected the items of 2 pages, got %v", items)
+	}
+}
+
+func TestPaginator_pageTokens(t *testing.T) {
+	var tokens []string
+	list := func(ctx context.Context, opt *ListOptions) ([]string, *Response, error) {
+		tokens = append(tokens, opt.PageToken)
+		switch opt.PageToken {
+		case "":
+			return []string{"a"}, &Response{Links: &Links{Pages: &Pages{Next: "https://api.example.com/v2/items?page_token=t1"}}}, nil
+		case "t1":
+			return []string{"b"}, &Response{Meta: &Meta{NextPageToken: "t2"}}, nil
+		default:
+			return []string{"c"}, &Response{}, nil
+		}
+	}
+
+	items, _, err := NewPaginator(list, nil).All(context.Background())
+	if err != nil {
+		t.Fatalf("All returned error: %v", err)
+	}
+	if fmt.Sprint(items) != "[a b c]" {
+		t.Errorf("got items %v", items)
+	}
+	if fmt.Sprint(tokens) != fmt.Sprint([]string{"", "t1", "t2"}) {
+		t.Errorf("got page tokens %q", tokens)
+	}
+}
+
+func TestIterator(t *testing.T) {
+	var requested []int
+	it := NewIterator(fakePages(3, &requested, nil, nil), nil)
+
+	var all []int
+	for it.Next(context.Background()) {
+		all = append(all, it.Value())
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Err returned %v", err)
+	}
+	if fmt.Sprint(all) != "[1 2 3 4 5 6]" {
+		t.Errorf("got items %v", all)
+	}
+	if fmt.Sprint(requested) != "[1 2 3]" {
+		t.Errorf("got pages %v", requested)
+	}
+	if it.Next(context.Background()) {
+		t.Error("expected no more items")
+	}
+}
+
+func TestIterator_error(t *testing.T) {
+	failed := errors.New("unavailable")
+	var requested []int
+	it := NewIterator(fakePages(3, &requested, map[int]int{2: 1}, func(page int) ([]int, *Response, error) {
+		return nil, nil, failed
+	}), nil)
+
+	var all []int
+	for it.Next(context.Background()) {
+		all = append(all, it.Value())
+	}
+	if it.Err() != failed {
+		t.Errorf("expected the list error, got %v", it.Err())
+	}
+	if fmt.Sprint(all) != "[1 2]" {
+		t.Errorf("expected the items before the error, got %v", all)
+	}
+	if it.Next(context.Background()) {
+		t.Error("expected the iterator stopped by the error")
+	}
+}
+
+// listEnvelopeKeys are the envelope keys of the list endpoints with a ListAll.
+var listEnvelopeKeys = []string{
+	"actions", "apps", "billing_history", "certificates", "databases", "domain_records",
+	"domains", "droplets", "images", "invoices", "keys", "kubernetes_clusters",
+	"load_balancers", "partner_attachments", "projects", "regions", "reserved_ips",
+	"reserved_ipv6s", "sizes", "snapshots", "ssh_keys", "checks", "tags", "volumes", "vpcs",
+}
+
+func TestServices_ListAll(t *testing.T) {
+	c, mux := setup(t)
+
+	var paths []string
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path+"?"+r.URL.RawQuery)
+
+		items := `[{},{}]`
+		links := fmt.Sprintf(`{"pages":{"next":"https://api.example.com%s?page=2"}}`, r.URL.Path)
+		if r.URL.Query().Get("page") == "2" {
+			items = `[{}]`
+			links = fmt.Sprintf(`{"pages":{"prev":"https://api.example.com%s?page=1"}}`, r.URL.Path)
+		}
+		fields := make([]string, 0, len(listEnvelopeKeys))
+		for _, key := range listEnvelopeKeys {
+			fields = append(fields, fmt.Sprintf("%q:%s", key, items))
+		}
+		fmt.Fprintf(w, `{%s,"links":%s}`, strings.Join(fields, ","), links)
+	})
+
+	count := func(n int, _ *Response, err error) (int, error) { return n, err }
+	ctx := context.Background()
+	tests := map[string]func() (int, error){
+		"Actions":        func() (int, error) { l, r, err := c.Actions.ListAll(ctx, nil); return count(len(l), r, err) },
+		"Activity":       func() (int, error) { l, r, err := c.Activity.ListAll(ctx, nil); return count(len(l), r, err) },
+		"Apps":           func() (int, error) { l, r, err := c.Apps.ListAll(ctx, nil); return count(len(l), r, err) },
+		"BillingHistory": func() (int, error) { l, r, err := c.BillingHistory.ListAll(ctx, nil); return count(len(l), r, err) },
+		"Certificates":   func() (int, error) { l, r, err := c.Certificates.ListAll(ctx, nil); return count(len(l), r, err) },
+		"Databases":      func() (int, error) { l, r, err := c.Databases.ListAll(ctx, nil); return count(len(l), r, err) },
+		"DomainRecords": func() (int, error) {
+			l, r, err := c.DomainRecords.ListAll(ctx, "example.com", nil)
+			return count(len(l), r, err)
+		},
+		"Domains":            func() (int, error) { l, r, err := c.Domains.ListAll(ctx, nil); return count(len(l), r, err) },
+		"Droplets":           func() (int, error) { l, r, err := c.Droplets.ListAll(ctx, nil); return count(len(l), r, err) },
+		"Images":             func() (int, error) { l, r, err := c.Images.ListAll(ctx, nil); return count(len(l), r, err) },
+		"Invoices":           func() (int, error) { l, r, err := c.Invoices.ListAll(ctx, nil); return count(len(l), r, err) },
+		"Keys":               func() (int, error) { l, r, err := c.Keys.ListAll(ctx, nil); return count(len(l), r, err) },
+		"Kubernetes":         func() (int, error) { l, r, err := c.Kubernetes.ListAll(ctx, nil); return count(len(l), r, err) },
+		"LoadBalancers":      func() (int, error) { l, r, err := c.LoadBalancers.ListAll(ctx, nil); return count(len(l), r, err) },
+		"PartnerAttachments": func() (int, error) { l, r, err := c.PartnerAttachment.ListAll(ctx, nil); return count(len(l), r, err) },
+		"Projects":           func() (int, error) { l, r, err := c.Projects.ListAll(ctx, nil); return count(len(l), r, err) },
+		"Regions":            func() (int, error) { l, r, err := c.Regions.ListAll(ctx, nil); return count(len(l), r, err) },
+		"ReservedIPActions": func() (int, error) {
+			l, r, err := c.ReservedIPActions.ListAll(ctx, "192.0.2.1", nil)
+			return count(len(l), r, err)
+		},
+		"ReservedIPs":   func() (int, error) { l, r, err := c.ReservedIPs.ListAll(ctx, nil); return count(len(l), r, err) },
+		"ReservedIPV6s": func() (int, error) { l, r, err := c.ReservedIPV6s.ListAll(ctx, nil); return count(len(l), r, err) },
+		"Sizes":         func() (int, error) { l, r, err := c.Sizes.ListAll(ctx, nil); return count(len(l), r, err) },
+		"Snapshots":     func() (int, error) { l, r, err := c.Snapshots.ListAll(ctx, nil); return count(len(l), r, err) },
+		"SpacesKeys":    func() (int, error) { l, r, err := c.SpacesKeys.ListAll(ctx, nil); return count(len(l), r, err) },
+		"StorageActions": func() (int, error) {
+			l, r, err := c.StorageActions.ListAll(ctx, "vol-1", nil)
+			return count(len(l), r, err)
+		},
+		"Tags":         func() (int, error) { l, r, err := c.Tags.ListAll(ctx, nil); return count(len(l), r, err) },
+		"UptimeChecks": func() (int, error) { l, r, err := c.UptimeChecks.ListAll(ctx, nil); return count(len(l), r, err) },
+		"Volumes":      func() (int, error) { l, r, err := c.Storage.ListAllVolumes(ctx, nil); return count(len(l), r, err) },
+		"VPCs":         func() (int, error) { l, r, err := c.VPCs.ListAll(ctx, nil); return count(len(l), r, err) },
+	}
+	for name, listAll := range tests {
+		paths = nil
+		n, err := listAll()
+		if err != nil {
+			t.Errorf("%s.ListAll returned error: %v", name, err)
+			continue
+		}
+		if n != 3 {
+			t.Errorf("%s.ListAll: expected 3 items from 2 pages, got %d from %v", name, n, paths)
+		}
+	}
+}
+
+func TestStorage_ListAllVolumes_region(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/volumes", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("region"); got != "nyc3" {
+			t.Errorf("expected volumes listed in nyc3, got %q", got)
+		}
+		fmt.Fprint(w, `{"volumes":[{"id":"vol-1"},{"id":"vol-2"}],"links":{"pages":{"next":"https://api.example.com/v2/volumes?page=2"}}}`)
+	})
+
+	volumes, _, err := c.Storage.ListAllVolumes(context.Background(), &VolumeListAllOptions{
+		ListAllOptions: ListAllOptions{MaxPages: 1},
+		Region:         "nyc3",
+	})
+	if !errors.Is(err, ErrListLimitExceeded) {
+		t.Errorf("expected ErrListLimitExceeded, got %v", err)
+	}
+	if len(volumes) != 2 {
+		t.Errorf("expected the volumes of the first page, got %v", volumes)
+	}
+}
+
+func TestActivity_ListAll_range(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/actions", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"actions":[{"id":1,"started_at":"2024-01-01T00:00:00Z"},{"id":2,"started_at":"2024-03-01T00:00:00Z"}]}`)
+	})
+
+	since := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	actions, _, err := c.Activity.ListAll(context.Background(), &ActivityListAllOptions{Since: since})
+	if err != nil {
+		t.Fatalf("Activity.ListAll returned error: %v", err)
+	}
+	if len(actions) != 1 || actions[0].ID != 2 {
+		t.Errorf("expected the action started after %v, got %v", since, actions)
+	}
+}
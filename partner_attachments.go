@@ -0,0 +1,318 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"time"
+)
+
+const partnerAttachmentsBasePath = "v2/partner_network_connect/attachments"
+
+// PartnerAttachment represents a DigitalOcean partner attachment, a private
+// interconnect between VPCs and a network as a service provider.
+type PartnerAttachment struct {
+	ID                        string    `json:"id,omitempty"`
+	Name                      string    `json:"name,omitempty"`
+	State                     string    `json:"state,omitempty"`
+	ConnectionBandwidthInMbps int       `json:"connection_bandwidth_in_mbps,omitempty"`
+	Region                    string    `json:"region,omitempty"`
+	NaaSProvider              string    `json:"naas_provider,omitempty"`
+	VPCIDs                    []string  `json:"vpc_ids,omitempty"`
+	BGP                       BGP       `json:"bgp,omitempty"`
+	CreatedAt                 time.Time `json:"created_at,omitempty"`
+}
+
+// BGP represents the BGP session of a partner attachment. AuthKey is only
+// sent when creating the attachment, use GetBGPAuthKey to retrieve it.
+type BGP struct {
+	LocalASN      int    `json:"local_asn,omitempty"`
+	LocalRouterIP string `json:"local_router_ip,omitempty"`
+	PeerASN       int    `json:"peer_asn,omitempty"`
+	PeerRouterIP  string `json:"peer_router_ip,omitempty"`
+	AuthKey       string `json:"auth_key,omitempty"`
+}
+
+// PartnerAttachmentCreateRequest represents a request to create a partner
+// attachment.
+type PartnerAttachmentCreateRequest struct {
+	Name                      string   `json:"name,omitempty"`
+	ConnectionBandwidthInMbps int      `json:"connection_bandwidth_in_mbps,omitempty"`
+	Region                    string   `json:"region,omitempty"`
+	NaaSProvider              string   `json:"naas_provider,omitempty"`
+	VPCIDs                    []string `json:"vpc_ids,omitempty"`
+	BGP                       BGP      `json:"bgp,omitempty"`
+}
+
+// PartnerAttachmentUpdateRequest represents a request to update a partner
+// attachment. Empty fields are left unchanged.
+type PartnerAttachmentUpdateRequest struct {
+	Name   string   `json:"name,omitempty"`
+	VPCIDs []string `json:"vpc_ids,omitempty"`
+}
+
+// ServiceKey is the key the network as a service provider uses to connect to
+// a partner attachment.
+type ServiceKey struct {
+	Value     string    `json:"value,omitempty"`
+	State     string    `json:"state,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// BGPAuthKey is the key authenticating the BGP session of a partner
+// attachment.
+type BGPAuthKey struct {
+	Value string `json:"value"`
+}
+
+// RemoteRoute is a network reachable through a partner attachment.
+type RemoteRoute struct {
+	CIDR string `json:"cidr"`
+}
+
+type partnerAttachmentRoutesRequest struct {
+	RemoteRoutes []RemoteRoute `json:"remote_routes"`
+}
+
+/* SERVICE */
+
+// PartnerAttachmentService is an interface for managing partner attachments
+// with the DigitalOcean API.
+// See: https://docs.digitalocean.com/reference/api/api-reference/#tag/Partner-Network-Connect
+type PartnerAttachmentService interface {
+	List(context.Context, *ListOptions) ([]*PartnerAttachment, *Response, error)
+	ListAll(context.Context, *ListAllOptions) ([]*PartnerAttachment, *Response, error)
+	Get(context.Context, string) (*PartnerAttachment, *Response, error)
+	Create(context.Context, *PartnerAttachmentCreateRequest) (*PartnerAttachment, *Response, error)
+	Update(context.Context, string, *PartnerAttachmentUpdateRequest) (*PartnerAttachment, *Response, error)
+	Delete(context.Context, string) (*Response, error)
+	GetServiceKey(context.Context, string) (*ServiceKey, *Response, error)
+	RegenerateServiceKey(context.Context, string) (*Response, error)
+	GetBGPAuthKey(context.Context, string) (*BGPAuthKey, *Response, error)
+	ListRoutes(context.Context, string, *ListOptions) ([]*RemoteRoute, *Response, error)
+	SetRoutes(context.Context, string, []string) (*PartnerAttachment, *Response, error)
+}
+
+// PartnerAttachmentServiceOp handles communication with partner attachment
+// methods of the DigitalOcean API.
+type PartnerAttachmentServiceOp struct {
+	client *Client
+}
+
+var _ PartnerAttachmentService = &PartnerAttachmentServiceOp{}
+
+// List returns a list of all partner attachments.
+func (s *PartnerAttachmentServiceOp) List(ctx context.Context, opt *ListOptions) ([]*PartnerAttachment, *Response, error) {
+	path, err := addOptions(partnerAttachmentsBasePath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	attachments, resp, err := DoEnvelope[[]*PartnerAttachment](ctx, s.client, req, "partner_attachments")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *attachments, resp, err
+}
+
+// ListAll partner attachments across all pages
+func (s *PartnerAttachmentServiceOp) ListAll(ctx context.Context, opt *ListAllOptions) ([]*PartnerAttachment, *Response, error) {
+	return ListAll[*PartnerAttachment](ctx, s.List, opt)
+}
+
+// Get retrieves a partner attachment by its ID.
+func (s *PartnerAttachmentServiceOp) Get(ctx context.Context, id string) (*PartnerAttachment, *Response, error) {
+	path, err := partnerAttachmentPath(id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doAttachment(ctx, req)
+}
+
+// Create creates a partner attachment connecting VPCs to a network as a
+// service provider.
+func (s *PartnerAttachmentServiceOp) Create(ctx context.Context, createRequest *PartnerAttachmentCreateRequest) (*PartnerAttachment, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, &ValidationError{Field: "createRequest", Reason: "cannot be nil"}
+	}
+	if createRequest.Name == "" {
+		return nil, nil, &ValidationError{Field: "name", Reason: "must not be empty"}
+	}
+	if createRequest.Region == "" {
+		return nil, nil, &ValidationError{Field: "region", Reason: "must not be empty"}
+	}
+	if createRequest.NaaSProvider == "" {
+		return nil, nil, &ValidationError{Field: "naas_provider", Reason: "must not be empty"}
+	}
+	if createRequest.ConnectionBandwidthInMbps < 1 {
+		return nil, nil, &ValidationError{Field: "connection_bandwidth_in_mbps", Reason: "must be positive"}
+	}
+	if len(createRequest.VPCIDs) == 0 {
+		return nil, nil, &ValidationError{Field: "vpc_ids", Reason: "must not be empty"}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, partnerAttachmentsBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doAttachment(ctx, req)
+}
+
+// Update updates the name and VPCs of a partner attachment.
+func (s *PartnerAttachmentServiceOp) Update(ctx context.Context, id string, updateRequest *PartnerAttachmentUpdateRequest) (*PartnerAttachment, *Response, error) {
+	path, err := partnerAttachmentPath(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if updateRequest == nil {
+		return nil, nil, &ValidationError{Field: "updateRequest", Reason: "cannot be nil"}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPatch, path, updateRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doAttachment(ctx, req)
+}
+
+// Delete deletes a partner attachment.
+func (s *PartnerAttachmentServiceOp) Delete(ctx context.Context, id string) (*Response, error) {
+	path, err := partnerAttachmentPath(id)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// GetServiceKey retrieves the service key of a partner attachment.
+func (s *PartnerAttachmentServiceOp) GetServiceKey(ctx context.Context, id string) (*ServiceKey, *Response, error) {
+	path, err := partnerAttachmentPath(id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path+"/service_key", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return DoEnvelope[ServiceKey](ctx, s.client, req, "service_key")
+}
+
+// RegenerateServiceKey replaces the service key of a partner attachment. The
+// new key can be retrieved with GetServiceKey once it was created.
+func (s *PartnerAttachmentServiceOp) RegenerateServiceKey(ctx context.Context, id string) (*Response, error) {
+	path, err := partnerAttachmentPath(id)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path+"/service_key", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// GetBGPAuthKey retrieves the BGP authentication key of a partner attachment.
+func (s *PartnerAttachmentServiceOp) GetBGPAuthKey(ctx context.Context, id string) (*BGPAuthKey, *Response, error) {
+	path, err := partnerAttachmentPath(id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path+"/bgp_auth_key", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return DoEnvelope[BGPAuthKey](ctx, s.client, req, "bgp_auth_key")
+}
+
+// ListRoutes lists the remote routes of a partner attachment.
+func (s *PartnerAttachmentServiceOp) ListRoutes(ctx context.Context, id string, opt *ListOptions) ([]*RemoteRoute, *Response, error) {
+	path, err := partnerAttachmentPath(id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	path, err = addOptions(path+"/remote_routes", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	routes, resp, err := DoEnvelope[[]*RemoteRoute](ctx, s.client, req, "remote_routes")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *routes, resp, err
+}
+
+// SetRoutes replaces the remote routes of a partner attachment with the given
+// CIDRs, e.g. "10.10.0.0/16".
+func (s *PartnerAttachmentServiceOp) SetRoutes(ctx context.Context, id string, cidrs []string) (*PartnerAttachment, *Response, error) {
+	path, err := partnerAttachmentPath(id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	routes := make([]RemoteRoute, len(cidrs))
+	for i, cidr := range cidrs {
+		if _, err := netip.ParsePrefix(cidr); err != nil {
+			return nil, nil, &ValidationError{Field: "cidr", Value: cidr, Reason: "is not a CIDR"}
+		}
+		routes[i] = RemoteRoute{CIDR: cidr}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path+"/remote_routes", &partnerAttachmentRoutesRequest{RemoteRoutes: routes})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doAttachment(ctx, req)
+}
+
+func (s *PartnerAttachmentServiceOp) doAttachment(ctx context.Context, req *http.Request) (*PartnerAttachment, *Response, error) {
+	attachment, resp, err := DoEnvelope[PartnerAttachment](ctx, s.client, req, "partner_attachment")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return attachment, resp, err
+}
+
+// partnerAttachmentPath returns the path of a partner attachment.
+func partnerAttachmentPath(id string) (string, error) {
+	if id == "" {
+		return "", &ValidationError{Field: "id", Reason: "must not be empty"}
+	}
+	return fmt.Sprintf("%s/%s", partnerAttachmentsBasePath, id), nil
+}
@@ -0,0 +1,210 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestPartnerAttachments_List(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/partner_network_connect/attachments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"partner_attachments":[{"id":"880b7f98","name":"env.prod-partner-network-connect","state":"active","connection_bandwidth_in_mbps":1000,"region":"nyc","naas_provider":"megaport","vpc_ids":["796c6fe3"],"bgp":{"local_asn":64532,"local_router_ip":"169.254.0.1/29","peer_asn":133937,"peer_router_ip":"169.254.0.6/29"}}]}`)
+	})
+
+	attachments, _, err := c.PartnerAttachment.List(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("PartnerAttachment.List returned error: %v", err)
+	}
+	if len(attachments) != 1 {
+		t.Fatalf("expected 1 attachment, got %d", len(attachments))
+	}
+	a := attachments[0]
+	if a.ConnectionBandwidthInMbps != 1000 || a.NaaSProvider != "megaport" || a.BGP.PeerASN != 133937 || fmt.Sprint(a.VPCIDs) != "[796c6fe3]" {
+		t.Errorf("got attachment %+v", a)
+	}
+}
+
+func TestPartnerAttachments_CreateUpdateGetDelete(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+
+	mux.HandleFunc("/v2/partner_network_connect/attachments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var req PartnerAttachmentCreateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if req.Name != "env.prod" || req.BGP.AuthKey != "secret" || req.ConnectionBandwidthInMbps != 1000 {
+			t.Errorf("got request %+v", req)
+		}
+		fmt.Fprint(w, `{"partner_attachment":{"id":"880b7f98","state":"pending"}}`)
+	})
+
+	var methods []string
+	mux.HandleFunc("/v2/partner_network_connect/attachments/880b7f98", func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		switch r.Method {
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusAccepted)
+		case http.MethodPatch:
+			var req map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("decoding request body: %v", err)
+			}
+			if len(req) != 1 || req["name"] != "env.staging" {
+				t.Errorf("expected only the name sent, got %v", req)
+			}
+			fmt.Fprint(w, `{"partner_attachment":{"id":"880b7f98","name":"env.staging"}}`)
+		default:
+			fmt.Fprint(w, `{"partner_attachment":{"id":"880b7f98","name":"env.staging"}}`)
+		}
+	})
+
+	attachment, _, err := c.PartnerAttachment.Create(ctx, &PartnerAttachmentCreateRequest{
+		Name:                      "env.prod",
+		ConnectionBandwidthInMbps: 1000,
+		Region:                    "nyc",
+		NaaSProvider:              "megaport",
+		VPCIDs:                    []string{"796c6fe3"},
+		BGP:                       BGP{LocalASN: 64532, PeerASN: 133937, AuthKey: "secret"},
+	})
+	if err != nil {
+		t.Fatalf("PartnerAttachment.Create returned error: %v", err)
+	}
+	if attachment.State != "pending" {
+		t.Errorf("got attachment %+v", attachment)
+	}
+	attachment, _, err = c.PartnerAttachment.Update(ctx, "880b7f98", &PartnerAttachmentUpdateRequest{Name: "env.staging"})
+	if err != nil {
+		t.Fatalf("PartnerAttachment.Update returned error: %v", err)
+	}
+	if attachment.Name != "env.staging" {
+		t.Errorf("got attachment %+v", attachment)
+	}
+	if _, _, err := c.PartnerAttachment.Get(ctx, "880b7f98"); err != nil {
+		t.Fatalf("PartnerAttachment.Get returned error: %v", err)
+	}
+	if _, err := c.PartnerAttachment.Delete(ctx, "880b7f98"); err != nil {
+		t.Fatalf("PartnerAttachment.Delete returned error: %v", err)
+	}
+
+	if fmt.Sprint(methods) != "[PATCH GET DELETE]" {
+		t.Errorf("got methods %v", methods)
+	}
+}
+
+func TestPartnerAttachments_keys(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+
+	var methods []string
+	mux.HandleFunc("/v2/partner_network_connect/attachments/880b7f98/service_key", func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		fmt.Fprint(w, `{"service_key":{"value":"a1b2c3","state":"CREATED"}}`)
+	})
+	mux.HandleFunc("/v2/partner_network_connect/attachments/880b7f98/bgp_auth_key", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"bgp_auth_key":{"value":"secret"}}`)
+	})
+
+	if _, err := c.PartnerAttachment.RegenerateServiceKey(ctx, "880b7f98"); err != nil {
+		t.Fatalf("PartnerAttachment.RegenerateServiceKey returned error: %v", err)
+	}
+	key, _, err := c.PartnerAttachment.GetServiceKey(ctx, "880b7f98")
+	if err != nil {
+		t.Fatalf("PartnerAttachment.GetServiceKey returned error: %v", err)
+	}
+	if key.Value != "a1b2c3" || key.State != "CREATED" {
+		t.Errorf("got service key %+v", key)
+	}
+	authKey, _, err := c.PartnerAttachment.GetBGPAuthKey(ctx, "880b7f98")
+	if err != nil {
+		t.Fatalf("PartnerAttachment.GetBGPAuthKey returned error: %v", err)
+	}
+	if authKey.Value != "secret" {
+		t.Errorf("got BGP auth key %+v", authKey)
+	}
+
+	if fmt.Sprint(methods) != "[POST GET]" {
+		t.Errorf("got methods %v", methods)
+	}
+}
+
+func TestPartnerAttachments_routes(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+
+	mux.HandleFunc("/v2/partner_network_connect/attachments/880b7f98/remote_routes", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `{"remote_routes":[{"cidr":"10.10.0.0/16"}]}`)
+			return
+		}
+		testMethod(t, r, http.MethodPut)
+		var req map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if fmt.Sprint(req["remote_routes"]) != "[map[cidr:10.10.0.0/16] map[cidr:10.20.0.0/16]]" {
+			t.Errorf("got request %v", req)
+		}
+		fmt.Fprint(w, `{"partner_attachment":{"id":"880b7f98"}}`)
+	})
+
+	routes, _, err := c.PartnerAttachment.ListRoutes(ctx, "880b7f98", nil)
+	if err != nil {
+		t.Fatalf("PartnerAttachment.ListRoutes returned error: %v", err)
+	}
+	if len(routes) != 1 || routes[0].CIDR != "10.10.0.0/16" {
+		t.Errorf("got routes %+v", routes)
+	}
+	if _, _, err := c.PartnerAttachment.SetRoutes(ctx, "880b7f98", []string{"10.10.0.0/16", "10.20.0.0/16"}); err != nil {
+		t.Fatalf("PartnerAttachment.SetRoutes returned error: %v", err)
+	}
+}
+
+func TestPartnerAttachments_validation(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+	s := c.PartnerAttachment
+	valid := PartnerAttachmentCreateRequest{Name: "env.prod", ConnectionBandwidthInMbps: 1000, Region: "nyc", NaaSProvider: "megaport", VPCIDs: []string{"796c6fe3"}}
+
+	create := func(change func(*PartnerAttachmentCreateRequest)) func() error {
+		return func() error {
+			req := valid
+			change(&req)
+			_, _, err := s.Create(ctx, &req)
+			return err
+		}
+	}
+
+	calls := map[string]func() error{
+		"get empty id":         func() error { _, _, err := s.Get(ctx, ""); return err },
+		"delete empty id":      func() error { _, err := s.Delete(ctx, ""); return err },
+		"create nil":           func() error { _, _, err := s.Create(ctx, nil); return err },
+		"create no name":       create(func(r *PartnerAttachmentCreateRequest) { r.Name = "" }),
+		"create no region":     create(func(r *PartnerAttachmentCreateRequest) { r.Region = "" }),
+		"create no provider":   create(func(r *PartnerAttachmentCreateRequest) { r.NaaSProvider = "" }),
+		"create no bandwidth":  create(func(r *PartnerAttachmentCreateRequest) { r.ConnectionBandwidthInMbps = 0 }),
+		"create no VPCs":       create(func(r *PartnerAttachmentCreateRequest) { r.VPCIDs = nil }),
+		"update nil":           func() error { _, _, err := s.Update(ctx, "880b7f98", nil); return err },
+		"service key empty id": func() error { _, _, err := s.GetServiceKey(ctx, ""); return err },
+		"routes empty id":      func() error { _, _, err := s.ListRoutes(ctx, "", nil); return err },
+		"set invalid CIDR":     func() error { _, _, err := s.SetRoutes(ctx, "880b7f98", []string{"10.10.0.0"}); return err },
+	}
+	for name, call := range calls {
+		var verr *ValidationError
+		if err := call(); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,305 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	projectsBasePath = "v2/projects"
+
+	// DefaultProject is the ID to use for creating resources in the
+	// default project.
+	DefaultProject = "default"
+)
+
+// Environments of projects.
+const (
+	ProjectEnvironmentDevelopment = "Development"
+	ProjectEnvironmentStaging     = "Staging"
+	ProjectEnvironmentProduction  = "Production"
+)
+
+// Project represents a DigitalOcean Project configuration.
+type Project struct {
+	ID          string `json:"id"`
+	OwnerUUID   string `json:"owner_uuid"`
+	OwnerID     int    `json:"owner_id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Purpose     string `json:"purpose"`
+	Environment string `json:"environment"`
+	IsDefault   bool   `json:"is_default"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+// CreateProjectRequest represents the request to create a new project.
+type CreateProjectRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Purpose     string `json:"purpose"`
+	Environment string `json:"environment"`
+}
+
+// UpdateProjectRequest represents the request to update project information.
+// Only the fields which are not nil are changed.
+type UpdateProjectRequest struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Purpose     *string `json:"purpose,omitempty"`
+	Environment *string `json:"environment,omitempty"`
+	IsDefault   *bool   `json:"is_default,omitempty"`
+}
+
+// ProjectResource is the projects API's representation of a resource.
+type ProjectResource struct {
+	URN        string                `json:"urn"`
+	AssignedAt string                `json:"assigned_at"`
+	Links      *ProjectResourceLinks `json:"links"`
+	Status     string                `json:"status,omitempty"`
+}
+
+// ProjectResourceLinks specify the link for more information about the resource.
+type ProjectResourceLinks struct {
+	Self string `json:"self"`
+}
+
+// ResourceWithURN is a resource which can be assigned to a project, such as
+// a Droplet, Volume or Database.
+type ResourceWithURN interface {
+	URN() string
+}
+
+type assignResourcesRequest struct {
+	Resources []string `json:"resources"`
+}
+
+/* SERVICE */
+
+// ProjectsService is an interface for creating and managing Projects with the DigitalOcean API.
+// See: https://docs.digitalocean.com/reference/api/api-reference/#tag/Projects
+type ProjectsService interface {
+	List(context.Context, *ListOptions) ([]Project, *Response, error)
+	ListAll(context.Context, *ListAllOptions) ([]Project, *Response, error)
+	GetDefault(context.Context) (*Project, *Response, error)
+	Get(context.Context, string) (*Project, *Response, error)
+	Create(context.Context, *CreateProjectRequest) (*Project, *Response, error)
+	Update(context.Context, string, *UpdateProjectRequest) (*Project, *Response, error)
+	UpdateDefault(context.Context, *UpdateProjectRequest) (*Project, *Response, error)
+	Delete(context.Context, string) (*Response, error)
+
+	ListResources(context.Context, string, *ListOptions) ([]ProjectResource, *Response, error)
+	AssignResources(context.Context, string, ...interface{}) ([]ProjectResource, *Response, error)
+}
+
+// ProjectsServiceOp handles communication with Projects methods of the DigitalOcean API.
+type ProjectsServiceOp struct {
+	client *Client
+}
+
+var _ ProjectsService = &ProjectsServiceOp{}
+
+// List Projects.
+func (s *ProjectsServiceOp) List(ctx context.Context, opt *ListOptions) ([]Project, *Response, error) {
+	path, err := addOptions(projectsBasePath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	projects, resp, err := DoEnvelope[[]Project](ctx, s.client, req, "projects")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *projects, resp, err
+}
+
+// ListAll projects across all pages
+func (s *ProjectsServiceOp) ListAll(ctx context.Context, opt *ListAllOptions) ([]Project, *Response, error) {
+	return ListAll[Project](ctx, s.List, opt)
+}
+
+// GetDefault project.
+func (s *ProjectsServiceOp) GetDefault(ctx context.Context) (*Project, *Response, error) {
+	return s.Get(ctx, DefaultProject)
+}
+
+// Get retrieves a single project by its ID.
+func (s *ProjectsServiceOp) Get(ctx context.Context, projectID string) (*Project, *Response, error) {
+	path, err := projectPath(projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doProject(ctx, req)
+}
+
+// Create a new project.
+func (s *ProjectsServiceOp) Create(ctx context.Context, createRequest *CreateProjectRequest) (*Project, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, &ValidationError{Field: "createRequest", Reason: "cannot be nil"}
+	}
+	if createRequest.Name == "" {
+		return nil, nil, &ValidationError{Field: "name", Reason: "must not be empty"}
+	}
+	if createRequest.Purpose == "" {
+		return nil, nil, &ValidationError{Field: "purpose", Reason: "must not be empty"}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, projectsBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doProject(ctx, req)
+}
+
+// Update an existing project. Setting IsDefault makes the project the
+// default one, where resources created without a project are placed.
+func (s *ProjectsServiceOp) Update(ctx context.Context, projectID string, updateRequest *UpdateProjectRequest) (*Project, *Response, error) {
+	path, err := projectPath(projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if updateRequest == nil {
+		return nil, nil, &ValidationError{Field: "updateRequest", Reason: "cannot be nil"}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPatch, path, updateRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doProject(ctx, req)
+}
+
+// UpdateDefault updates the default project.
+func (s *ProjectsServiceOp) UpdateDefault(ctx context.Context, updateRequest *UpdateProjectRequest) (*Project, *Response, error) {
+	return s.Update(ctx, DefaultProject, updateRequest)
+}
+
+// Delete an existing project. You cannot have any resources in a project
+// before deleting it. See the API documentation for more details.
+func (s *ProjectsServiceOp) Delete(ctx context.Context, projectID string) (*Response, error) {
+	path, err := projectPath(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListResources lists all resources in a project.
+func (s *ProjectsServiceOp) ListResources(ctx context.Context, projectID string, opt *ListOptions) ([]ProjectResource, *Response, error) {
+	path, err := projectPath(projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	path, err = addOptions(path+"/resources", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resources, resp, err := DoEnvelope[[]ProjectResource](ctx, s.client, req, "resources")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *resources, resp, err
+}
+
+// AssignResources assigns one or more resources to a project, moving them
+// out of the project they were in. Resources are given either as URNs, as
+// strings or URN values, e.g. "do:droplet:13457723", or as values
+// implementing ResourceWithURN.
+func (s *ProjectsServiceOp) AssignResources(ctx context.Context, projectID string, resources ...interface{}) ([]ProjectResource, *Response, error) {
+	path, err := projectPath(projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(resources) == 0 {
+		return nil, nil, &ValidationError{Field: "resources", Reason: "must not be empty"}
+	}
+
+	assign := &assignResourcesRequest{Resources: make([]string, 0, len(resources))}
+	for _, resource := range resources {
+		urn, err := resourceURN(resource)
+		if err != nil {
+			return nil, nil, err
+		}
+		assign.Resources = append(assign.Resources, urn)
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path+"/resources", assign)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	assigned, resp, err := DoEnvelope[[]ProjectResource](ctx, s.client, req, "resources")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *assigned, resp, err
+}
+
+// resourceURN returns the URN of a resource given as a URN string or as a
+// ResourceWithURN.
+func resourceURN(resource interface{}) (string, error) {
+	var urn string
+	switch r := resource.(type) {
+	case string:
+		urn = r
+	case URN:
+		urn = string(r)
+	case ResourceWithURN:
+		urn = r.URN()
+	default:
+		return "", &ValidationError{Field: "resources", Value: fmt.Sprintf("%T", resource), Reason: "must be a URN string or implement ResourceWithURN"}
+	}
+
+	if _, err := ParseURN(urn); err != nil {
+		return "", &ValidationError{Field: "resources", Value: urn, Reason: "is not a valid URN"}
+	}
+	return urn, nil
+}
+
+func (s *ProjectsServiceOp) doProject(ctx context.Context, req *http.Request) (*Project, *Response, error) {
+	project, resp, err := DoEnvelope[Project](ctx, s.client, req, "project")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return project, resp, err
+}
+
+// projectPath returns the path of a project.
+func projectPath(projectID string) (string, error) {
+	if projectID == "" {
+		return "", &ValidationError{Field: "projectID", Reason: "must not be empty"}
+	}
+	return fmt.Sprintf("%s/%s", projectsBasePath, projectID), nil
+}
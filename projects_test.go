@@ -0,0 +1,205 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestProjects_List(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"projects":[{"id":"4e1bfbc3","name":"my-web-api","purpose":"Service or API","environment":"Production","is_default":true}]}`)
+	})
+
+	projects, _, err := c.Projects.List(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Projects.List returned error: %v", err)
+	}
+	if len(projects) != 1 || projects[0].Environment != ProjectEnvironmentProduction || !projects[0].IsDefault {
+		t.Errorf("got projects %+v", projects)
+	}
+}
+
+func TestProjects_Create(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var req CreateProjectRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if req.Name != "my-web-api" || req.Purpose != "Service or API" {
+			t.Errorf("got request %+v", req)
+		}
+		fmt.Fprint(w, `{"project":{"id":"4e1bfbc3","name":"my-web-api"}}`)
+	})
+
+	project, _, err := c.Projects.Create(context.Background(), &CreateProjectRequest{Name: "my-web-api", Purpose: "Service or API", Environment: ProjectEnvironmentStaging})
+	if err != nil {
+		t.Fatalf("Projects.Create returned error: %v", err)
+	}
+	if project.ID != "4e1bfbc3" {
+		t.Errorf("got project %+v", project)
+	}
+}
+
+func TestProjects_default(t *testing.T) {
+	c, mux := setup(t)
+
+	var methods []string
+	mux.HandleFunc("/v2/projects/default", func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		if r.Method == http.MethodPatch {
+			var req map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("decoding request body: %v", err)
+			}
+			if len(req) != 1 || req["description"] != "" {
+				t.Errorf("expected only the description cleared, got %v", req)
+			}
+		}
+		fmt.Fprint(w, `{"project":{"id":"4e1bfbc3","is_default":true}}`)
+	})
+
+	project, _, err := c.Projects.GetDefault(context.Background())
+	if err != nil {
+		t.Fatalf("Projects.GetDefault returned error: %v", err)
+	}
+	if !project.IsDefault {
+		t.Errorf("got project %+v", project)
+	}
+
+	empty := ""
+	if _, _, err := c.Projects.UpdateDefault(context.Background(), &UpdateProjectRequest{Description: &empty}); err != nil {
+		t.Fatalf("Projects.UpdateDefault returned error: %v", err)
+	}
+
+	if fmt.Sprint(methods) != "[GET PATCH]" {
+		t.Errorf("got methods %v", methods)
+	}
+}
+
+func TestProjects_Delete(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/projects/4e1bfbc3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := c.Projects.Delete(context.Background(), "4e1bfbc3"); err != nil {
+		t.Errorf("Projects.Delete returned error: %v", err)
+	}
+}
+
+func TestProjects_validation(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+	p := c.Projects
+
+	calls := map[string]func() error{
+		"get empty id":      func() error { _, _, err := p.Get(ctx, ""); return err },
+		"create nil":        func() error { _, _, err := p.Create(ctx, nil); return err },
+		"create no name":    func() error { _, _, err := p.Create(ctx, &CreateProjectRequest{Purpose: "Service or API"}); return err },
+		"create no purpose": func() error { _, _, err := p.Create(ctx, &CreateProjectRequest{Name: "my-web-api"}); return err },
+		"update empty id":   func() error { _, _, err := p.Update(ctx, "", &UpdateProjectRequest{}); return err },
+		"update nil":        func() error { _, _, err := p.UpdateDefault(ctx, nil); return err },
+		"delete empty id":   func() error { _, err := p.Delete(ctx, ""); return err },
+	}
+	for name, call := range calls {
+		var verr *ValidationError
+		if err := call(); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
+
+func TestProjects_ListResources(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/projects/4e1bfbc3/resources", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"resources":[{"urn":"do:droplet:13457723","assigned_at":"2018-09-28T19:26:37Z","links":{"self":"https://api.digitalocean.com/v2/droplets/13457723"},"status":"ok"}]}`)
+	})
+
+	resources, _, err := c.Projects.ListResources(context.Background(), "4e1bfbc3", nil)
+	if err != nil {
+		t.Fatalf("Projects.ListResources returned error: %v", err)
+	}
+	if len(resources) != 1 || resources[0].URN != "do:droplet:13457723" || resources[0].Links.Self == "" {
+		t.Errorf("got resources %+v", resources)
+	}
+}
+
+func TestProjects_AssignResources(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/projects/4e1bfbc3/resources", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var req assignResourcesRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		want := "[do:droplet:13457723 do:droplet:1 do:volume:506f78a4]"
+		if fmt.Sprint(req.Resources) != want {
+			t.Errorf("expected resources %s, got %v", want, req.Resources)
+		}
+		fmt.Fprint(w, `{"resources":[{"urn":"do:droplet:13457723","status":"ok"},{"urn":"do:droplet:1","status":"ok"},{"urn":"do:volume:506f78a4","status":"ok"}]}`)
+	})
+
+	assigned, _, err := c.Projects.AssignResources(context.Background(), "4e1bfbc3", "do:droplet:13457723", Droplet{ID: 1}, Volume{ID: "506f78a4"})
+	if err != nil {
+		t.Fatalf("Projects.AssignResources returned error: %v", err)
+	}
+	if len(assigned) != 3 || assigned[2].Status != "ok" {
+		t.Errorf("got resources %+v", assigned)
+	}
+}
+
+func TestProjects_AssignResources_URN(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/projects/4e1bfbc3/resources", func(w http.ResponseWriter, r *http.Request) {
+		var req assignResourcesRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if fmt.Sprint(req.Resources) != "[do:kubernetes:bd5f5959]" {
+			t.Errorf("got resources %v", req.Resources)
+		}
+		fmt.Fprint(w, `{"resources":[{"urn":"do:kubernetes:bd5f5959","status":"ok"}]}`)
+	})
+
+	if _, _, err := c.Projects.AssignResources(context.Background(), "4e1bfbc3", URN("do:kubernetes:bd5f5959")); err != nil {
+		t.Fatalf("Projects.AssignResources returned error: %v", err)
+	}
+}
+
+func TestProjects_AssignResources_invalid(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+
+	for name, resources := range map[string][]interface{}{
+		"none":          nil,
+		"not a URN":     {"droplet:1"},
+		"not URN typed": {1},
+		"invalid URN":   {URN("do:droplet")},
+	} {
+		var verr *ValidationError
+		if _, _, err := c.Projects.AssignResources(ctx, "4e1bfbc3", resources...); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+
+	var verr *ValidationError
+	if _, _, err := c.Projects.ListResources(ctx, "", nil); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for an empty project ID, got %v", err)
+	}
+}
@@ -0,0 +1,346 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// SetWaitForRateLimitReset is a client option which makes requests sleep until
+// RateLimit-Reset once RateLimit-Remaining has dropped to zero, instead of
+// sending them and failing. It is meant for long running batch jobs.
+func SetWaitForRateLimitReset(wait bool) ClientOpt {
+	return func(c *Client) error {
+		c.waitForRateLimitReset = wait
+		return nil
+	}
+}
+
+// SetRequestRateLimit is a client option which throttles outgoing requests to
+// requestsPerSecond on average, allowing bursts of up to burst requests. Do
+// waits for the limiter before sending each request, so a busy goroutine cannot
+// use up the whole API quota at once.
+func SetRequestRateLimit(requestsPerSecond float64, burst int) ClientOpt {
+	return func(c *Client) error {
+		if requestsPerSecond <= 0 {
+			return fmt.Errorf("requests per second must be positive, got %v", requestsPerSecond)
+		}
+		if burst < 1 {
+			return fmt.Errorf("burst must be at least 1, got %d", burst)
+		}
+		c.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+		return nil
+	}
+}
+
+// ErrRateLimitQueueFull is returned when a request can not be queued because
+// the rate limit queue already holds as many requests as it was sized for.
+var ErrRateLimitQueueFull = errors.New("rate limit queue is full")
+
+// SetRateLimitQueue is a client option which queues up to size requests while
+// the rate limit is exhausted, and releases them one by one in FIFO order once
+// it resets. Requests leave the queue early when their context is done, and
+// the time each request spent queued is reported in Response.QueueTime.
+func SetRateLimitQueue(size int) ClientOpt {
+	return func(c *Client) error {
+		if size < 1 {
+			return fmt.Errorf("rate limit queue size must be at least 1, got %d", size)
+		}
+		c.rateLimitQueue = &rateLimitQueue{size: size}
+		return nil
+	}
+}
+
+// rateLimitQueue is a bounded FIFO queue of requests waiting for the rate
+// limit to reset. Each request holds a ticket, which is closed once it is at
+// the head of the queue.
+type rateLimitQueue struct {
+	// size bounds the number of queued requests.
+	size int
+
+	mu      sync.Mutex
+	tickets []chan struct{}
+}
+
+// enqueue appends a ticket to the queue, which is closed at once if the queue
+// was empty.
+func (q *rateLimitQueue) enqueue() (chan struct{}, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.tickets) >= q.size {
+		return nil, ErrRateLimitQueueFull
+	}
+	ticket := make(chan struct{})
+	q.tickets = append(q.tickets, ticket)
+	if len(q.tickets) == 1 {
+		close(ticket)
+	}
+	return ticket, nil
+}
+
+// leave removes ticket from the queue, passing the turn on to the next ticket
+// if it was at the head.
+func (q *rateLimitQueue) leave(ticket chan struct{}) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for i, t := range q.tickets {
+		if t != ticket {
+			continue
+		}
+		q.tickets = append(q.tickets[:i], q.tickets[i+1:]...)
+		if i == 0 && len(q.tickets) > 0 {
+			close(q.tickets[0])
+		}
+		return
+	}
+}
+
+// queueForRateLimit holds req in the rate limit queue while the rate limit of
+// its endpoint is exhausted. It returns the time spent queued.
+func (c *Client) queueForRateLimit(ctx context.Context, req *http.Request) (time.Duration, error) {
+	if !c.rateFor(ctx, req).exhausted() {
+		return 0, nil
+	}
+
+	ticket, err := c.rateLimitQueue.enqueue()
+	if err != nil {
+		return 0, err
+	}
+	defer c.rateLimitQueue.leave(ticket)
+
+	c.logInfo(ctx, "request queued until rate limit reset", "method", req.Method, "url", c.redactor.RedactURL(req.URL))
+	start := time.Now()
+	select {
+	case <-ticket:
+	case <-ctx.Done():
+		return time.Since(start), ctx.Err()
+	}
+
+	err = c.waitForRateLimit(ctx, req)
+	return time.Since(start), err
+}
+
+// RateLimitHooks are callbacks fired as the client observes rate limits, so
+// applications can alert or shed load before they are hard limited. The
+// endpoint passed to the callbacks is the prefix used by RatesSnapshot.
+type RateLimitHooks struct {
+	// Thresholds of remaining requests which trigger OnThreshold.
+	Thresholds []int
+
+	// OnThreshold is called when the remaining requests of an endpoint drop to
+	// or below one of the Thresholds.
+	OnThreshold func(endpoint string, threshold int, rate Rate)
+
+	// OnRateLimited is called when the API rate limits a request, responding
+	// with 429 Too Many Requests, or 403 Forbidden with no requests remaining.
+	OnRateLimited func(endpoint string, resp *Response)
+}
+
+// SetRateLimitHooks is a client option for registering rate limit callbacks.
+func SetRateLimitHooks(hooks RateLimitHooks) ClientOpt {
+	return func(c *Client) error {
+		c.rateLimitHooks = hooks
+		return nil
+	}
+}
+
+// RateLimitError occurs when the rate limit of the client has been exhausted.
+type RateLimitError struct {
+	Rate     Rate           // Rate specifies last known rate limit for the client
+	Response *http.Response // HTTP response that caused this error
+	Message  string         `json:"message"` // error message
+
+	// unsent is set when the request was not sent because the rate limit
+	// would not reset before its context expires, so retrying is futile.
+	unsent bool
+}
+
+func (r *RateLimitError) Error() string {
+	if r.Rate.Reset.IsZero() {
+		return fmt.Sprintf("%v %v: %d %v",
+			r.Response.Request.Method, r.Response.Request.URL, r.Response.StatusCode, r.Message)
+	}
+	return fmt.Sprintf("%v %v: %d %v %v",
+		r.Response.Request.Method, r.Response.Request.URL,
+		r.Response.StatusCode, r.Message, formatRateReset(time.Until(r.Rate.Reset.Time)))
+}
+
+// waitForRateLimit blocks until the rate limit resets if the most recent
+// response reported no remaining requests. It gives up early with a
+// RateLimitError when ctx would expire before the reset.
+func (c *Client) waitForRateLimit(ctx context.Context, req *http.Request) error {
+	rate := c.rateFor(ctx, req)
+	if !rate.exhausted() {
+		return nil
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && deadline.Before(rate.Reset.Time) {
+		return newRateLimitError(req, rate)
+	}
+
+	wait := time.Until(rate.Reset.Time)
+	c.logInfo(ctx, "waiting for rate limit reset", "method", req.Method, "url", c.redactor.RedactURL(req.URL), "wait", wait)
+	c.Emit(ctx, &RequestQueued{Request: req, Reset: rate.Reset.Time})
+	return sleep(ctx, wait)
+}
+
+// RatesSnapshot returns the latest rate limit observed for each endpoint
+// prefix, e.g. "/v2/tags". Some endpoints have separate budgets, which the
+// single Rate field can not reflect since every response overwrites it.
+func (c *Client) RatesSnapshot() map[string]Rate {
+	return c.rateStore.Rates(context.Background())
+}
+
+// RateLimitedCounts returns the number of rate limited responses, see
+// RateLimitHooks.OnRateLimited, received for each endpoint prefix since the
+// client was created.
+func (c *Client) RateLimitedCounts() map[string]int {
+	c.ratemtx.Lock()
+	defer c.ratemtx.Unlock()
+
+	counts := make(map[string]int, len(c.rateLimited))
+	for prefix, n := range c.rateLimited {
+		counts[prefix] = n
+	}
+	return counts
+}
+
+// recordRateLimited counts a rate limited response to req and fires the
+// OnRateLimited hook.
+func (c *Client) recordRateLimited(req *http.Request, resp *Response) {
+	key := c.rateKey(req)
+
+	c.ratemtx.Lock()
+	if c.rateLimited == nil {
+		c.rateLimited = make(map[string]int)
+	}
+	c.rateLimited[key]++
+	c.ratemtx.Unlock()
+
+	if hook := c.rateLimitHooks.OnRateLimited; hook != nil {
+		hook(key, resp)
+	}
+	c.Emit(req.Context(), &RateLimited{Request: req, Response: resp, Endpoint: key})
+}
+
+// recordRate stores rate as the latest rate limit of the client and of the
+// endpoint req was sent to. Responses without rate limit headers, e.g. from a
+// proxy, leave the last known rate limit in place.
+func (c *Client) recordRate(req *http.Request, rate Rate) {
+	if !rate.observed() {
+		return
+	}
+	key := c.rateKey(req)
+
+	// swap the endpoint rate under the lock, so that concurrent responses
+	// crossing a threshold fire it once
+	c.ratemtx.Lock()
+	c.Rate = rate
+	prev, seen := c.rateStore.Rate(req.Context(), key)
+	c.rateStore.SetRate(req.Context(), key, rate)
+	c.ratemtx.Unlock()
+
+	c.fireRateThresholds(key, prev, seen, rate)
+}
+
+// fireRateThresholds calls the OnThreshold hook for every threshold crossed
+// between the previous and the current rate of an endpoint.
+func (c *Client) fireRateThresholds(key string, prev Rate, seen bool, rate Rate) {
+	hook := c.rateLimitHooks.OnThreshold
+	if hook == nil || !rate.observed() {
+		return
+	}
+
+	for _, threshold := range c.rateLimitHooks.Thresholds {
+		if rate.Remaining > threshold {
+			continue
+		}
+		if seen && prev.observed() && prev.Remaining <= threshold {
+			// already below the threshold before this response
+			continue
+		}
+		hook(key, threshold, rate)
+	}
+}
+
+// observed reports whether the rate was parsed from rate limit headers.
+func (r Rate) observed() bool {
+	return r.Limit != 0 || !r.Reset.IsZero()
+}
+
+// exhausted reports whether no requests remain until the rate limit resets.
+func (r Rate) exhausted() bool {
+	return !r.Reset.IsZero() && r.Remaining == 0 && time.Now().Before(r.Reset.Time)
+}
+
+// rateFor returns the latest rate limit observed for the endpoint of req,
+// falling back to the client wide rate for endpoints not seen yet.
+func (c *Client) rateFor(ctx context.Context, req *http.Request) Rate {
+	if rate, ok := c.rateStore.Rate(ctx, c.rateKey(req)); ok {
+		return rate
+	}
+	return c.GetRate()
+}
+
+// rateKey returns the endpoint prefix rate limits of req are tracked under.
+// It is made of the first two path segments below BaseURL, e.g. "/v2/tags".
+func (c *Client) rateKey(req *http.Request) string {
+	path := strings.TrimPrefix(req.URL.Path, c.BaseURL.Path)
+	segments := strings.SplitN(strings.Trim(path, "/"), "/", 3)
+	if len(segments) > 2 {
+		segments = segments[:2]
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+// newRateLimitError creates a RateLimitError with a fake response for a
+// request which was not sent because the rate limit is exhausted.
+func newRateLimitError(req *http.Request, rate Rate) *RateLimitError {
+	resp := &http.Response{
+		Status:     http.StatusText(http.StatusTooManyRequests),
+		StatusCode: http.StatusTooManyRequests,
+		Request:    req,
+		Header:     make(http.Header),
+		Body:       ioutil.NopCloser(strings.NewReader("")),
+	}
+	return &RateLimitError{
+		Rate:     rate,
+		Response: resp,
+		Message:  fmt.Sprintf("API rate limit of %v still exceeded until %v, not making remote request.", rate.Limit, rate.Reset.Time),
+		unsent:   true,
+	}
+}
+
+// formatRateReset formats d to look like "[rate reset in 2s]" or
+// "[rate reset in 87m02s]" for the positive durations. And like "[rate limit was reset 87m02s ago]"
+// for the negative cases.
+func formatRateReset(d time.Duration) string {
+	isNegative := d < 0
+	if isNegative {
+		d *= -1
+	}
+	secondsTotal := int(0.5 + d.Seconds())
+	minutes := secondsTotal / 60
+	seconds := secondsTotal - minutes*60
+
+	var timeString string
+	if minutes > 0 {
+		timeString = fmt.Sprintf("%dm%02ds", minutes, seconds)
+	} else {
+		timeString = fmt.Sprintf("%ds", seconds)
+	}
+
+	if isNegative {
+		return fmt.Sprintf("[rate limit was reset %v ago]", timeString)
+	}
+	return fmt.Sprintf("[rate reset in %v]", timeString)
+}
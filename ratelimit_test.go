@@ -0,0 +1,346 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// waitForTickets waits until q holds n tickets.
+func waitForTickets(t *testing.T, q *rateLimitQueue, n int) {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		q.mu.Lock()
+		got := len(q.tickets)
+		q.mu.Unlock()
+		if got == n {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %d tickets, got %d", n, got)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestRateLimitQueue_FIFO(t *testing.T) {
+	q := &rateLimitQueue{size: 10}
+
+	head, err := q.enqueue()
+	if err != nil {
+		t.Fatalf("enqueue returned error: %v", err)
+	}
+
+	var mu sync.Mutex
+	var order []int
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		ticket, err := q.enqueue()
+		if err != nil {
+			t.Fatalf("enqueue returned error: %v", err)
+		}
+		wg.Add(1)
+		go func(i int, ticket chan struct{}) {
+			defer wg.Done()
+			<-ticket
+			mu.Lock()
+			order = append(order, i)
+			mu.Unlock()
+			q.leave(ticket)
+		}(i, ticket)
+	}
+
+	q.leave(head)
+	wg.Wait()
+
+	if fmt.Sprint(order) != fmt.Sprint([]int{0, 1, 2, 3, 4, 5, 6, 7}) {
+		t.Errorf("expected tickets released in order, got %v", order)
+	}
+}
+
+func TestRateLimitQueue_leaveBeforeTurn(t *testing.T) {
+	q := &rateLimitQueue{size: 3}
+
+	head, _ := q.enqueue()
+	second, _ := q.enqueue()
+	third, _ := q.enqueue()
+
+	q.leave(second)
+	select {
+	case <-third:
+		t.Fatal("expected the third ticket to wait for the head")
+	default:
+	}
+
+	q.leave(head)
+	select {
+	case <-third:
+	default:
+		t.Fatal("expected the third ticket released after the head left")
+	}
+}
+
+func TestRateLimitQueue_full(t *testing.T) {
+	c, mux := setup(t, SetRateLimitQueue(1))
+
+	reset := time.Now().Add(time.Hour).Unix()
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "5000")
+		w.Header().Set(headerRateRemaining, "0")
+		w.Header().Set(headerRateReset, strconv.FormatInt(reset, 10))
+		fmt.Fprint(w, `{"account":{}}`)
+	})
+
+	if _, _, err := c.Account.Get(context.Background()); err != nil {
+		t.Fatalf("Account.Get returned error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	queued := make(chan error)
+	go func() {
+		_, _, err := c.Account.Get(ctx)
+		queued <- err
+	}()
+	waitForTickets(t, c.rateLimitQueue, 1)
+
+	if _, _, err := c.Account.Get(context.Background()); err != ErrRateLimitQueueFull {
+		t.Errorf("expected ErrRateLimitQueueFull, got %v", err)
+	}
+
+	cancel()
+	if err := <-queued; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the queued request canceled, got %v", err)
+	}
+	waitForTickets(t, c.rateLimitQueue, 0)
+}
+
+func TestDo_responseWithoutRateKeepsRate(t *testing.T) {
+	c, mux := setup(t)
+
+	reset := time.Now().Add(time.Hour).Unix()
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "5000")
+		w.Header().Set(headerRateRemaining, "0")
+		w.Header().Set(headerRateReset, strconv.FormatInt(reset, 10))
+		fmt.Fprint(w, `{"account":{}}`)
+	})
+	mux.HandleFunc("/v2/account/keys", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"ssh_keys":[]}`)
+	})
+
+	if _, _, err := c.Account.Get(context.Background()); err != nil {
+		t.Fatalf("Account.Get returned error: %v", err)
+	}
+	if _, _, err := c.Keys.List(context.Background(), nil); err != nil {
+		t.Fatalf("Keys.List returned error: %v", err)
+	}
+
+	rate := c.GetRate()
+	if rate.Limit != 5000 || rate.Remaining != 0 || rate.Reset.Unix() != reset {
+		t.Errorf("expected the exhausted rate kept, got %+v", rate)
+	}
+	if got := c.RatesSnapshot()["/v2/account"]; got != rate {
+		t.Errorf("expected the endpoint rate kept, got %+v", got)
+	}
+}
+
+func TestRateLimitHooks_forbiddenWithoutRemaining(t *testing.T) {
+	var hooked []string
+	var events []*RateLimited
+	c, mux := setup(t,
+		SetRateLimitHooks(RateLimitHooks{OnRateLimited: func(endpoint string, resp *Response) {
+			hooked = append(hooked, endpoint)
+		}}),
+		AddEventListener(func(ctx context.Context, e Event) {
+			if e, ok := e.(*RateLimited); ok {
+				events = append(events, e)
+			}
+		}),
+		SetRateLimitStrategy(RateLimitStrategy{}))
+
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "5000")
+		w.Header().Set(headerRateRemaining, "0")
+		w.Header().Set(headerRateReset, strconv.FormatInt(time.Now().Add(time.Second).Unix(), 10))
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"id":"forbidden","message":"rate limited"}`)
+	})
+
+	_, _, err := c.Account.Get(context.Background())
+	var rerr *RateLimitError
+	if !errors.As(err, &rerr) {
+		t.Fatalf("expected *RateLimitError, got %v", err)
+	}
+
+	if fmt.Sprint(hooked) != "[/v2/account]" {
+		t.Errorf("expected OnRateLimited for /v2/account, got %v", hooked)
+	}
+	if len(events) != 1 || events[0].Endpoint != "/v2/account" {
+		t.Errorf("expected one RateLimited event, got %v", events)
+	}
+	if got := c.RateLimitedCounts()["/v2/account"]; got != 1 {
+		t.Errorf("expected 1 rate limited response counted, got %d", got)
+	}
+}
+
+func TestRateLimitHooks_thresholdFiresOnce(t *testing.T) {
+	var mu sync.Mutex
+	fired := 0
+	c, mux := setup(t, SetRateLimitHooks(RateLimitHooks{
+		Thresholds: []int{10},
+		OnThreshold: func(endpoint string, threshold int, rate Rate) {
+			mu.Lock()
+			fired++
+			mu.Unlock()
+		},
+	}))
+
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "5000")
+		w.Header().Set(headerRateRemaining, "5")
+		w.Header().Set(headerRateReset, strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+		fmt.Fprint(w, `{"account":{}}`)
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, _, err := c.Account.Get(context.Background()); err != nil {
+				t.Errorf("Account.Get returned error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if fired != 1 {
+		t.Errorf("expected the threshold to fire once, fired %d times", fired)
+	}
+}
+
+func TestSetWaitForRateLimitReset(t *testing.T) {
+	var queued []*RequestQueued
+	c, mux := setup(t,
+		SetWaitForRateLimitReset(true),
+		AddEventListener(func(ctx context.Context, e Event) {
+			if e, ok := e.(*RequestQueued); ok {
+				queued = append(queued, e)
+			}
+		}))
+
+	reset := time.Now().Add(time.Second).Truncate(time.Second)
+	var sent []time.Time
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		sent = append(sent, time.Now())
+		w.Header().Set(headerRateLimit, "5000")
+		w.Header().Set(headerRateRemaining, "0")
+		w.Header().Set(headerRateReset, strconv.FormatInt(reset.Unix(), 10))
+		fmt.Fprint(w, `{"account":{}}`)
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := c.Account.Get(context.Background()); err != nil {
+			t.Fatalf("Account.Get returned error: %v", err)
+		}
+	}
+
+	if len(sent) != 2 || sent[1].Before(reset) {
+		t.Errorf("expected the second request sent after the reset at %v, sent at %v", reset, sent)
+	}
+	if len(queued) != 1 || !queued[0].Reset.Equal(reset) {
+		t.Errorf("expected one RequestQueued event until %v, got %v", reset, queued)
+	}
+}
+
+func TestSetRequestRateLimit(t *testing.T) {
+	c, mux := setup(t, SetRequestRateLimit(20, 1))
+
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"account":{}}`)
+	})
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, _, err := c.Account.Get(context.Background()); err != nil {
+			t.Fatalf("Account.Get returned error: %v", err)
+		}
+	}
+	// the burst of one lets the first request through, the others wait 50ms each
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("expected requests throttled to 20 per second, 3 took %v", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, _, err := c.Account.Get(ctx); err == nil {
+		t.Error("expected an error waiting for the limiter with a canceled context")
+	}
+
+	for _, opt := range []ClientOpt{SetRequestRateLimit(0, 1), SetRequestRateLimit(1, 0)} {
+		if _, err := New(nil, opt); err == nil {
+			t.Error("expected an error for an invalid limit")
+		}
+	}
+}
+
+func TestClient_RatesSnapshot(t *testing.T) {
+	c, mux := setup(t)
+
+	handle := func(remaining string, body string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set(headerRateLimit, "5000")
+			w.Header().Set(headerRateRemaining, remaining)
+			w.Header().Set(headerRateReset, "1700000000")
+			fmt.Fprint(w, body)
+		}
+	}
+	mux.HandleFunc("/v2/account", handle("10", `{"account":{}}`))
+	mux.HandleFunc("/v2/sizes", handle("4000", `{"sizes":[]}`))
+
+	if _, _, err := c.Account.Get(context.Background()); err != nil {
+		t.Fatalf("Account.Get returned error: %v", err)
+	}
+	if _, _, err := c.Sizes.List(context.Background(), nil); err != nil {
+		t.Fatalf("Sizes.List returned error: %v", err)
+	}
+
+	rates := c.RatesSnapshot()
+	if got := rates["/v2/account"].Remaining; got != 10 {
+		t.Errorf("expected 10 remaining for /v2/account, got %d", got)
+	}
+	if got := rates["/v2/sizes"].Remaining; got != 4000 {
+		t.Errorf("expected 4000 remaining for /v2/sizes, got %d", got)
+	}
+	if got := c.GetRate().Remaining; got != 4000 {
+		t.Errorf("expected the client rate of the latest response, got %d", got)
+	}
+}
+
+func TestClient_rateKey(t *testing.T) {
+	c, err := New(nil)
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+
+	for path, want := range map[string]string{
+		"v2/droplets":                 "/v2/droplets",
+		"v2/droplets/1/actions":       "/v2/droplets",
+		"v2/monitoring/alerts?page=2": "/v2/monitoring",
+	} {
+		req, err := c.NewRequest(context.Background(), http.MethodGet, path, nil)
+		if err != nil {
+			t.Fatalf("NewRequest returned error: %v", err)
+		}
+		if got := c.rateKey(req); got != want {
+			t.Errorf("rateKey(%s) = %q, want %q", path, got, want)
+		}
+	}
+}
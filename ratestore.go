@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// RateStore stores the latest rate limit observed for each endpoint prefix.
+// Sharing one store between several clients, or between processes with an
+// implementation backed by e.g. Redis, lets them coordinate throttling
+// against one account wide budget. Implementations must be safe for
+// concurrent use and are expected to handle their own failures, since rate
+// tracking is best effort.
+type RateStore interface {
+	// Rate returns the latest rate observed for endpoint, and whether one has
+	// been observed at all.
+	Rate(ctx context.Context, endpoint string) (Rate, bool)
+
+	// SetRate stores the latest rate observed for endpoint.
+	SetRate(ctx context.Context, endpoint string, rate Rate)
+
+	// Rates returns the latest rate observed for every endpoint.
+	Rates(ctx context.Context) map[string]Rate
+}
+
+// SetRateStore is a client option for sharing observed rate limits through
+// store. Clients use a private in-memory store by default.
+func SetRateStore(store RateStore) ClientOpt {
+	return func(c *Client) error {
+		if store == nil {
+			return errors.New("rate store must not be nil")
+		}
+		c.rateStore = store
+		return nil
+	}
+}
+
+// MemoryRateStore is an in-memory RateStore which can be shared by clients in
+// the same process. The zero value is an empty store ready to use.
+type MemoryRateStore struct {
+	mu    sync.Mutex
+	rates map[string]Rate
+}
+
+var _ RateStore = &MemoryRateStore{}
+
+// NewMemoryRateStore returns an empty MemoryRateStore.
+func NewMemoryRateStore() *MemoryRateStore {
+	return &MemoryRateStore{rates: make(map[string]Rate)}
+}
+
+// Rate implements RateStore.
+func (s *MemoryRateStore) Rate(ctx context.Context, endpoint string) (Rate, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rate, ok := s.rates[endpoint]
+	return rate, ok
+}
+
+// SetRate implements RateStore.
+func (s *MemoryRateStore) SetRate(ctx context.Context, endpoint string, rate Rate) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.rates == nil {
+		s.rates = make(map[string]Rate)
+	}
+	s.rates[endpoint] = rate
+}
+
+// Rates implements RateStore.
+func (s *MemoryRateStore) Rates(ctx context.Context) map[string]Rate {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rates := make(map[string]Rate, len(s.rates))
+	for endpoint, rate := range s.rates {
+		rates[endpoint] = rate
+	}
+	return rates
+}
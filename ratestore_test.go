@@ -0,0 +1,23 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMemoryRateStore_zeroValue(t *testing.T) {
+	var s MemoryRateStore
+
+	if _, ok := s.Rate(context.Background(), "/v2/tags"); ok {
+		t.Error("expected no rate in an empty store")
+	}
+
+	s.SetRate(context.Background(), "/v2/tags", Rate{Limit: 250, Remaining: 10})
+	rate, ok := s.Rate(context.Background(), "/v2/tags")
+	if !ok || rate.Remaining != 10 {
+		t.Errorf("got rate %+v, %v", rate, ok)
+	}
+	if rates := s.Rates(context.Background()); len(rates) != 1 {
+		t.Errorf("expected 1 rate, got %v", rates)
+	}
+}
@@ -0,0 +1,211 @@
+package client
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const redacted = "[REDACTED]"
+
+var (
+	// defaultSensitiveHeaders are header keys whose values are always redacted.
+	defaultSensitiveHeaders = []string{
+		"Authorization",
+		"Proxy-Authorization",
+		"Cookie",
+		"Set-Cookie",
+		"X-Api-Key",
+	}
+
+	// defaultSensitiveFields are redacted in JSON fields and query parameters
+	// whose name contains them, e.g. "token" covers "access_token".
+	defaultSensitiveFields = []string{
+		"token",
+		"password",
+		"secret",
+		"private_key",
+		"api_key",
+		"credentials",
+	}
+
+	// privateKeyPattern matches PEM encoded private keys such as SSH keys.
+	privateKeyPattern = regexp.MustCompile(`-----BEGIN ([A-Z ]*)PRIVATE KEY-----[\s\S]*?-----END ([A-Z ]*)PRIVATE KEY-----`)
+
+	// urlPasswordPattern matches the password of URLs with user info, such as
+	// database connection strings.
+	urlPasswordPattern = regexp.MustCompile(`([a-zA-Z][a-zA-Z0-9+.-]*://[^:/@\s"]+:)[^@\s"]+@`)
+)
+
+// Redactor removes secrets such as tokens, SSH private keys and database
+// passwords from everything the client logs or dumps. It holds a registry of
+// sensitive header keys and field names which integrators can extend, e.g.
+//
+//	client.Redactor().AddFields("license")
+//
+// A Redactor is safe for concurrent use. The zero value knows no sensitive
+// headers or fields, NewRedactor returns one knowing the defaults.
+type Redactor struct {
+	mu      sync.RWMutex
+	headers map[string]struct{}
+	fields  map[string]struct{}
+
+	// patterns compiled from the registry, reset when it changes
+	headerPattern *regexp.Regexp
+	fieldPattern  *regexp.Regexp
+	queryPattern  *regexp.Regexp
+}
+
+// NewRedactor returns a Redactor knowing the default sensitive headers and
+// fields.
+func NewRedactor() *Redactor {
+	r := &Redactor{
+		headers: make(map[string]struct{}),
+		fields:  make(map[string]struct{}),
+	}
+	r.AddHeaders(defaultSensitiveHeaders...)
+	r.AddFields(defaultSensitiveFields...)
+	return r
+}
+
+// SetRedactor is a client option replacing the Redactor of the client.
+func SetRedactor(r *Redactor) ClientOpt {
+	return func(c *Client) error {
+		if r == nil {
+			return errors.New("redactor must not be nil")
+		}
+		c.redactor = r
+		return nil
+	}
+}
+
+// Redactor returns the Redactor the client uses for logs and debug dumps.
+func (c *Client) Redactor() *Redactor {
+	return c.redactor
+}
+
+// AddHeaders registers header keys whose values are redacted.
+func (r *Redactor) AddHeaders(keys ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.headers == nil {
+		r.headers = make(map[string]struct{})
+	}
+	for _, key := range keys {
+		r.headers[strings.ToLower(key)] = struct{}{}
+	}
+	r.headerPattern = nil
+}
+
+// AddFields registers names of JSON fields and query parameters whose values
+// are redacted. A name matches every field containing it, case insensitively.
+func (r *Redactor) AddFields(names ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.fields == nil {
+		r.fields = make(map[string]struct{})
+	}
+	for _, name := range names {
+		r.fields[strings.ToLower(name)] = struct{}{}
+	}
+	r.fieldPattern = nil
+	r.queryPattern = nil
+}
+
+// Redact returns a copy of text, e.g. an HTTP dump or a JSON body, with the
+// values of sensitive headers, JSON fields and query parameters, private keys
+// and passwords in URLs replaced.
+func (r *Redactor) Redact(text []byte) []byte {
+	headerPattern, fieldPattern, queryPattern := r.patterns()
+
+	text = headerPattern.ReplaceAll(text, []byte("$1: "+redacted))
+	text = fieldPattern.ReplaceAll(text, []byte(`$1"`+redacted+`"`))
+	text = queryPattern.ReplaceAll(text, []byte("${1}"+redacted))
+	text = privateKeyPattern.ReplaceAll(text, []byte(redacted))
+	return urlPasswordPattern.ReplaceAll(text, []byte("$1"+redacted+"@"))
+}
+
+// RedactURL returns u as a string with the values of sensitive query
+// parameters and the password of its user info replaced.
+func (r *Redactor) RedactURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+
+	clean := *u
+	if clean.User != nil {
+		if _, ok := clean.User.Password(); ok {
+			clean.User = url.UserPassword(clean.User.Username(), redacted)
+		}
+	}
+	if clean.RawQuery != "" {
+		q := clean.Query()
+		for name := range q {
+			if r.isSensitiveField(name) {
+				q.Set(name, redacted)
+			}
+		}
+		clean.RawQuery = q.Encode()
+	}
+	// keep the placeholder readable rather than percent-encoded
+	return strings.ReplaceAll(clean.String(), url.QueryEscape(redacted), redacted)
+}
+
+// isSensitiveField reports whether name contains a registered field name.
+func (r *Redactor) isSensitiveField(name string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	name = strings.ToLower(name)
+	for field := range r.fields {
+		if strings.Contains(name, field) {
+			return true
+		}
+	}
+	return false
+}
+
+// patterns returns the regular expressions matching sensitive header lines,
+// JSON fields and query parameters, compiling them if the registry changed.
+func (r *Redactor) patterns() (headerPattern, fieldPattern, queryPattern *regexp.Regexp) {
+	r.mu.RLock()
+	headerPattern, fieldPattern, queryPattern = r.headerPattern, r.fieldPattern, r.queryPattern
+	r.mu.RUnlock()
+	if headerPattern != nil && fieldPattern != nil && queryPattern != nil {
+		return headerPattern, fieldPattern, queryPattern
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.headerPattern == nil {
+		r.headerPattern = regexp.MustCompile(`(?im)^(` + alternation(r.headers) + `):.*$`)
+	}
+	fields := alternation(r.fields)
+	if r.fieldPattern == nil {
+		r.fieldPattern = regexp.MustCompile(`(?i)("[^"]*(?:` + fields + `)[^"]*"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	}
+	if r.queryPattern == nil {
+		r.queryPattern = regexp.MustCompile(`(?i)([?&][^=\s&#]*(?:` + fields + `)[^=\s&#]*=)[^&\s#]*`)
+	}
+	return r.headerPattern, r.fieldPattern, r.queryPattern
+}
+
+// alternation returns a regular expression matching any of the names, or
+// nothing if there are none.
+func alternation(names map[string]struct{}) string {
+	if len(names) == 0 {
+		return `[^\x00-\x{10FFFF}]`
+	}
+	quoted := make([]string, 0, len(names))
+	for name := range names {
+		quoted = append(quoted, regexp.QuoteMeta(name))
+	}
+	sort.Strings(quoted)
+	return strings.Join(quoted, "|")
+}
@@ -0,0 +1,25 @@
+package client
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRedactor_zeroValue(t *testing.T) {
+	var r Redactor
+
+	dump := "Authorization: Bearer secret\r\n\r\n{\"name\":\"web\",\"license\":\"abc123\"}"
+	if got := string(r.Redact([]byte(dump))); got != dump {
+		t.Errorf("expected nothing redacted without a registry, got %q", got)
+	}
+
+	r.AddHeaders("Authorization")
+	r.AddFields("license")
+	got := string(r.Redact([]byte(dump)))
+	if strings.Contains(got, "secret") || strings.Contains(got, "abc123") {
+		t.Errorf("expected the secrets redacted, got %q", got)
+	}
+	if !strings.Contains(got, `"name":"web"`) {
+		t.Errorf("expected other fields kept, got %q", got)
+	}
+}
@@ -0,0 +1,79 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+const regionsBasePath = "v2/regions"
+
+// Region represents a DigitalOcean Region
+type Region struct {
+	Slug      string   `json:"slug,omitempty"`
+	Name      string   `json:"name,omitempty"`
+	Sizes     []string `json:"sizes,omitempty"`
+	Available bool     `json:"available,omitempty"`
+	Features  []string `json:"features,omitempty"`
+}
+
+// HasFeature reports whether the region offers feature, e.g. "backups".
+func (r Region) HasFeature(feature string) bool {
+	return containsString(r.Features, feature)
+}
+
+// HasSize reports whether droplets of the size slug can be created in the
+// region.
+func (r Region) HasSize(size string) bool {
+	return r.Available && containsString(r.Sizes, size)
+}
+
+/* SERVICE */
+
+// RegionsService is an interface for interfacing with the regions
+// endpoints of the DigitalOcean API
+type RegionsService interface {
+	List(context.Context, *ListOptions) ([]Region, *Response, error)
+	ListAll(context.Context, *ListAllOptions) ([]Region, *Response, error)
+}
+
+// RegionsServiceOp handles communication with the region related methods of the
+// DigitalOcean API.
+type RegionsServiceOp struct {
+	client *Client
+}
+
+var _ RegionsService = &RegionsServiceOp{}
+
+// List all regions
+func (s *RegionsServiceOp) List(ctx context.Context, opt *ListOptions) ([]Region, *Response, error) {
+	path, err := addOptions(regionsBasePath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	regions, resp, err := DoEnvelope[[]Region](ctx, s.client, req, "regions")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *regions, resp, err
+}
+
+// ListAll regions across all pages
+func (s *RegionsServiceOp) ListAll(ctx context.Context, opt *ListAllOptions) ([]Region, *Response, error) {
+	return ListAll[Region](ctx, s.List, opt)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
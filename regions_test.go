@@ -0,0 +1,40 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestRegions_List(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/regions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"regions":[{"slug":"nyc3","available":true,"sizes":["s-1vcpu-1gb"],"features":["backups","ipv6"]}]}`)
+	})
+
+	regions, _, err := c.Regions.List(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Regions.List returned error: %v", err)
+	}
+	if len(regions) != 1 || regions[0].Slug != "nyc3" {
+		t.Fatalf("got regions %+v", regions)
+	}
+
+	r := regions[0]
+	if !r.HasFeature("ipv6") || r.HasFeature("storage") {
+		t.Errorf("unexpected features of %+v", r)
+	}
+	if !r.HasSize("s-1vcpu-1gb") || r.HasSize("s-2vcpu-2gb") {
+		t.Errorf("unexpected sizes of %+v", r)
+	}
+}
+
+func TestRegion_HasSize_unavailable(t *testing.T) {
+	r := Region{Slug: "nyc1", Sizes: []string{"s-1vcpu-1gb"}}
+	if r.HasSize("s-1vcpu-1gb") {
+		t.Error("expected no sizes in an unavailable region")
+	}
+}
@@ -0,0 +1,523 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	registryPath = "v2/registry"
+	// RegistryServer is the hostname of the DigitalOcean registry service
+	RegistryServer = "registry.digitalocean.com"
+)
+
+// Registry represents a registry.
+type Registry struct {
+	Name                       string    `json:"name,omitempty"`
+	StorageUsageBytes          uint64    `json:"storage_usage_bytes,omitempty"`
+	StorageUsageBytesUpdatedAt time.Time `json:"storage_usage_bytes_updated_at,omitempty"`
+	CreatedAt                  time.Time `json:"created_at,omitempty"`
+	Region                     string    `json:"region,omitempty"`
+}
+
+// RegistryCreateRequest represents a request to create a registry.
+type RegistryCreateRequest struct {
+	Name                 string `json:"name,omitempty"`
+	SubscriptionTierSlug string `json:"subscription_tier_slug,omitempty"`
+	Region               string `json:"region,omitempty"`
+}
+
+// RegistryDockerCredentialsRequest represents a request to retrieve docker
+// credentials for a registry. Credentials are read-only unless ReadWrite is
+// set, and do not expire unless ExpirySeconds is given.
+type RegistryDockerCredentialsRequest struct {
+	ReadWrite     bool `url:"read_write"`
+	ExpirySeconds *int `url:"expiry_seconds,omitempty"`
+}
+
+// DockerCredentials is the content of a Docker config file
+// that is used by the docker CLI
+// See: https://docs.docker.com/engine/reference/commandline/cli/#configjson-properties
+type DockerCredentials struct {
+	DockerConfigJSON []byte
+}
+
+// RegistrySubscriptionTier is a subscription tier for container registry.
+type RegistrySubscriptionTier struct {
+	Name                   string `json:"name"`
+	Slug                   string `json:"slug"`
+	IncludedRepositories   uint64 `json:"included_repositories"`
+	IncludedStorageBytes   uint64 `json:"included_storage_bytes"`
+	AllowStorageOverage    bool   `json:"allow_storage_overage"`
+	IncludedBandwidthBytes uint64 `json:"included_bandwidth_bytes"`
+	MonthlyPriceInCents    uint64 `json:"monthly_price_in_cents"`
+	StorageOveragePrice    uint64 `json:"storage_overage_price_in_cents"`
+}
+
+// RegistrySubscription is a user's subscription.
+type RegistrySubscription struct {
+	Tier      *RegistrySubscriptionTier `json:"tier"`
+	CreatedAt time.Time                 `json:"created_at"`
+	UpdatedAt time.Time                 `json:"updated_at"`
+}
+
+// RegistrySubscriptionUpdateRequest represents a request to update the
+// subscription plan for a registry.
+type RegistrySubscriptionUpdateRequest struct {
+	TierSlug string `json:"tier_slug"`
+}
+
+// GarbageCollectionType specifies what kinds of images a garbage collection
+// removes.
+type GarbageCollectionType string
+
+// Types of garbage collections.
+const (
+	// GCTypeUntaggedManifestsOnly indicates that a garbage collection should
+	// only delete untagged manifests.
+	GCTypeUntaggedManifestsOnly = GarbageCollectionType("untagged manifests only")
+	// GCTypeUnreferencedBlobsOnly indicates that a garbage collection should
+	// only delete unreferenced blobs.
+	GCTypeUnreferencedBlobsOnly = GarbageCollectionType("unreferenced blobs only")
+	// GCTypeUntaggedManifestsAndUnreferencedBlobs indicates that a garbage
+	// collection should delete both untagged manifests and unreferenced blobs.
+	GCTypeUntaggedManifestsAndUnreferencedBlobs = GarbageCollectionType("untagged manifests and unreferenced blobs")
+)
+
+// GarbageCollection represents a garbage collection.
+type GarbageCollection struct {
+	UUID         string    `json:"uuid"`
+	RegistryName string    `json:"registry_name"`
+	Status       string    `json:"status"`
+	Type         string    `json:"type"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	BlobsDeleted uint64    `json:"blobs_deleted"`
+	FreedBytes   uint64    `json:"freed_bytes"`
+}
+
+// StartGarbageCollectionRequest represents options to a garbage collection
+// start request.
+type StartGarbageCollectionRequest struct {
+	Type GarbageCollectionType `json:"type"`
+}
+
+// UpdateGarbageCollectionRequest represents a request to update a garbage
+// collection.
+type UpdateGarbageCollectionRequest struct {
+	Cancel bool `json:"cancel"`
+}
+
+// RepositoryV2 represents a repository in the V2 format.
+type RepositoryV2 struct {
+	RegistryName   string              `json:"registry_name,omitempty"`
+	Name           string              `json:"name,omitempty"`
+	TagCount       uint64              `json:"tag_count,omitempty"`
+	ManifestCount  uint64              `json:"manifest_count,omitempty"`
+	LatestManifest *RepositoryManifest `json:"latest_manifest,omitempty"`
+}
+
+// RepositoryTag represents a repository tag.
+type RepositoryTag struct {
+	RegistryName        string    `json:"registry_name,omitempty"`
+	Repository          string    `json:"repository,omitempty"`
+	Tag                 string    `json:"tag,omitempty"`
+	ManifestDigest      string    `json:"manifest_digest,omitempty"`
+	CompressedSizeBytes uint64    `json:"compressed_size_bytes,omitempty"`
+	SizeBytes           uint64    `json:"size_bytes,omitempty"`
+	UpdatedAt           time.Time `json:"updated_at,omitempty"`
+}
+
+// RepositoryManifest represents a repository manifest.
+type RepositoryManifest struct {
+	RegistryName        string    `json:"registry_name,omitempty"`
+	Repository          string    `json:"repository,omitempty"`
+	Digest              string    `json:"digest,omitempty"`
+	CompressedSizeBytes uint64    `json:"compressed_size_bytes,omitempty"`
+	SizeBytes           uint64    `json:"size_bytes,omitempty"`
+	UpdatedAt           time.Time `json:"updated_at,omitempty"`
+	Tags                []string  `json:"tags,omitempty"`
+}
+
+// TokenListOptions specifies the optional parameters to endpoints which are
+// paginated with a token, like ListRepositoriesV2. Token is taken from the
+// next page link of the previous response.
+type TokenListOptions struct {
+	// For paginated result sets, page of results to retrieve.
+	Page int `url:"page,omitempty"`
+
+	// For paginated result sets, the number of results to include per page.
+	PerPage int `url:"per_page,omitempty"`
+
+	// For paginated result sets which support tokens, the token provided by the last set
+	// of results in order to retrieve the next set of results. This is expected to be faster
+	// than incrementing or decrementing the page number.
+	Token string `url:"page_token,omitempty"`
+}
+
+/* SERVICE */
+
+// RegistryService is an interface for interfacing with the Registry endpoints
+// of the DigitalOcean API.
+// See: https://docs.digitalocean.com/reference/api/api-reference/#tag/Container-Registry
+type RegistryService interface {
+	Get(context.Context) (*Registry, *Response, error)
+	Create(context.Context, *RegistryCreateRequest) (*Registry, *Response, error)
+	Delete(context.Context) (*Response, error)
+	DockerCredentials(context.Context, *RegistryDockerCredentialsRequest) (*DockerCredentials, *Response, error)
+	GetSubscription(context.Context) (*RegistrySubscription, *Response, error)
+	UpdateSubscription(context.Context, *RegistrySubscriptionUpdateRequest) (*RegistrySubscription, *Response, error)
+	StartGarbageCollection(context.Context, string, *StartGarbageCollectionRequest) (*GarbageCollection, *Response, error)
+	GetGarbageCollection(context.Context, string) (*GarbageCollection, *Response, error)
+	ListGarbageCollections(context.Context, string, *ListOptions) ([]*GarbageCollection, *Response, error)
+	UpdateGarbageCollection(context.Context, string, string, *UpdateGarbageCollectionRequest) (*GarbageCollection, *Response, error)
+
+	ListRepositoriesV2(context.Context, string, *TokenListOptions) ([]*RepositoryV2, *Response, error)
+	ListRepositoryTags(context.Context, string, string, *ListOptions) ([]*RepositoryTag, *Response, error)
+	DeleteTag(context.Context, string, string, string) (*Response, error)
+	ListRepositoryManifests(context.Context, string, string, *ListOptions) ([]*RepositoryManifest, *Response, error)
+	DeleteManifest(context.Context, string, string, string) (*Response, error)
+}
+
+// RegistryServiceOp handles communication with Registry methods of the DigitalOcean API.
+type RegistryServiceOp struct {
+	client *Client
+}
+
+var _ RegistryService = &RegistryServiceOp{}
+
+// Get retrieves the details of a Registry.
+func (s *RegistryServiceOp) Get(ctx context.Context) (*Registry, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, registryPath, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doRegistry(ctx, req)
+}
+
+// Create creates a registry.
+func (s *RegistryServiceOp) Create(ctx context.Context, create *RegistryCreateRequest) (*Registry, *Response, error) {
+	if create == nil {
+		return nil, nil, &ValidationError{Field: "create", Reason: "cannot be nil"}
+	}
+	if create.Name == "" {
+		return nil, nil, &ValidationError{Field: "name", Reason: "must not be empty"}
+	}
+	if create.SubscriptionTierSlug == "" {
+		return nil, nil, &ValidationError{Field: "subscription_tier_slug", Reason: "must not be empty"}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, registryPath, create)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doRegistry(ctx, req)
+}
+
+// Delete deletes a registry. There is no way to recover a registry once it has
+// been destroyed.
+func (s *RegistryServiceOp) Delete(ctx context.Context) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, registryPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// DockerCredentials retrieves a Docker config file containing the registry's
+// credentials.
+func (s *RegistryServiceOp) DockerCredentials(ctx context.Context, request *RegistryDockerCredentialsRequest) (*DockerCredentials, *Response, error) {
+	if request != nil && request.ExpirySeconds != nil && *request.ExpirySeconds <= 0 {
+		return nil, nil, &ValidationError{Field: "expiry_seconds", Value: fmt.Sprint(*request.ExpirySeconds), Reason: "must be positive"}
+	}
+
+	path, err := addOptions(registryPath+"/docker-credentials", request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configBytes := new(bytes.Buffer)
+	resp, err := s.client.Do(ctx, req, configBytes)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &DockerCredentials{DockerConfigJSON: configBytes.Bytes()}, resp, nil
+}
+
+// GetSubscription retrieves the user's subscription.
+func (s *RegistryServiceOp) GetSubscription(ctx context.Context) (*RegistrySubscription, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, registryPath+"/subscription", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return DoEnvelope[RegistrySubscription](ctx, s.client, req, "subscription")
+}
+
+// UpdateSubscription updates the user's registry subscription to another tier.
+func (s *RegistryServiceOp) UpdateSubscription(ctx context.Context, request *RegistrySubscriptionUpdateRequest) (*RegistrySubscription, *Response, error) {
+	if request == nil || request.TierSlug == "" {
+		return nil, nil, &ValidationError{Field: "tier_slug", Reason: "must not be empty"}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, registryPath+"/subscription", request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return DoEnvelope[RegistrySubscription](ctx, s.client, req, "subscription")
+}
+
+// StartGarbageCollection requests a garbage collection for the specified
+// registry. The registry is read-only while garbage collection is running.
+func (s *RegistryServiceOp) StartGarbageCollection(ctx context.Context, registry string, request *StartGarbageCollectionRequest) (*GarbageCollection, *Response, error) {
+	path, err := registrySubPath(registry, "garbage-collection")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var body interface{}
+	if request != nil {
+		body = request
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doGarbageCollection(ctx, req)
+}
+
+// GetGarbageCollection retrieves the currently-active garbage collection for
+// the specified registry; if there are no active garbage collections, then
+// return a 404/NotFound error.
+func (s *RegistryServiceOp) GetGarbageCollection(ctx context.Context, registry string) (*GarbageCollection, *Response, error) {
+	path, err := registrySubPath(registry, "garbage-collection")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doGarbageCollection(ctx, req)
+}
+
+// ListGarbageCollections retrieves all garbage collections (active and
+// inactive) for the specified registry.
+func (s *RegistryServiceOp) ListGarbageCollections(ctx context.Context, registry string, opt *ListOptions) ([]*GarbageCollection, *Response, error) {
+	path, err := registrySubPath(registry, "garbage-collections")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	path, err = addOptions(path, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gcs, resp, err := DoEnvelope[[]*GarbageCollection](ctx, s.client, req, "garbage_collections")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *gcs, resp, err
+}
+
+// UpdateGarbageCollection updates the specified garbage collection for the
+// specified registry. While only the currently-active garbage collection can
+// be updated we still require the exact garbage collection to be specified to
+// avoid race conditions that might may arise from issuing an update to the
+// implicit "currently-active" garbage collection. Returns the updated garbage
+// collection.
+func (s *RegistryServiceOp) UpdateGarbageCollection(ctx context.Context, registry, gcUUID string, request *UpdateGarbageCollectionRequest) (*GarbageCollection, *Response, error) {
+	if gcUUID == "" {
+		return nil, nil, &ValidationError{Field: "gcUUID", Reason: "must not be empty"}
+	}
+	if request == nil {
+		return nil, nil, &ValidationError{Field: "request", Reason: "cannot be nil"}
+	}
+
+	path, err := registrySubPath(registry, "garbage-collection/"+gcUUID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doGarbageCollection(ctx, req)
+}
+
+// ListRepositoriesV2 returns a list of the Repositories in a registry.
+func (s *RegistryServiceOp) ListRepositoriesV2(ctx context.Context, registry string, opts *TokenListOptions) ([]*RepositoryV2, *Response, error) {
+	path, err := registrySubPath(registry, "repositoriesV2")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	path, err = addOptions(path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	repositories, resp, err := DoEnvelope[[]*RepositoryV2](ctx, s.client, req, "repositories")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *repositories, resp, err
+}
+
+// ListRepositoryTags returns a list of the RepositoryTags available within the given repository.
+func (s *RegistryServiceOp) ListRepositoryTags(ctx context.Context, registry, repository string, opts *ListOptions) ([]*RepositoryTag, *Response, error) {
+	path, err := repositorySubPath(registry, repository, "tags")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	path, err = addOptions(path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tags, resp, err := DoEnvelope[[]*RepositoryTag](ctx, s.client, req, "tags")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *tags, resp, err
+}
+
+// DeleteTag deletes a tag within a given repository. The manifest it
+// referenced is kept until deleted with DeleteManifest or garbage collected.
+func (s *RegistryServiceOp) DeleteTag(ctx context.Context, registry, repository, tag string) (*Response, error) {
+	if tag == "" {
+		return nil, &ValidationError{Field: "tag", Reason: "must not be empty"}
+	}
+
+	path, err := repositorySubPath(registry, repository, "tags/"+tag)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListRepositoryManifests returns a list of the RepositoryManifests available within the given repository.
+func (s *RegistryServiceOp) ListRepositoryManifests(ctx context.Context, registry, repository string, opts *ListOptions) ([]*RepositoryManifest, *Response, error) {
+	path, err := repositorySubPath(registry, repository, "digests")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	path, err = addOptions(path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	manifests, resp, err := DoEnvelope[[]*RepositoryManifest](ctx, s.client, req, "manifests")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *manifests, resp, err
+}
+
+// DeleteManifest deletes a manifest by its digest within a given repository,
+// along with all tags referencing it.
+func (s *RegistryServiceOp) DeleteManifest(ctx context.Context, registry, repository, digest string) (*Response, error) {
+	if digest == "" {
+		return nil, &ValidationError{Field: "digest", Reason: "must not be empty"}
+	}
+
+	path, err := repositorySubPath(registry, repository, "digests/"+digest)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *RegistryServiceOp) doRegistry(ctx context.Context, req *http.Request) (*Registry, *Response, error) {
+	registry, resp, err := DoEnvelope[Registry](ctx, s.client, req, "registry")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return registry, resp, err
+}
+
+func (s *RegistryServiceOp) doGarbageCollection(ctx context.Context, req *http.Request) (*GarbageCollection, *Response, error) {
+	gc, resp, err := DoEnvelope[GarbageCollection](ctx, s.client, req, "garbage_collection")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return gc, resp, err
+}
+
+// registrySubPath returns the path of a resource below a registry.
+func registrySubPath(registry, resource string) (string, error) {
+	if registry == "" {
+		return "", &ValidationError{Field: "registry", Reason: "must not be empty"}
+	}
+	return fmt.Sprintf("%s/%s/%s", registryPath, registry, resource), nil
+}
+
+// repositorySubPath returns the path of a resource below a repository. The
+// repository name is escaped as it may contain slashes.
+func repositorySubPath(registry, repository, resource string) (string, error) {
+	if repository == "" {
+		return "", &ValidationError{Field: "repository", Reason: "must not be empty"}
+	}
+	return registrySubPath(registry, fmt.Sprintf("repositories/%s/%s", url.PathEscape(repository), resource))
+}
@@ -0,0 +1,294 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRegistry_CreateGetDelete(t *testing.T) {
+	c, mux := setup(t)
+
+	var methods []string
+	mux.HandleFunc("/v2/registry", func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		switch r.Method {
+		case http.MethodPost:
+			var req RegistryCreateRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("decoding request body: %v", err)
+			}
+			if req.Name != "example" || req.SubscriptionTierSlug != "basic" {
+				t.Errorf("got request %+v", req)
+			}
+			fmt.Fprint(w, `{"registry":{"name":"example","region":"fra1"}}`)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			fmt.Fprint(w, `{"registry":{"name":"example","storage_usage_bytes":1024}}`)
+		}
+	})
+
+	ctx := context.Background()
+	registry, _, err := c.Registry.Create(ctx, &RegistryCreateRequest{Name: "example", SubscriptionTierSlug: "basic", Region: "fra1"})
+	if err != nil {
+		t.Fatalf("Registry.Create returned error: %v", err)
+	}
+	if registry.Region != "fra1" {
+		t.Errorf("got registry %+v", registry)
+	}
+	if registry, _, err = c.Registry.Get(ctx); err != nil || registry.StorageUsageBytes != 1024 {
+		t.Fatalf("Registry.Get returned %+v, %v", registry, err)
+	}
+	if _, err := c.Registry.Delete(ctx); err != nil {
+		t.Fatalf("Registry.Delete returned error: %v", err)
+	}
+
+	if fmt.Sprint(methods) != "[POST GET DELETE]" {
+		t.Errorf("got methods %v", methods)
+	}
+}
+
+func TestRegistry_DockerCredentials(t *testing.T) {
+	c, mux := setup(t)
+
+	config := `{"auths":{"registry.digitalocean.com":{"auth":"YWJjZA=="}}}`
+	mux.HandleFunc("/v2/registry/docker-credentials", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		q := r.URL.Query()
+		if q.Get("read_write") != "true" || q.Get("expiry_seconds") != "3600" {
+			t.Errorf("got query %q", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, config)
+	})
+
+	expiry := 3600
+	creds, _, err := c.Registry.DockerCredentials(context.Background(), &RegistryDockerCredentialsRequest{ReadWrite: true, ExpirySeconds: &expiry})
+	if err != nil {
+		t.Fatalf("Registry.DockerCredentials returned error: %v", err)
+	}
+	if string(creds.DockerConfigJSON) != config {
+		t.Errorf("got config %q", creds.DockerConfigJSON)
+	}
+}
+
+func TestRegistry_subscription(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/registry/subscription", func(w http.ResponseWriter, r *http.Request) {
+		slug := "basic"
+		if r.Method == http.MethodPost {
+			var req RegistrySubscriptionUpdateRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("decoding request body: %v", err)
+			}
+			slug = req.TierSlug
+		}
+		fmt.Fprintf(w, `{"subscription":{"tier":{"slug":%q,"included_repositories":5}}}`, slug)
+	})
+
+	sub, _, err := c.Registry.GetSubscription(context.Background())
+	if err != nil {
+		t.Fatalf("Registry.GetSubscription returned error: %v", err)
+	}
+	if sub.Tier.Slug != "basic" || sub.Tier.IncludedRepositories != 5 {
+		t.Errorf("got subscription %+v", sub.Tier)
+	}
+
+	sub, _, err = c.Registry.UpdateSubscription(context.Background(), &RegistrySubscriptionUpdateRequest{TierSlug: "professional"})
+	if err != nil {
+		t.Fatalf("Registry.UpdateSubscription returned error: %v", err)
+	}
+	if sub.Tier.Slug != "professional" {
+		t.Errorf("got subscription %+v", sub.Tier)
+	}
+}
+
+func TestRegistry_garbageCollection(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+
+	mux.HandleFunc("/v2/registry/example/garbage-collection", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			var req StartGarbageCollectionRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("decoding request body: %v", err)
+			}
+			if req.Type != GCTypeUnreferencedBlobsOnly {
+				t.Errorf("got request %+v", req)
+			}
+		}
+		fmt.Fprint(w, `{"garbage_collection":{"uuid":"eff0feee","registry_name":"example","status":"requested"}}`)
+	})
+	mux.HandleFunc("/v2/registry/example/garbage-collection/eff0feee", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		var req UpdateGarbageCollectionRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if !req.Cancel {
+			t.Errorf("got request %+v", req)
+		}
+		fmt.Fprint(w, `{"garbage_collection":{"uuid":"eff0feee","status":"cancelled"}}`)
+	})
+	mux.HandleFunc("/v2/registry/example/garbage-collections", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"garbage_collections":[{"uuid":"eff0feee","blobs_deleted":42,"freed_bytes":667}]}`)
+	})
+
+	gc, _, err := c.Registry.StartGarbageCollection(ctx, "example", &StartGarbageCollectionRequest{Type: GCTypeUnreferencedBlobsOnly})
+	if err != nil {
+		t.Fatalf("Registry.StartGarbageCollection returned error: %v", err)
+	}
+	if gc.UUID != "eff0feee" || gc.Status != "requested" {
+		t.Errorf("got garbage collection %+v", gc)
+	}
+	if _, _, err := c.Registry.GetGarbageCollection(ctx, "example"); err != nil {
+		t.Fatalf("Registry.GetGarbageCollection returned error: %v", err)
+	}
+
+	gcs, _, err := c.Registry.ListGarbageCollections(ctx, "example", nil)
+	if err != nil {
+		t.Fatalf("Registry.ListGarbageCollections returned error: %v", err)
+	}
+	if len(gcs) != 1 || gcs[0].BlobsDeleted != 42 {
+		t.Errorf("got garbage collections %+v", gcs)
+	}
+
+	if gc, _, err = c.Registry.UpdateGarbageCollection(ctx, "example", "eff0feee", &UpdateGarbageCollectionRequest{Cancel: true}); err != nil || gc.Status != "cancelled" {
+		t.Errorf("Registry.UpdateGarbageCollection returned %+v, %v", gc, err)
+	}
+}
+
+func TestRegistry_validation(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+	r := c.Registry
+	zero := 0
+
+	calls := map[string]func() error{
+		"create nil": func() error { _, _, err := r.Create(ctx, nil); return err },
+		"create no name": func() error {
+			_, _, err := r.Create(ctx, &RegistryCreateRequest{SubscriptionTierSlug: "basic"})
+			return err
+		},
+		"create no tier": func() error { _, _, err := r.Create(ctx, &RegistryCreateRequest{Name: "example"}); return err },
+		"credentials expiry": func() error {
+			_, _, err := r.DockerCredentials(ctx, &RegistryDockerCredentialsRequest{ExpirySeconds: &zero})
+			return err
+		},
+		"subscription nil":    func() error { _, _, err := r.UpdateSubscription(ctx, nil); return err },
+		"gc no registry":      func() error { _, _, err := r.StartGarbageCollection(ctx, "", nil); return err },
+		"get gc no registry":  func() error { _, _, err := r.GetGarbageCollection(ctx, ""); return err },
+		"list gc no registry": func() error { _, _, err := r.ListGarbageCollections(ctx, "", nil); return err },
+		"update gc no uuid": func() error {
+			_, _, err := r.UpdateGarbageCollection(ctx, "example", "", &UpdateGarbageCollectionRequest{})
+			return err
+		},
+		"update gc nil": func() error { _, _, err := r.UpdateGarbageCollection(ctx, "example", "eff0feee", nil); return err },
+	}
+	for name, call := range calls {
+		var verr *ValidationError
+		if err := call(); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
+
+func TestRegistry_ListRepositoriesV2(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/registry/example/repositoriesV2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("page_token") != "abc" || r.URL.Query().Get("per_page") != "1" {
+			t.Errorf("got query %q", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"repositories":[{"name":"team/app","tag_count":2,"latest_manifest":{"digest":"sha256:cb8a924a","tags":["latest","v1"]}}],"links":{"pages":{"next":"https://api.digitalocean.com/v2/registry/example/repositoriesV2?page_token=def&per_page=1"}}}`)
+	})
+
+	repositories, resp, err := c.Registry.ListRepositoriesV2(context.Background(), "example", &TokenListOptions{PerPage: 1, Token: "abc"})
+	if err != nil {
+		t.Fatalf("Registry.ListRepositoriesV2 returned error: %v", err)
+	}
+	if len(repositories) != 1 || repositories[0].TagCount != 2 || repositories[0].LatestManifest.Digest != "sha256:cb8a924a" {
+		t.Errorf("got repositories %+v", repositories)
+	}
+	if token, err := resp.Links.NextPageToken(); err != nil || token != "def" {
+		t.Errorf("expected next page token def, got %q, %v", token, err)
+	}
+}
+
+func TestRegistry_repository(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+
+	var got []string
+	mux.HandleFunc("/v2/registry/example/repositories/", func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Method+" "+r.URL.EscapedPath())
+		switch {
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		case strings.HasSuffix(r.URL.Path, "/tags"):
+			fmt.Fprint(w, `{"tags":[{"tag":"latest","manifest_digest":"sha256:cb8a924a","size_bytes":2048}]}`)
+		default:
+			fmt.Fprint(w, `{"manifests":[{"digest":"sha256:cb8a924a","tags":["latest"]}]}`)
+		}
+	})
+
+	tags, _, err := c.Registry.ListRepositoryTags(ctx, "example", "team/app", nil)
+	if err != nil {
+		t.Fatalf("Registry.ListRepositoryTags returned error: %v", err)
+	}
+	if len(tags) != 1 || tags[0].ManifestDigest != "sha256:cb8a924a" || tags[0].SizeBytes != 2048 {
+		t.Errorf("got tags %+v", tags)
+	}
+
+	manifests, _, err := c.Registry.ListRepositoryManifests(ctx, "example", "team/app", nil)
+	if err != nil {
+		t.Fatalf("Registry.ListRepositoryManifests returned error: %v", err)
+	}
+	if len(manifests) != 1 || fmt.Sprint(manifests[0].Tags) != "[latest]" {
+		t.Errorf("got manifests %+v", manifests)
+	}
+
+	if _, err := c.Registry.DeleteTag(ctx, "example", "team/app", "latest"); err != nil {
+		t.Fatalf("Registry.DeleteTag returned error: %v", err)
+	}
+	if _, err := c.Registry.DeleteManifest(ctx, "example", "team/app", "sha256:cb8a924a"); err != nil {
+		t.Fatalf("Registry.DeleteManifest returned error: %v", err)
+	}
+
+	want := fmt.Sprint([]string{
+		"GET /v2/registry/example/repositories/team%2Fapp/tags",
+		"GET /v2/registry/example/repositories/team%2Fapp/digests",
+		"DELETE /v2/registry/example/repositories/team%2Fapp/tags/latest",
+		"DELETE /v2/registry/example/repositories/team%2Fapp/digests/sha256:cb8a924a",
+	})
+	if fmt.Sprint(got) != want {
+		t.Errorf("expected %s, got %v", want, got)
+	}
+}
+
+func TestRegistry_repository_validation(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+	r := c.Registry
+
+	calls := map[string]func() error{
+		"repositories no registry": func() error { _, _, err := r.ListRepositoriesV2(ctx, "", nil); return err },
+		"tags no repository":       func() error { _, _, err := r.ListRepositoryTags(ctx, "example", "", nil); return err },
+		"delete empty tag":         func() error { _, err := r.DeleteTag(ctx, "example", "team/app", ""); return err },
+		"manifests no registry":    func() error { _, _, err := r.ListRepositoryManifests(ctx, "", "team/app", nil); return err },
+		"delete empty digest":      func() error { _, err := r.DeleteManifest(ctx, "example", "team/app", ""); return err },
+	}
+	for name, call := range calls {
+		var verr *ValidationError
+		if err := call(); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
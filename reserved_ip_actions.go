@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ReservedIPActionsService is an interface for interfacing with the
+// reserved IPs actions endpoints of the Digital Ocean API.
+type ReservedIPActionsService interface {
+	Assign(ctx context.Context, ip string, dropletID int) (*Action, *Response, error)
+	Unassign(ctx context.Context, ip string) (*Action, *Response, error)
+	Get(ctx context.Context, ip string, actionID int) (*Action, *Response, error)
+	List(ctx context.Context, ip string, opt *ListOptions) ([]Action, *Response, error)
+	ListAll(ctx context.Context, ip string, opt *ListAllOptions) ([]Action, *Response, error)
+}
+
+// ReservedIPActionsServiceOp handles communication with the reserved IPs
+// action related methods of the DigitalOcean API.
+type ReservedIPActionsServiceOp struct {
+	client *Client
+}
+
+var _ ReservedIPActionsService = &ReservedIPActionsServiceOp{}
+
+// Assign a reserved IP to a droplet.
+func (s *ReservedIPActionsServiceOp) Assign(ctx context.Context, ip string, dropletID int) (*Action, *Response, error) {
+	if dropletID < 1 {
+		return nil, nil, &ValidationError{Field: "dropletID", Reason: "must be positive"}
+	}
+
+	return s.doAction(ctx, ip, ActionRequest{"type": "assign", "droplet_id": dropletID})
+}
+
+// Unassign a reserved IP from the droplet it is currently assigned to.
+func (s *ReservedIPActionsServiceOp) Unassign(ctx context.Context, ip string) (*Action, *Response, error) {
+	return s.doAction(ctx, ip, ActionRequest{"type": "unassign"})
+}
+
+// Get an action for a particular reserved IP by id.
+func (s *ReservedIPActionsServiceOp) Get(ctx context.Context, ip string, actionID int) (*Action, *Response, error) {
+	if err := validateIP(ip); err != nil {
+		return nil, nil, err
+	}
+	if actionID < 1 {
+		return nil, nil, &ValidationError{Field: "actionID", Reason: "must be positive"}
+	}
+
+	path := fmt.Sprintf("%s/%d", reservedIPActionPath(ip), actionID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	action, resp, err := DoEnvelope[Action](ctx, s.client, req, "action")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return action, resp, err
+}
+
+// List the actions for a particular reserved IP.
+func (s *ReservedIPActionsServiceOp) List(ctx context.Context, ip string, opt *ListOptions) ([]Action, *Response, error) {
+	if err := validateIP(ip); err != nil {
+		return nil, nil, err
+	}
+
+	path, err := addOptions(reservedIPActionPath(ip), opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	actions, resp, err := DoEnvelope[[]Action](ctx, s.client, req, "actions")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *actions, resp, err
+}
+
+// ListAll the actions of a reserved IP across all pages
+func (s *ReservedIPActionsServiceOp) ListAll(ctx context.Context, ip string, opt *ListAllOptions) ([]Action, *Response, error) {
+	return ListAll[Action](ctx, func(ctx context.Context, opt *ListOptions) ([]Action, *Response, error) {
+		return s.List(ctx, ip, opt)
+	}, opt)
+}
+
+func (s *ReservedIPActionsServiceOp) doAction(ctx context.Context, ip string, request ActionRequest) (*Action, *Response, error) {
+	if err := validateIP(ip); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, reservedIPActionPath(ip), request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	action, resp, err := DoEnvelope[Action](ctx, s.client, req, "action")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return action, resp, err
+}
+
+func reservedIPActionPath(ip string) string {
+	return fmt.Sprintf("%s/%s/actions", reservedIPsBasePath, ip)
+}
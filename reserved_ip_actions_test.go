@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestReservedIPActions_AssignUnassign(t *testing.T) {
+	c, mux := setup(t)
+
+	var got []ActionRequest
+	mux.HandleFunc("/v2/reserved_ips/192.0.2.1/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var req ActionRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		got = append(got, req)
+		fmt.Fprintf(w, `{"action":{"id":2,"type":%q,"resource_type":"reserved_ip"}}`, req["type"])
+	})
+
+	ctx := context.Background()
+	action, _, err := c.ReservedIPActions.Assign(ctx, "192.0.2.1", 1)
+	if err != nil {
+		t.Fatalf("ReservedIPActions.Assign returned error: %v", err)
+	}
+	if action.Type != "assign" {
+		t.Errorf("got action %+v", action)
+	}
+	if _, _, err := c.ReservedIPActions.Unassign(ctx, "192.0.2.1"); err != nil {
+		t.Fatalf("ReservedIPActions.Unassign returned error: %v", err)
+	}
+
+	want := []ActionRequest{{"type": "assign", "droplet_id": 1.0}, {"type": "unassign"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected requests %v, got %v", want, got)
+	}
+}
+
+func TestReservedIPActions_GetList(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/reserved_ips/192.0.2.1/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"actions":[{"id":2,"type":"assign"}]}`)
+	})
+	mux.HandleFunc("/v2/reserved_ips/192.0.2.1/actions/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"action":{"id":2,"status":"completed"}}`)
+	})
+
+	actions, _, err := c.ReservedIPActions.ListAll(context.Background(), "192.0.2.1", nil)
+	if err != nil {
+		t.Fatalf("ReservedIPActions.ListAll returned error: %v", err)
+	}
+	if len(actions) != 1 || actions[0].Type != "assign" {
+		t.Errorf("got actions %+v", actions)
+	}
+
+	action, _, err := c.ReservedIPActions.Get(context.Background(), "192.0.2.1", 2)
+	if err != nil {
+		t.Fatalf("ReservedIPActions.Get returned error: %v", err)
+	}
+	if action.Status != ActionCompleted {
+		t.Errorf("got action %+v", action)
+	}
+}
+
+func TestReservedIPActions_validation(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+	a := c.ReservedIPActions
+
+	calls := map[string]func() error{
+		"assign droplet 0":   func() error { _, _, err := a.Assign(ctx, "192.0.2.1", 0); return err },
+		"assign not an IP":   func() error { _, _, err := a.Assign(ctx, "ip", 1); return err },
+		"unassign not an IP": func() error { _, _, err := a.Unassign(ctx, ""); return err },
+		"get action 0":       func() error { _, _, err := a.Get(ctx, "192.0.2.1", 0); return err },
+		"list not an IP":     func() error { _, _, err := a.List(ctx, "ip", nil); return err },
+	}
+	for name, call := range calls {
+		var verr *ValidationError
+		if err := call(); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+)
+
+const reservedIPsBasePath = "v2/reserved_ips"
+
+// ReservedIP represents a Digital Ocean reserved IP.
+type ReservedIP struct {
+	Region    *Region  `json:"region"`
+	Droplet   *Droplet `json:"droplet"`
+	IP        string   `json:"ip"`
+	ProjectID string   `json:"project_id"`
+	Locked    bool     `json:"locked"`
+}
+
+// URN returns the reserved IP in a valid DO API URN form.
+func (f ReservedIP) URN() string {
+	return Resource{ID: f.IP, Type: ReservedIPResourceType}.URN()
+}
+
+// ReservedIPCreateRequest represents a request to create a reserved IP.
+// Specify DropletID to assign the reserved IP to a Droplet or Region
+// to reserve it to the region.
+type ReservedIPCreateRequest struct {
+	Region    string `json:"region,omitempty"`
+	DropletID int    `json:"droplet_id,omitempty"`
+	ProjectID string `json:"project_id,omitempty"`
+}
+
+/* SERVICE */
+
+// ReservedIPsService is an interface for interfacing with the reserved IPs
+// endpoints of the Digital Ocean API.
+type ReservedIPsService interface {
+	List(context.Context, *ListOptions) ([]ReservedIP, *Response, error)
+	ListAll(context.Context, *ListAllOptions) ([]ReservedIP, *Response, error)
+	Get(context.Context, string) (*ReservedIP, *Response, error)
+	Create(context.Context, *ReservedIPCreateRequest) (*ReservedIP, *Response, error)
+	Delete(context.Context, string) (*Response, error)
+}
+
+// ReservedIPsServiceOp handles communication with the reserved IPs related methods of the
+// DigitalOcean API.
+type ReservedIPsServiceOp struct {
+	client *Client
+}
+
+var _ ReservedIPsService = &ReservedIPsServiceOp{}
+
+// List all reserved IPs.
+func (s *ReservedIPsServiceOp) List(ctx context.Context, opt *ListOptions) ([]ReservedIP, *Response, error) {
+	path, err := addOptions(reservedIPsBasePath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ips, resp, err := DoEnvelope[[]ReservedIP](ctx, s.client, req, "reserved_ips")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *ips, resp, err
+}
+
+// ListAll reserved IPs across all pages
+func (s *ReservedIPsServiceOp) ListAll(ctx context.Context, opt *ListAllOptions) ([]ReservedIP, *Response, error) {
+	return ListAll[ReservedIP](ctx, s.List, opt)
+}
+
+// Get an individual reserved IP.
+func (s *ReservedIPsServiceOp) Get(ctx context.Context, ip string) (*ReservedIP, *Response, error) {
+	if err := validateIP(ip); err != nil {
+		return nil, nil, err
+	}
+
+	path := fmt.Sprintf("%s/%s", reservedIPsBasePath, ip)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reservedIP, resp, err := DoEnvelope[ReservedIP](ctx, s.client, req, "reserved_ip")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return reservedIP, resp, err
+}
+
+// Create a reserved IP, assigned to a Droplet or reserved to a region. The
+// project it belongs to can be set with ProjectID.
+func (s *ReservedIPsServiceOp) Create(ctx context.Context, createRequest *ReservedIPCreateRequest) (*ReservedIP, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, &ValidationError{Field: "createRequest", Reason: "cannot be nil"}
+	}
+	if (createRequest.DropletID == 0) == (createRequest.Region == "") {
+		return nil, nil, &ValidationError{Field: "createRequest", Reason: "exactly one of droplet_id and region must be set"}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, reservedIPsBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reservedIP, resp, err := DoEnvelope[ReservedIP](ctx, s.client, req, "reserved_ip")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return reservedIP, resp, err
+}
+
+// Delete a reserved IP.
+func (s *ReservedIPsServiceOp) Delete(ctx context.Context, ip string) (*Response, error) {
+	if err := validateIP(ip); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("%s/%s", reservedIPsBasePath, ip)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func validateIP(ip string) error {
+	if net.ParseIP(ip) == nil {
+		return &ValidationError{Field: "ip", Value: ip, Reason: "is not an IP address"}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestReservedIPs_List(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/reserved_ips", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"reserved_ips":[{"ip":"192.0.2.1","region":{"slug":"nyc3"},"droplet":{"id":1},"locked":true}]}`)
+	})
+
+	ips, _, err := c.ReservedIPs.List(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("ReservedIPs.List returned error: %v", err)
+	}
+	if len(ips) != 1 || ips[0].Region.Slug != "nyc3" || ips[0].Droplet.ID != 1 || !ips[0].Locked {
+		t.Errorf("got reserved IPs %+v", ips)
+	}
+	if got := ips[0].URN(); got != "do:reservedip:192.0.2.1" {
+		t.Errorf("got URN %q", got)
+	}
+}
+
+func TestReservedIPs_GetCreateDelete(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+
+	mux.HandleFunc("/v2/reserved_ips", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var req ReservedIPCreateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if req.Region != "nyc3" || req.DropletID != 0 {
+			t.Errorf("got request %+v", req)
+		}
+		fmt.Fprint(w, `{"reserved_ip":{"ip":"192.0.2.1"}}`)
+	})
+	mux.HandleFunc("/v2/reserved_ips/192.0.2.1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"reserved_ip":{"ip":"192.0.2.1","project_id":"746c6152"}}`)
+	})
+
+	ip, _, err := c.ReservedIPs.Create(ctx, &ReservedIPCreateRequest{Region: "nyc3"})
+	if err != nil {
+		t.Fatalf("ReservedIPs.Create returned error: %v", err)
+	}
+	if ip.IP != "192.0.2.1" {
+		t.Errorf("got reserved IP %+v", ip)
+	}
+	if ip, _, err = c.ReservedIPs.Get(ctx, "192.0.2.1"); err != nil || ip.ProjectID != "746c6152" {
+		t.Errorf("ReservedIPs.Get returned %+v, %v", ip, err)
+	}
+	if _, err := c.ReservedIPs.Delete(ctx, "192.0.2.1"); err != nil {
+		t.Errorf("ReservedIPs.Delete returned error: %v", err)
+	}
+}
+
+func TestReservedIPs_validation(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+	s := c.ReservedIPs
+
+	calls := map[string]func() error{
+		"get not an IP":    func() error { _, _, err := s.Get(ctx, "example.com"); return err },
+		"delete not an IP": func() error { _, err := s.Delete(ctx, ""); return err },
+		"create nil":       func() error { _, _, err := s.Create(ctx, nil); return err },
+		"create neither":   func() error { _, _, err := s.Create(ctx, &ReservedIPCreateRequest{}); return err },
+		"create both": func() error {
+			_, _, err := s.Create(ctx, &ReservedIPCreateRequest{Region: "nyc3", DropletID: 1})
+			return err
+		},
+	}
+	for name, call := range calls {
+		var verr *ValidationError
+		if err := call(); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
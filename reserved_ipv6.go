@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"time"
+)
+
+const reservedIPV6sBasePath = "v2/reserved_ipv6"
+
+// ReservedIPV6 represents a Digital Ocean reserved IPv6 address.
+type ReservedIPV6 struct {
+	RegionSlug string     `json:"region_slug"`
+	IP         netip.Addr `json:"ip"`
+	ReservedAt time.Time  `json:"reserved_at"`
+	Droplet    *Droplet   `json:"droplet,omitempty"`
+}
+
+// ReservedIPV6CreateRequest represents a request to reserve an IPv6 address
+// in a region.
+type ReservedIPV6CreateRequest struct {
+	Region string `json:"region_slug"`
+}
+
+/* SERVICE */
+
+// ReservedIPV6sService is an interface for interfacing with the reserved IPv6
+// endpoints of the Digital Ocean API.
+type ReservedIPV6sService interface {
+	List(context.Context, *ListOptions) ([]ReservedIPV6, *Response, error)
+	ListAll(context.Context, *ListAllOptions) ([]ReservedIPV6, *Response, error)
+	Get(context.Context, string) (*ReservedIPV6, *Response, error)
+	Create(context.Context, *ReservedIPV6CreateRequest) (*ReservedIPV6, *Response, error)
+	Delete(context.Context, string) (*Response, error)
+}
+
+// ReservedIPV6sServiceOp handles communication with the reserved IPv6 related
+// methods of the DigitalOcean API.
+type ReservedIPV6sServiceOp struct {
+	client *Client
+}
+
+var _ ReservedIPV6sService = &ReservedIPV6sServiceOp{}
+
+// List all reserved IPv6 addresses.
+func (s *ReservedIPV6sServiceOp) List(ctx context.Context, opt *ListOptions) ([]ReservedIPV6, *Response, error) {
+	path, err := addOptions(reservedIPV6sBasePath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ips, resp, err := DoEnvelope[[]ReservedIPV6](ctx, s.client, req, "reserved_ipv6s")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *ips, resp, err
+}
+
+// ListAll reserved IPv6 addresses across all pages
+func (s *ReservedIPV6sServiceOp) ListAll(ctx context.Context, opt *ListAllOptions) ([]ReservedIPV6, *Response, error) {
+	return ListAll[ReservedIPV6](ctx, s.List, opt)
+}
+
+// Get an individual reserved IPv6 address.
+func (s *ReservedIPV6sServiceOp) Get(ctx context.Context, ip string) (*ReservedIPV6, *Response, error) {
+	if err := validateIPv6(ip); err != nil {
+		return nil, nil, err
+	}
+
+	path := fmt.Sprintf("%s/%s", reservedIPV6sBasePath, ip)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reservedIP, resp, err := DoEnvelope[ReservedIPV6](ctx, s.client, req, "reserved_ipv6")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return reservedIP, resp, err
+}
+
+// Create reserves an IPv6 address in a region. Assign it to a Droplet with
+// ReservedIPV6Actions.Assign.
+func (s *ReservedIPV6sServiceOp) Create(ctx context.Context, createRequest *ReservedIPV6CreateRequest) (*ReservedIPV6, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, &ValidationError{Field: "createRequest", Reason: "cannot be nil"}
+	}
+	if createRequest.Region == "" {
+		return nil, nil, &ValidationError{Field: "region_slug", Reason: "must not be empty"}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, reservedIPV6sBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reservedIP, resp, err := DoEnvelope[ReservedIPV6](ctx, s.client, req, "reserved_ipv6")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return reservedIP, resp, err
+}
+
+// Delete a reserved IPv6 address.
+func (s *ReservedIPV6sServiceOp) Delete(ctx context.Context, ip string) (*Response, error) {
+	if err := validateIPv6(ip); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("%s/%s", reservedIPV6sBasePath, ip)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func validateIPv6(ip string) error {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil || !addr.Is6() || addr.Is4In6() {
+		return &ValidationError{Field: "ip", Value: ip, Reason: "is not an IPv6 address"}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ReservedIPV6ActionsService is an interface for interfacing with the
+// reserved IPv6 actions endpoints of the Digital Ocean API.
+type ReservedIPV6ActionsService interface {
+	Assign(ctx context.Context, ip string, dropletID int) (*Action, *Response, error)
+	Unassign(ctx context.Context, ip string) (*Action, *Response, error)
+}
+
+// ReservedIPV6ActionsServiceOp handles communication with the reserved IPv6
+// action related methods of the DigitalOcean API.
+type ReservedIPV6ActionsServiceOp struct {
+	client *Client
+}
+
+var _ ReservedIPV6ActionsService = &ReservedIPV6ActionsServiceOp{}
+
+// Assign a reserved IPv6 address to a droplet in its region.
+func (s *ReservedIPV6ActionsServiceOp) Assign(ctx context.Context, ip string, dropletID int) (*Action, *Response, error) {
+	if dropletID < 1 {
+		return nil, nil, &ValidationError{Field: "dropletID", Reason: "must be positive"}
+	}
+
+	return s.doAction(ctx, ip, ActionRequest{"type": "assign", "droplet_id": dropletID})
+}
+
+// Unassign a reserved IPv6 address from the droplet it is currently assigned
+// to.
+func (s *ReservedIPV6ActionsServiceOp) Unassign(ctx context.Context, ip string) (*Action, *Response, error) {
+	return s.doAction(ctx, ip, ActionRequest{"type": "unassign"})
+}
+
+func (s *ReservedIPV6ActionsServiceOp) doAction(ctx context.Context, ip string, request ActionRequest) (*Action, *Response, error) {
+	if err := validateIPv6(ip); err != nil {
+		return nil, nil, err
+	}
+
+	path := fmt.Sprintf("%s/%s/actions", reservedIPV6sBasePath, ip)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	action, resp, err := DoEnvelope[Action](ctx, s.client, req, "action")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return action, resp, err
+}
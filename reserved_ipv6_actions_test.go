@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestReservedIPV6Actions_AssignUnassign(t *testing.T) {
+	c, mux := setup(t)
+
+	var got []ActionRequest
+	mux.HandleFunc("/v2/reserved_ipv6/2604:a880:800:14::42c3:d000/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var req ActionRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		got = append(got, req)
+		fmt.Fprintf(w, `{"action":{"id":2,"type":%q,"resource_type":"reserved_ipv6"}}`, req["type"])
+	})
+
+	ctx := context.Background()
+	action, _, err := c.ReservedIPV6Actions.Assign(ctx, "2604:a880:800:14::42c3:d000", 1)
+	if err != nil {
+		t.Fatalf("ReservedIPV6Actions.Assign returned error: %v", err)
+	}
+	if action.Type != "assign" {
+		t.Errorf("got action %+v", action)
+	}
+	if _, _, err := c.ReservedIPV6Actions.Unassign(ctx, "2604:a880:800:14::42c3:d000"); err != nil {
+		t.Fatalf("ReservedIPV6Actions.Unassign returned error: %v", err)
+	}
+
+	want := []ActionRequest{{"type": "assign", "droplet_id": 1.0}, {"type": "unassign"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected requests %v, got %v", want, got)
+	}
+}
+
+func TestReservedIPV6Actions_validation(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+	a := c.ReservedIPV6Actions
+
+	calls := map[string]func() error{
+		"assign droplet 0":  func() error { _, _, err := a.Assign(ctx, "2604:a880:800:14::42c3:d000", 0); return err },
+		"assign IPv4":       func() error { _, _, err := a.Assign(ctx, "192.0.2.1", 1); return err },
+		"unassign empty ip": func() error { _, _, err := a.Unassign(ctx, ""); return err },
+	}
+	for name, call := range calls {
+		var verr *ValidationError
+		if err := call(); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestReservedIPV6s_List(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/reserved_ipv6", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"reserved_ipv6s":[{"ip":"2604:a880:800:14::42c3:d000","region_slug":"nyc3","reserved_at":"2024-11-20T11:08:30Z","droplet":{"id":1}}]}`)
+	})
+
+	ips, _, err := c.ReservedIPV6s.List(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("ReservedIPV6s.List returned error: %v", err)
+	}
+	if len(ips) != 1 || ips[0].IP.String() != "2604:a880:800:14::42c3:d000" || ips[0].Droplet == nil || ips[0].Droplet.ID != 1 {
+		t.Errorf("got reserved IPv6s %+v", ips)
+	}
+}
+
+func TestReservedIPV6s_CreateGetDelete(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+
+	mux.HandleFunc("/v2/reserved_ipv6", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var req map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if req["region_slug"] != "nyc3" {
+			t.Errorf("got request %v", req)
+		}
+		fmt.Fprint(w, `{"reserved_ipv6":{"ip":"2604:a880:800:14::42c3:d000","region_slug":"nyc3"}}`)
+	})
+
+	var methods []string
+	mux.HandleFunc("/v2/reserved_ipv6/2604:a880:800:14::42c3:d000", func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		fmt.Fprint(w, `{"reserved_ipv6":{"ip":"2604:a880:800:14::42c3:d000","region_slug":"nyc3"}}`)
+	})
+
+	ip, _, err := c.ReservedIPV6s.Create(ctx, &ReservedIPV6CreateRequest{Region: "nyc3"})
+	if err != nil {
+		t.Fatalf("ReservedIPV6s.Create returned error: %v", err)
+	}
+	if !ip.IP.Is6() || ip.RegionSlug != "nyc3" {
+		t.Errorf("got reserved IPv6 %+v", ip)
+	}
+	if _, _, err := c.ReservedIPV6s.Get(ctx, "2604:a880:800:14::42c3:d000"); err != nil {
+		t.Fatalf("ReservedIPV6s.Get returned error: %v", err)
+	}
+	if _, err := c.ReservedIPV6s.Delete(ctx, "2604:a880:800:14::42c3:d000"); err != nil {
+		t.Fatalf("ReservedIPV6s.Delete returned error: %v", err)
+	}
+
+	if fmt.Sprint(methods) != "[GET DELETE]" {
+		t.Errorf("got methods %v", methods)
+	}
+}
+
+func TestReservedIPV6s_validation(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+	s := c.ReservedIPV6s
+
+	calls := map[string]func() error{
+		"get empty ip":     func() error { _, _, err := s.Get(ctx, ""); return err },
+		"get IPv4":         func() error { _, _, err := s.Get(ctx, "192.0.2.1"); return err },
+		"get IPv4 in IPv6": func() error { _, _, err := s.Get(ctx, "::ffff:192.0.2.1"); return err },
+		"delete invalid":   func() error { _, err := s.Delete(ctx, "2604:a880::zz"); return err },
+		"create nil":       func() error { _, _, err := s.Create(ctx, nil); return err },
+		"create no region": func() error { _, _, err := s.Create(ctx, &ReservedIPV6CreateRequest{}); return err },
+	}
+	for name, call := range calls {
+		var verr *ValidationError
+		if err := call(); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+const sizesBasePath = "v2/sizes"
+
+// Size represents a DigitalOcean Size
+type Size struct {
+	Slug         string   `json:"slug,omitempty"`
+	Memory       int      `json:"memory,omitempty"`
+	Vcpus        int      `json:"vcpus,omitempty"`
+	Disk         int      `json:"disk,omitempty"`
+	PriceMonthly float64  `json:"price_monthly,omitempty"`
+	PriceHourly  float64  `json:"price_hourly,omitempty"`
+	Regions      []string `json:"regions,omitempty"`
+	Available    bool     `json:"available,omitempty"`
+	Transfer     float64  `json:"transfer,omitempty"`
+	Description  string   `json:"description,omitempty"`
+	GPUInfo      *GPUInfo `json:"gpu_info,omitempty"`
+}
+
+// GPUInfo describes the GPUs of a GPU Droplet or size.
+type GPUInfo struct {
+	Count int    `json:"count,omitempty"`
+	VRAM  *VRAM  `json:"vram,omitempty"`
+	Model string `json:"model,omitempty"`
+}
+
+// VRAM is the video memory of each GPU, e.g. 80 "gib".
+type VRAM struct {
+	Amount int    `json:"amount,omitempty"`
+	Unit   string `json:"unit,omitempty"`
+}
+
+// HasGPU reports whether droplets of the size have GPUs.
+func (s Size) HasGPU() bool {
+	return s.GPUInfo != nil && s.GPUInfo.Count > 0
+}
+
+// AvailableIn reports whether droplets of the size can be created in the
+// region slug.
+func (s Size) AvailableIn(region string) bool {
+	return s.Available && containsString(s.Regions, region)
+}
+
+/* SERVICE */
+
+// SizesService is an interface for interfacing with the size
+// endpoints of the DigitalOcean API
+type SizesService interface {
+	List(context.Context, *ListOptions) ([]Size, *Response, error)
+	ListAll(context.Context, *ListAllOptions) ([]Size, *Response, error)
+	ListGPU(context.Context, *ListOptions) ([]Size, *Response, error)
+}
+
+// SizesServiceOp handles communication with the size related methods of the
+// DigitalOcean API.
+type SizesServiceOp struct {
+	client *Client
+}
+
+var _ SizesService = &SizesServiceOp{}
+
+// List all sizes
+func (s *SizesServiceOp) List(ctx context.Context, opt *ListOptions) ([]Size, *Response, error) {
+	path, err := addOptions(sizesBasePath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sizes, resp, err := DoEnvelope[[]Size](ctx, s.client, req, "sizes")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *sizes, resp, err
+}
+
+// ListAll sizes across all pages
+func (s *SizesServiceOp) ListAll(ctx context.Context, opt *ListAllOptions) ([]Size, *Response, error) {
+	return ListAll[Size](ctx, s.List, opt)
+}
+
+// ListGPU lists the GPU sizes. The API can not filter sizes, so the sizes of
+// each page are filtered and a page may hold fewer than PerPage sizes.
+func (s *SizesServiceOp) ListGPU(ctx context.Context, opt *ListOptions) ([]Size, *Response, error) {
+	sizes, resp, err := s.List(ctx, opt)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	gpuSizes := make([]Size, 0, len(sizes))
+	for _, size := range sizes {
+		if size.HasGPU() {
+			gpuSizes = append(gpuSizes, size)
+		}
+	}
+
+	return gpuSizes, resp, err
+}
+
+// GPURegions returns the slugs of the regions in which droplets of any of the
+// available GPU sizes can be created, in the order they are first found.
+func GPURegions(sizes []Size) []string {
+	var regions []string
+	for _, size := range sizes {
+		if !size.Available || !size.HasGPU() {
+			continue
+		}
+		for _, region := range size.Regions {
+			if !containsString(regions, region) {
+				regions = append(regions, region)
+			}
+		}
+	}
+	return regions
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestSizes_List(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/sizes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"sizes":[{"slug":"s-1vcpu-1gb","memory":1024,"vcpus":1,"price_monthly":6,"available":true,"regions":["nyc3"]}]}`)
+	})
+
+	sizes, _, err := c.Sizes.List(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Sizes.List returned error: %v", err)
+	}
+	if len(sizes) != 1 || sizes[0].Memory != 1024 || sizes[0].PriceMonthly != 6 {
+		t.Errorf("got sizes %+v", sizes)
+	}
+}
+
+func TestSize_AvailableIn(t *testing.T) {
+	tests := []struct {
+		size   Size
+		region string
+		want   bool
+	}{
+		{Size{Available: true, Regions: []string{"nyc3"}}, "nyc3", true},
+		{Size{Available: true, Regions: []string{"nyc3"}}, "ams3", false},
+		{Size{Regions: []string{"nyc3"}}, "nyc3", false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.size.AvailableIn(tt.region); got != tt.want {
+			t.Errorf("%+v.AvailableIn(%q) = %t, expected %t", tt.size, tt.region, got, tt.want)
+		}
+	}
+}
+
+func TestSizes_ListGPU(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/sizes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"sizes":[
+			{"slug":"s-1vcpu-1gb","available":true,"regions":["nyc3"]},
+			{"slug":"gpu-h100x1-80gb","available":true,"regions":["tor1","nyc2"],"gpu_info":{"count":1,"model":"nvidia_h100","vram":{"amount":80,"unit":"gib"}}}
+		]}`)
+	})
+
+	sizes, _, err := c.Sizes.ListGPU(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Sizes.ListGPU returned error: %v", err)
+	}
+	if len(sizes) != 1 || sizes[0].Slug != "gpu-h100x1-80gb" {
+		t.Fatalf("expected only the GPU size, got %+v", sizes)
+	}
+	if gpu := sizes[0].GPUInfo; gpu.Model != "nvidia_h100" || gpu.VRAM == nil || gpu.VRAM.Amount != 80 {
+		t.Errorf("got GPU info %+v", gpu)
+	}
+}
+
+func TestGPURegions(t *testing.T) {
+	gpu := &GPUInfo{Count: 8}
+	sizes := []Size{
+		{Slug: "s-1vcpu-1gb", Available: true, Regions: []string{"fra1"}},
+		{Slug: "gpu-h100x1-80gb", Available: true, Regions: []string{"tor1", "nyc2"}, GPUInfo: &GPUInfo{Count: 1}},
+		{Slug: "gpu-h100x8-640gb", Available: true, Regions: []string{"nyc2", "ams3"}, GPUInfo: gpu},
+		{Slug: "gpu-l40sx1-48gb", Available: false, Regions: []string{"sfo3"}, GPUInfo: gpu},
+		{Slug: "gpu-none", Available: true, Regions: []string{"lon1"}, GPUInfo: &GPUInfo{}},
+	}
+
+	if got := fmt.Sprint(GPURegions(sizes)); got != "[tor1 nyc2 ams3]" {
+		t.Errorf("expected [tor1 nyc2 ams3], got %s", got)
+	}
+	if got := GPURegions(nil); got != nil {
+		t.Errorf("expected no regions, got %v", got)
+	}
+}
@@ -0,0 +1,133 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const snapshotBasePath = "v2/snapshots"
+
+// Snapshot represents a DigitalOcean Snapshot
+type Snapshot struct {
+	ID            string   `json:"id,omitempty"`
+	Name          string   `json:"name,omitempty"`
+	ResourceID    string   `json:"resource_id,omitempty"`
+	ResourceType  string   `json:"resource_type,omitempty"`
+	Regions       []string `json:"regions,omitempty"`
+	MinDiskSize   int      `json:"min_disk_size,omitempty"`
+	SizeGigaBytes float64  `json:"size_gigabytes,omitempty"`
+	Created       string   `json:"created_at,omitempty"`
+	Tags          []string `json:"tags,omitempty"`
+}
+
+// snapshotListOptions adds the resource type filter to ListOptions.
+type snapshotListOptions struct {
+	ListOptions
+	ResourceType ResourceType `url:"resource_type,omitempty"`
+}
+
+/* SERVICE */
+
+// SnapshotsService is an interface for interfacing with the snapshots
+// endpoints of the DigitalOcean API
+type SnapshotsService interface {
+	List(context.Context, *ListOptions) ([]Snapshot, *Response, error)
+	ListAll(context.Context, *ListAllOptions) ([]Snapshot, *Response, error)
+	ListVolume(context.Context, *ListOptions) ([]Snapshot, *Response, error)
+	ListDroplet(context.Context, *ListOptions) ([]Snapshot, *Response, error)
+	ListByResourceType(context.Context, ResourceType, *ListOptions) ([]Snapshot, *Response, error)
+	Get(context.Context, string) (*Snapshot, *Response, error)
+	Delete(context.Context, string) (*Response, error)
+}
+
+// SnapshotsServiceOp handles communication with the snapshot related methods of the
+// DigitalOcean API.
+type SnapshotsServiceOp struct {
+	client *Client
+}
+
+var _ SnapshotsService = &SnapshotsServiceOp{}
+
+// List lists all the snapshots available.
+func (s *SnapshotsServiceOp) List(ctx context.Context, opt *ListOptions) ([]Snapshot, *Response, error) {
+	return s.ListByResourceType(ctx, "", opt)
+}
+
+// ListAll snapshots across all pages
+func (s *SnapshotsServiceOp) ListAll(ctx context.Context, opt *ListAllOptions) ([]Snapshot, *Response, error) {
+	return ListAll[Snapshot](ctx, s.List, opt)
+}
+
+// ListDroplet lists all the Droplet snapshots.
+func (s *SnapshotsServiceOp) ListDroplet(ctx context.Context, opt *ListOptions) ([]Snapshot, *Response, error) {
+	return s.ListByResourceType(ctx, DropletResourceType, opt)
+}
+
+// ListVolume lists all the volume snapshots.
+func (s *SnapshotsServiceOp) ListVolume(ctx context.Context, opt *ListOptions) ([]Snapshot, *Response, error) {
+	return s.ListByResourceType(ctx, VolumeResourceType, opt)
+}
+
+// ListByResourceType lists the snapshots of resources of the given type, or
+// all snapshots when resourceType is empty.
+func (s *SnapshotsServiceOp) ListByResourceType(ctx context.Context, resourceType ResourceType, opt *ListOptions) ([]Snapshot, *Response, error) {
+	o := snapshotListOptions{ResourceType: resourceType}
+	if opt != nil {
+		o.ListOptions = *opt
+	}
+
+	path, err := addOptions(snapshotBasePath, &o)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snapshots, resp, err := DoEnvelope[[]Snapshot](ctx, s.client, req, "snapshots")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *snapshots, resp, err
+}
+
+// Get retrieves a snapshot by id.
+func (s *SnapshotsServiceOp) Get(ctx context.Context, snapshotID string) (*Snapshot, *Response, error) {
+	if snapshotID == "" {
+		return nil, nil, &ValidationError{Field: "snapshotID", Reason: "must not be empty"}
+	}
+
+	path := fmt.Sprintf("%s/%s", snapshotBasePath, snapshotID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snapshot, resp, err := DoEnvelope[Snapshot](ctx, s.client, req, "snapshot")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return snapshot, resp, err
+}
+
+// Delete a snapshot.
+func (s *SnapshotsServiceOp) Delete(ctx context.Context, snapshotID string) (*Response, error) {
+	if snapshotID == "" {
+		return nil, &ValidationError{Field: "snapshotID", Reason: "must not be empty"}
+	}
+
+	path := fmt.Sprintf("%s/%s", snapshotBasePath, snapshotID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
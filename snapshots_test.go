@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestSnapshots_List(t *testing.T) {
+	c, mux := setup(t)
+
+	var got []string
+	mux.HandleFunc("/v2/snapshots", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		got = append(got, r.URL.Query().Get("resource_type")+"/"+r.URL.Query().Get("page"))
+		fmt.Fprint(w, `{"snapshots":[{"id":"6372321","name":"web-01","regions":["nyc3"],"min_disk_size":25}]}`)
+	})
+
+	ctx := context.Background()
+	snapshots, _, err := c.Snapshots.List(ctx, &ListOptions{Page: 2})
+	if err != nil {
+		t.Fatalf("Snapshots.List returned error: %v", err)
+	}
+	if len(snapshots) != 1 || snapshots[0].MinDiskSize != 25 {
+		t.Errorf("got snapshots %+v", snapshots)
+	}
+	if _, _, err := c.Snapshots.ListDroplet(ctx, nil); err != nil {
+		t.Fatalf("Snapshots.ListDroplet returned error: %v", err)
+	}
+	if _, _, err := c.Snapshots.ListVolume(ctx, nil); err != nil {
+		t.Fatalf("Snapshots.ListVolume returned error: %v", err)
+	}
+
+	want := fmt.Sprint([]string{"/2", "droplet/", "volume/"})
+	if fmt.Sprint(got) != want {
+		t.Errorf("expected resource types and pages %s, got %v", want, got)
+	}
+}
+
+func TestSnapshots_GetDelete(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/snapshots/6372321", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"snapshot":{"id":"6372321","resource_type":"droplet"}}`)
+	})
+
+	snapshot, _, err := c.Snapshots.Get(context.Background(), "6372321")
+	if err != nil {
+		t.Fatalf("Snapshots.Get returned error: %v", err)
+	}
+	if snapshot.ResourceType != "droplet" {
+		t.Errorf("got snapshot %+v", snapshot)
+	}
+	if _, err := c.Snapshots.Delete(context.Background(), "6372321"); err != nil {
+		t.Errorf("Snapshots.Delete returned error: %v", err)
+	}
+
+	var verr *ValidationError
+	if _, _, err := c.Snapshots.Get(context.Background(), ""); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for an empty id, got %v", err)
+	}
+	if _, err := c.Snapshots.Delete(context.Background(), ""); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for an empty id, got %v", err)
+	}
+}
@@ -0,0 +1,209 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const spacesKeysBasePath = "v2/spaces/keys"
+
+// SpacesKeyPermission represents a permission for a Spaces grant
+type SpacesKeyPermission string
+
+const (
+	// SpacesKeyRead grants read-only access to the Spaces bucket
+	SpacesKeyRead SpacesKeyPermission = "read"
+	// SpacesKeyReadWrite grants read and write access to the Spaces bucket
+	SpacesKeyReadWrite SpacesKeyPermission = "readwrite"
+	// SpacesKeyFullAccess grants full access to the Spaces bucket
+	SpacesKeyFullAccess SpacesKeyPermission = "fullaccess"
+)
+
+// Grant represents a Grant for a Spaces key. A grant with
+// SpacesKeyFullAccess applies to all buckets and must not name one.
+type Grant struct {
+	Bucket     string              `json:"bucket"`
+	Permission SpacesKeyPermission `json:"permission"`
+}
+
+// SpacesKey represents a DigitalOcean Spaces key. SecretKey is only returned
+// when the key is created.
+type SpacesKey struct {
+	Name      string   `json:"name"`
+	AccessKey string   `json:"access_key"`
+	SecretKey string   `json:"secret_key"`
+	Grants    []*Grant `json:"grants"`
+	CreatedAt string   `json:"created_at"`
+}
+
+// SpacesKeyCreateRequest represents a request to create a Spaces key.
+type SpacesKeyCreateRequest struct {
+	Name   string   `json:"name"`
+	Grants []*Grant `json:"grants"`
+}
+
+// SpacesKeyUpdateRequest represents a request to update a Spaces key. The
+// grants replace the existing ones.
+type SpacesKeyUpdateRequest struct {
+	Name   string   `json:"name"`
+	Grants []*Grant `json:"grants"`
+}
+
+/* SERVICE */
+
+// SpacesKeysService is an interface for managing Spaces keys with the DigitalOcean API.
+// See: https://docs.digitalocean.com/reference/api/api-reference/#tag/Spaces-Keys
+type SpacesKeysService interface {
+	List(context.Context, *ListOptions) ([]*SpacesKey, *Response, error)
+	ListAll(context.Context, *ListAllOptions) ([]*SpacesKey, *Response, error)
+	Get(context.Context, string) (*SpacesKey, *Response, error)
+	Create(context.Context, *SpacesKeyCreateRequest) (*SpacesKey, *Response, error)
+	Update(context.Context, string, *SpacesKeyUpdateRequest) (*SpacesKey, *Response, error)
+	Delete(context.Context, string) (*Response, error)
+}
+
+// SpacesKeysServiceOp handles communication with the Spaces key related methods of the
+// DigitalOcean API.
+type SpacesKeysServiceOp struct {
+	client *Client
+}
+
+var _ SpacesKeysService = &SpacesKeysServiceOp{}
+
+// List returns a list of Spaces keys.
+func (s *SpacesKeysServiceOp) List(ctx context.Context, opt *ListOptions) ([]*SpacesKey, *Response, error) {
+	path, err := addOptions(spacesKeysBasePath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys, resp, err := DoEnvelope[[]*SpacesKey](ctx, s.client, req, "keys")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *keys, resp, err
+}
+
+// ListAll Spaces keys across all pages
+func (s *SpacesKeysServiceOp) ListAll(ctx context.Context, opt *ListAllOptions) ([]*SpacesKey, *Response, error) {
+	return ListAll[*SpacesKey](ctx, s.List, opt)
+}
+
+// Get retrieves a Spaces key by its access key.
+func (s *SpacesKeysServiceOp) Get(ctx context.Context, accessKey string) (*SpacesKey, *Response, error) {
+	path, err := spacesKeyPath(accessKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doKey(ctx, req)
+}
+
+// Create creates a new Spaces key. Keep the returned SecretKey, it can not be
+// retrieved again.
+func (s *SpacesKeysServiceOp) Create(ctx context.Context, createRequest *SpacesKeyCreateRequest) (*SpacesKey, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, &ValidationError{Field: "createRequest", Reason: "cannot be nil"}
+	}
+	if createRequest.Name == "" {
+		return nil, nil, &ValidationError{Field: "name", Reason: "must not be empty"}
+	}
+	if err := validateGrants(createRequest.Grants); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, spacesKeysBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doKey(ctx, req)
+}
+
+// Update updates the name and grants of a Spaces key.
+func (s *SpacesKeysServiceOp) Update(ctx context.Context, accessKey string, updateRequest *SpacesKeyUpdateRequest) (*SpacesKey, *Response, error) {
+	path, err := spacesKeyPath(accessKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if updateRequest == nil {
+		return nil, nil, &ValidationError{Field: "updateRequest", Reason: "cannot be nil"}
+	}
+	if err := validateGrants(updateRequest.Grants); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, updateRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doKey(ctx, req)
+}
+
+// Delete deletes a Spaces key, revoking its access immediately.
+func (s *SpacesKeysServiceOp) Delete(ctx context.Context, accessKey string) (*Response, error) {
+	path, err := spacesKeyPath(accessKey)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *SpacesKeysServiceOp) doKey(ctx context.Context, req *http.Request) (*SpacesKey, *Response, error) {
+	key, resp, err := DoEnvelope[SpacesKey](ctx, s.client, req, "key")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return key, resp, err
+}
+
+// spacesKeyPath returns the path of a Spaces key.
+func spacesKeyPath(accessKey string) (string, error) {
+	if accessKey == "" {
+		return "", &ValidationError{Field: "accessKey", Reason: "must not be empty"}
+	}
+	return fmt.Sprintf("%s/%s", spacesKeysBasePath, accessKey), nil
+}
+
+// validateGrants checks the permissions of grants and that full access is
+// not limited to a bucket.
+func validateGrants(grants []*Grant) error {
+	for _, grant := range grants {
+		if grant == nil {
+			return &ValidationError{Field: "grants", Reason: "must not contain nil"}
+		}
+		switch grant.Permission {
+		case SpacesKeyRead, SpacesKeyReadWrite:
+			if grant.Bucket == "" {
+				return &ValidationError{Field: "bucket", Reason: fmt.Sprintf("must not be empty for %s grants", grant.Permission)}
+			}
+		case SpacesKeyFullAccess:
+			if grant.Bucket != "" {
+				return &ValidationError{Field: "bucket", Value: grant.Bucket, Reason: "must be empty for fullaccess grants"}
+			}
+		default:
+			return &ValidationError{Field: "permission", Value: string(grant.Permission), Reason: "must be read, readwrite or fullaccess"}
+		}
+	}
+	return nil
+}
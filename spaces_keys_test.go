@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestSpacesKeys_List(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/spaces/keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"keys":[{"name":"ci","access_key":"DOACCESS","grants":[{"bucket":"assets","permission":"read"}],"created_at":"2024-01-01T00:00:00Z"}]}`)
+	})
+
+	keys, _, err := c.SpacesKeys.List(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("SpacesKeys.List returned error: %v", err)
+	}
+	if len(keys) != 1 || keys[0].AccessKey != "DOACCESS" || len(keys[0].Grants) != 1 || keys[0].Grants[0].Permission != SpacesKeyRead {
+		t.Errorf("got keys %+v", keys)
+	}
+}
+
+func TestSpacesKeys_CreateUpdateGetDelete(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+
+	mux.HandleFunc("/v2/spaces/keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var req SpacesKeyCreateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if req.Name != "ci" || len(req.Grants) != 1 || req.Grants[0].Permission != SpacesKeyFullAccess {
+			t.Errorf("got request %+v", req)
+		}
+		fmt.Fprint(w, `{"key":{"name":"ci","access_key":"DOACCESS","secret_key":"secret"}}`)
+	})
+
+	var methods []string
+	mux.HandleFunc("/v2/spaces/keys/DOACCESS", func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		switch r.Method {
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodPut:
+			var req SpacesKeyUpdateRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("decoding request body: %v", err)
+			}
+			if req.Name != "deploy" || len(req.Grants) != 1 || req.Grants[0].Bucket != "assets" {
+				t.Errorf("got request %+v", req)
+			}
+			fmt.Fprint(w, `{"key":{"name":"deploy","access_key":"DOACCESS"}}`)
+		default:
+			fmt.Fprint(w, `{"key":{"name":"deploy","access_key":"DOACCESS"}}`)
+		}
+	})
+
+	key, _, err := c.SpacesKeys.Create(ctx, &SpacesKeyCreateRequest{Name: "ci", Grants: []*Grant{{Permission: SpacesKeyFullAccess}}})
+	if err != nil {
+		t.Fatalf("SpacesKeys.Create returned error: %v", err)
+	}
+	if key.SecretKey != "secret" {
+		t.Errorf("got key %+v", key)
+	}
+	key, _, err = c.SpacesKeys.Update(ctx, "DOACCESS", &SpacesKeyUpdateRequest{Name: "deploy", Grants: []*Grant{{Bucket: "assets", Permission: SpacesKeyReadWrite}}})
+	if err != nil {
+		t.Fatalf("SpacesKeys.Update returned error: %v", err)
+	}
+	if key.Name != "deploy" {
+		t.Errorf("got key %+v", key)
+	}
+	if _, _, err := c.SpacesKeys.Get(ctx, "DOACCESS"); err != nil {
+		t.Fatalf("SpacesKeys.Get returned error: %v", err)
+	}
+	if _, err := c.SpacesKeys.Delete(ctx, "DOACCESS"); err != nil {
+		t.Fatalf("SpacesKeys.Delete returned error: %v", err)
+	}
+
+	if fmt.Sprint(methods) != "[PUT GET DELETE]" {
+		t.Errorf("got methods %v", methods)
+	}
+}
+
+func TestSpacesKeys_validation(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+	s := c.SpacesKeys
+	create := func(grants ...*Grant) func() error {
+		return func() error {
+			_, _, err := s.Create(ctx, &SpacesKeyCreateRequest{Name: "ci", Grants: grants})
+			return err
+		}
+	}
+
+	calls := map[string]func() error{
+		"get empty key":             func() error { _, _, err := s.Get(ctx, ""); return err },
+		"delete empty key":          func() error { _, err := s.Delete(ctx, ""); return err },
+		"create nil":                func() error { _, _, err := s.Create(ctx, nil); return err },
+		"create no name":            func() error { _, _, err := s.Create(ctx, &SpacesKeyCreateRequest{}); return err },
+		"create nil grant":          create(nil),
+		"create read no bucket":     create(&Grant{Permission: SpacesKeyRead}),
+		"create full with bucket":   create(&Grant{Bucket: "assets", Permission: SpacesKeyFullAccess}),
+		"create unknown permission": create(&Grant{Bucket: "assets", Permission: "write"}),
+		"update empty key":          func() error { _, _, err := s.Update(ctx, "", &SpacesKeyUpdateRequest{}); return err },
+		"update nil":                func() error { _, _, err := s.Update(ctx, "DOACCESS", nil); return err },
+		"update bad grant": func() error {
+			_, _, err := s.Update(ctx, "DOACCESS", &SpacesKeyUpdateRequest{Grants: []*Grant{{Permission: SpacesKeyReadWrite}}})
+			return err
+		},
+	}
+	for name, call := range calls {
+		var verr *ValidationError
+		if err := call(); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package client
+
+import (
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// statsWindow is the number of most recent latencies percentiles are
+// computed from.
+const statsWindow = 256
+
+// headerFromCache is set by caching transports on responses served from their
+// cache.
+const headerFromCache = "X-From-Cache"
+
+// versionSegment matches the API version segment of request paths, e.g. "v2".
+var versionSegment = regexp.MustCompile(`^v[0-9]+$`)
+
+// RequestService returns the service a request is addressed to: the resource
+// collection following the API version in its path, e.g. "tags" for
+// /v2/tags/foo.
+func RequestService(req *http.Request) string {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	for i, segment := range segments {
+		if versionSegment.MatchString(segment) && i+1 < len(segments) {
+			return segments[i+1]
+		}
+	}
+	return segments[0]
+}
+
+// EndpointStats are the latency and error statistics of the requests sent to
+// one service with one HTTP method.
+type EndpointStats struct {
+	Service string
+	Method  string
+
+	// Requests is the number of requests sent, Errors the number of those
+	// which failed in transport or with a status of 400 or above.
+	Requests int
+	Errors   int
+
+	// Latency percentiles over the most recent requests.
+	P50 time.Duration
+	P90 time.Duration
+	P99 time.Duration
+}
+
+// Stats returns the statistics of every endpoint the client has sent requests
+// to, ordered by service and method, so applications without a metrics stack
+// can still inspect API health.
+func (c *Client) Stats() []EndpointStats {
+	return c.stats.snapshot()
+}
+
+type statsKey struct {
+	service string
+	method  string
+}
+
+// endpointStats holds the counts and a ring buffer of recent latencies.
+type endpointStats struct {
+	requests  int
+	errors    int
+	latencies [statsWindow]time.Duration
+	next      int
+}
+
+type statsRecorder struct {
+	mu        sync.Mutex
+	endpoints map[statsKey]*endpointStats
+}
+
+// record accounts a request which took latency and finished with status, zero
+// when no response was received.
+func (r *statsRecorder) record(req *http.Request, latency time.Duration, status int) {
+	key := statsKey{service: RequestService(req), method: req.Method}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.endpoints == nil {
+		r.endpoints = make(map[statsKey]*endpointStats)
+	}
+	s, ok := r.endpoints[key]
+	if !ok {
+		s = new(endpointStats)
+		r.endpoints[key] = s
+	}
+
+	s.requests++
+	if status == 0 || status >= http.StatusBadRequest {
+		s.errors++
+	}
+	s.latencies[s.next%statsWindow] = latency
+	s.next++
+}
+
+func (r *statsRecorder) snapshot() []EndpointStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stats := make([]EndpointStats, 0, len(r.endpoints))
+	for key, s := range r.endpoints {
+		n := s.next
+		if n > statsWindow {
+			n = statsWindow
+		}
+		latencies := make([]time.Duration, n)
+		copy(latencies, s.latencies[:n])
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+		stats = append(stats, EndpointStats{
+			Service:  key.service,
+			Method:   key.method,
+			Requests: s.requests,
+			Errors:   s.errors,
+			P50:      percentile(latencies, 50),
+			P90:      percentile(latencies, 90),
+			P99:      percentile(latencies, 99),
+		})
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Service != stats[j].Service {
+			return stats[i].Service < stats[j].Service
+		}
+		return stats[i].Method < stats[j].Method
+	})
+	return stats
+}
+
+// percentile returns the p-th percentile of sorted using the nearest rank
+// method.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// StatsSnapshot holds the request counts of a client since it was created or
+// since the last call to ResetSnapshot.
+type StatsSnapshot struct {
+	// Requests is the number of requests sent, including retries.
+	Requests int64
+	// Retries is the number of requests which were retries of a rate limited
+	// request.
+	Retries int64
+	// ClientErrors and ServerErrors are the number of responses with a 4xx
+	// and 5xx status respectively.
+	ClientErrors int64
+	ServerErrors int64
+	// CacheHits is the number of responses served from a cache, either as a
+	// 304 Not Modified or marked with an X-From-Cache header by a caching
+	// transport.
+	CacheHits int64
+	// Throttled is the time spent waiting before sending requests because of
+	// rate or concurrency limits, including the delays between retries.
+	Throttled time.Duration
+}
+
+// Snapshot returns the request counts of the client.
+func (c *Client) Snapshot() StatsSnapshot {
+	return StatsSnapshot{
+		Requests:     c.counters.requests.Load(),
+		Retries:      c.counters.retries.Load(),
+		ClientErrors: c.counters.clientErrors.Load(),
+		ServerErrors: c.counters.serverErrors.Load(),
+		CacheHits:    c.counters.cacheHits.Load(),
+		Throttled:    time.Duration(c.counters.throttled.Load()),
+	}
+}
+
+// ResetSnapshot sets the request counts of the client back to zero.
+func (c *Client) ResetSnapshot() {
+	c.counters.requests.Store(0)
+	c.counters.retries.Store(0)
+	c.counters.clientErrors.Store(0)
+	c.counters.serverErrors.Store(0)
+	c.counters.cacheHits.Store(0)
+	c.counters.throttled.Store(0)
+}
+
+type counters struct {
+	requests     atomic.Int64
+	retries      atomic.Int64
+	clientErrors atomic.Int64
+	serverErrors atomic.Int64
+	cacheHits    atomic.Int64
+	throttled    atomic.Int64
+}
+
+// record accounts a request sent as attempt of a request, and resp, which is
+// nil when no response was received.
+func (c *counters) record(attempt int, resp *http.Response) {
+	c.requests.Add(1)
+	if attempt > 0 {
+		c.retries.Add(1)
+	}
+	if resp == nil {
+		return
+	}
+
+	switch {
+	case resp.StatusCode >= http.StatusInternalServerError:
+		c.serverErrors.Add(1)
+	case resp.StatusCode >= http.StatusBadRequest:
+		c.clientErrors.Add(1)
+	}
+	if resp.StatusCode == http.StatusNotModified || resp.Header.Get(headerFromCache) != "" {
+		c.cacheHits.Add(1)
+	}
+}
+
+// throttle accounts time spent waiting because of rate or concurrency limits.
+func (c *counters) throttle(d time.Duration) {
+	c.throttled.Add(int64(d))
+}
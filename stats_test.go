@@ -0,0 +1,165 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRequestService(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/v2/tags", want: "tags"},
+		{path: "/v2/tags/foo/resources", want: "tags"},
+		{path: "/api/v2/droplets/1", want: "droplets"},
+		{path: "/v2", want: "v2"},
+		{path: "/status", want: "status"},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+		if got := RequestService(req); got != tt.want {
+			t.Errorf("%s: expected %q, got %q", tt.path, tt.want, got)
+		}
+	}
+}
+
+func TestClient_Stats(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"account":{}}`)
+	})
+	mux.HandleFunc("/v2/droplets/1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"id":"not_found","message":"not found"}`)
+	})
+
+	for i := 0; i < 3; i++ {
+		if _, _, err := c.Account.Get(context.Background()); err != nil {
+			t.Fatalf("Account.Get returned error: %v", err)
+		}
+	}
+	for i := 0; i < 2; i++ {
+		if _, _, err := c.Droplets.Get(context.Background(), 1); err == nil {
+			t.Fatal("expected an error")
+		}
+	}
+	if _, err := c.Droplets.Delete(context.Background(), 1); err != nil {
+		t.Fatalf("Droplets.Delete returned error: %v", err)
+	}
+
+	var got []string
+	for _, s := range c.Stats() {
+		got = append(got, fmt.Sprintf("%s %s %d/%d", s.Service, s.Method, s.Errors, s.Requests))
+		if s.P50 <= 0 || s.P50 > s.P90 || s.P90 > s.P99 {
+			t.Errorf("%s %s: expected ordered latency percentiles, got %v %v %v", s.Service, s.Method, s.P50, s.P90, s.P99)
+		}
+	}
+	want := "[account GET 0/3 droplets DELETE 0/1 droplets GET 2/2]"
+	if fmt.Sprint(got) != want {
+		t.Errorf("expected %s, got %v", want, got)
+	}
+}
+
+func TestStatsRecorder_percentiles(t *testing.T) {
+	var r statsRecorder
+	req := httptest.NewRequest(http.MethodGet, "/v2/tags", nil)
+
+	// the oldest latencies fall out of the window
+	for i := 0; i < statsWindow; i++ {
+		r.record(req, time.Hour, http.StatusOK)
+	}
+	for i := 200; i > 0; i-- {
+		r.record(req, time.Duration(i)*time.Millisecond, 0)
+	}
+	for i := 0; i < statsWindow-200; i++ {
+		r.record(req, time.Second, http.StatusOK)
+	}
+
+	stats := r.snapshot()
+	if len(stats) != 1 {
+		t.Fatalf("expected 1 endpoint, got %v", stats)
+	}
+	s := stats[0]
+	if s.Requests != 2*statsWindow || s.Errors != 200 {
+		t.Errorf("expected 200 errors in %d requests, got %d in %d", 2*statsWindow, s.Errors, s.Requests)
+	}
+	if s.P50 != 128*time.Millisecond || s.P90 != time.Second || s.P99 != time.Second {
+		t.Errorf("expected the percentiles of the last %d requests, got %v %v %v", statsWindow, s.P50, s.P90, s.P99)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	var sorted []time.Duration
+	for i := 1; i <= 100; i++ {
+		sorted = append(sorted, time.Duration(i)*time.Millisecond)
+	}
+
+	for p, want := range map[int]time.Duration{50: 50 * time.Millisecond, 90: 90 * time.Millisecond, 99: 99 * time.Millisecond} {
+		if got := percentile(sorted, p); got != want {
+			t.Errorf("P%d: expected %v, got %v", p, want, got)
+		}
+	}
+	if got := percentile(sorted[:1], 50); got != time.Millisecond {
+		t.Errorf("expected the only latency, got %v", got)
+	}
+	if got := percentile(nil, 50); got != 0 {
+		t.Errorf("expected zero without latencies, got %v", got)
+	}
+}
+
+func TestClient_Snapshot(t *testing.T) {
+	c, mux := setup(t, SetRateLimitStrategy(RateLimitRetryWithBackoff(1, 5*time.Millisecond)))
+
+	var attempts int
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"id":"too_many_requests","message":"slow down"}`)
+			return
+		}
+		w.Header().Set(headerFromCache, "1")
+		fmt.Fprint(w, `{"account":{}}`)
+	})
+	mux.HandleFunc("/v2/droplets/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"id":"not_found","message":"not found"}`)
+	})
+	mux.HandleFunc("/v2/droplets/2", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, `{"id":"server_error","message":"oops"}`)
+	})
+
+	if _, _, err := c.Account.Get(context.Background()); err != nil {
+		t.Fatalf("Account.Get returned error: %v", err)
+	}
+	for _, id := range []int{1, 2} {
+		if _, _, err := c.Droplets.Get(context.Background(), id); err == nil {
+			t.Fatalf("expected an error for droplet %d", id)
+		}
+	}
+
+	s := c.Snapshot()
+	if s.Requests != 4 || s.Retries != 1 || s.ClientErrors != 2 || s.ServerErrors != 1 || s.CacheHits != 1 {
+		t.Errorf("got snapshot %+v", s)
+	}
+	if s.Throttled < 5*time.Millisecond {
+		t.Errorf("expected the retry delay accounted as throttled, got %v", s.Throttled)
+	}
+
+	c.ResetSnapshot()
+	if s := c.Snapshot(); s != (StatsSnapshot{}) {
+		t.Errorf("expected a zero snapshot after ResetSnapshot, got %+v", s)
+	}
+}
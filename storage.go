@@ -0,0 +1,285 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	storageBasePath  = "v2"
+	storageAllocPath = storageBasePath + "/volumes"
+	storageSnapPath  = storageBasePath + "/snapshots"
+)
+
+// Filesystem types volumes can be formatted with.
+const (
+	FilesystemExt4 = "ext4"
+	FilesystemXFS  = "xfs"
+)
+
+// Volume represents a Digital Ocean block store volume.
+type Volume struct {
+	ID              string    `json:"id"`
+	Region          *Region   `json:"region"`
+	Name            string    `json:"name"`
+	SizeGigaBytes   int64     `json:"size_gigabytes"`
+	Description     string    `json:"description"`
+	DropletIDs      []int     `json:"droplet_ids"`
+	CreatedAt       time.Time `json:"created_at"`
+	FilesystemType  string    `json:"filesystem_type"`
+	FilesystemLabel string    `json:"filesystem_label"`
+	Tags            []string  `json:"tags"`
+}
+
+// URN returns the volume ID as a valid DO API URN
+func (f Volume) URN() string {
+	return Resource{ID: f.ID, Type: VolumeResourceType}.URN()
+}
+
+// VolumeListOptions filters the volumes listed by StorageService.ListVolumes.
+type VolumeListOptions struct {
+	ListOptions
+
+	// Region restricts the listed volumes to a region slug.
+	Region string `url:"region,omitempty"`
+}
+
+// VolumeListAllOptions specifies the options of StorageService.ListAllVolumes.
+type VolumeListAllOptions struct {
+	ListAllOptions
+
+	// Region restricts the listed volumes to a region slug.
+	Region string
+}
+
+// VolumeCreateRequest represents a request to create a block store
+// volume.
+type VolumeCreateRequest struct {
+	Region          string   `json:"region,omitempty"`
+	Name            string   `json:"name"`
+	Description     string   `json:"description"`
+	SizeGigaBytes   int64    `json:"size_gigabytes"`
+	SnapshotID      string   `json:"snapshot_id,omitempty"`
+	FilesystemType  string   `json:"filesystem_type,omitempty"`
+	FilesystemLabel string   `json:"filesystem_label,omitempty"`
+	Tags            []string `json:"tags"`
+}
+
+// SnapshotCreateRequest represents a request to create a snapshot of a block
+// store volume.
+type SnapshotCreateRequest struct {
+	VolumeID    string   `json:"volume_id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Tags        []string `json:"tags"`
+}
+
+/* SERVICE */
+
+// StorageService is an interface for interfacing with the storage
+// endpoints of the Digital Ocean API.
+type StorageService interface {
+	ListVolumes(context.Context, *VolumeListOptions) ([]Volume, *Response, error)
+	ListAllVolumes(context.Context, *VolumeListAllOptions) ([]Volume, *Response, error)
+	GetVolume(context.Context, string) (*Volume, *Response, error)
+	CreateVolume(context.Context, *VolumeCreateRequest) (*Volume, *Response, error)
+	DeleteVolume(context.Context, string) (*Response, error)
+	ListSnapshots(ctx context.Context, volumeID string, opts *ListOptions) ([]Snapshot, *Response, error)
+	GetSnapshot(context.Context, string) (*Snapshot, *Response, error)
+	CreateSnapshot(context.Context, *SnapshotCreateRequest) (*Snapshot, *Response, error)
+	DeleteSnapshot(context.Context, string) (*Response, error)
+}
+
+// StorageServiceOp handles communication with the storage volumes related methods of the
+// DigitalOcean API.
+type StorageServiceOp struct {
+	client *Client
+}
+
+var _ StorageService = &StorageServiceOp{}
+
+// ListVolumes lists all storage volumes.
+func (s *StorageServiceOp) ListVolumes(ctx context.Context, opt *VolumeListOptions) ([]Volume, *Response, error) {
+	path, err := addOptions(storageAllocPath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	volumes, resp, err := DoEnvelope[[]Volume](ctx, s.client, req, "volumes")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *volumes, resp, err
+}
+
+// ListAllVolumes lists storage volumes across all pages.
+func (s *StorageServiceOp) ListAllVolumes(ctx context.Context, opt *VolumeListAllOptions) ([]Volume, *Response, error) {
+	if opt == nil {
+		opt = &VolumeListAllOptions{}
+	}
+	return ListAll[Volume](ctx, func(ctx context.Context, o *ListOptions) ([]Volume, *Response, error) {
+		return s.ListVolumes(ctx, &VolumeListOptions{ListOptions: *o, Region: opt.Region})
+	}, &opt.ListAllOptions)
+}
+
+// CreateVolume creates a storage volume. The name must be unique. The size
+// may be omitted when creating the volume from a snapshot.
+func (s *StorageServiceOp) CreateVolume(ctx context.Context, createRequest *VolumeCreateRequest) (*Volume, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, &ValidationError{Field: "createRequest", Reason: "cannot be nil"}
+	}
+	if createRequest.Name == "" {
+		return nil, nil, &ValidationError{Field: "name", Reason: "must not be empty"}
+	}
+	if createRequest.SizeGigaBytes <= 0 && createRequest.SnapshotID == "" {
+		return nil, nil, &ValidationError{Field: "size_gigabytes", Reason: "must be positive unless a snapshot is given"}
+	}
+	switch createRequest.FilesystemType {
+	case "", FilesystemExt4, FilesystemXFS:
+	default:
+		return nil, nil, &ValidationError{Field: "filesystem_type", Value: createRequest.FilesystemType, Reason: fmt.Sprintf("expected %q or %q", FilesystemExt4, FilesystemXFS)}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, storageAllocPath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	volume, resp, err := DoEnvelope[Volume](ctx, s.client, req, "volume")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return volume, resp, err
+}
+
+// GetVolume retrieves an individual storage volume.
+func (s *StorageServiceOp) GetVolume(ctx context.Context, id string) (*Volume, *Response, error) {
+	if id == "" {
+		return nil, nil, &ValidationError{Field: "id", Reason: "must not be empty"}
+	}
+
+	path := fmt.Sprintf("%s/%s", storageAllocPath, id)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	volume, resp, err := DoEnvelope[Volume](ctx, s.client, req, "volume")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return volume, resp, err
+}
+
+// DeleteVolume deletes a storage volume.
+func (s *StorageServiceOp) DeleteVolume(ctx context.Context, id string) (*Response, error) {
+	if id == "" {
+		return nil, &ValidationError{Field: "id", Reason: "must not be empty"}
+	}
+
+	path := fmt.Sprintf("%s/%s", storageAllocPath, id)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListSnapshots lists all snapshots related to a storage volume.
+func (s *StorageServiceOp) ListSnapshots(ctx context.Context, volumeID string, opt *ListOptions) ([]Snapshot, *Response, error) {
+	if volumeID == "" {
+		return nil, nil, &ValidationError{Field: "volumeID", Reason: "must not be empty"}
+	}
+
+	path := fmt.Sprintf("%s/%s/snapshots", storageAllocPath, volumeID)
+	path, err := addOptions(path, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snapshots, resp, err := DoEnvelope[[]Snapshot](ctx, s.client, req, "snapshots")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *snapshots, resp, err
+}
+
+// CreateSnapshot creates a snapshot of a storage volume.
+func (s *StorageServiceOp) CreateSnapshot(ctx context.Context, createRequest *SnapshotCreateRequest) (*Snapshot, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, &ValidationError{Field: "createRequest", Reason: "cannot be nil"}
+	}
+	if createRequest.VolumeID == "" {
+		return nil, nil, &ValidationError{Field: "volume_id", Reason: "must not be empty"}
+	}
+
+	path := fmt.Sprintf("%s/%s/snapshots", storageAllocPath, createRequest.VolumeID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snapshot, resp, err := DoEnvelope[Snapshot](ctx, s.client, req, "snapshot")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return snapshot, resp, err
+}
+
+// GetSnapshot retrieves an individual snapshot.
+func (s *StorageServiceOp) GetSnapshot(ctx context.Context, id string) (*Snapshot, *Response, error) {
+	if id == "" {
+		return nil, nil, &ValidationError{Field: "id", Reason: "must not be empty"}
+	}
+
+	path := fmt.Sprintf("%s/%s", storageSnapPath, id)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snapshot, resp, err := DoEnvelope[Snapshot](ctx, s.client, req, "snapshot")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return snapshot, resp, err
+}
+
+// DeleteSnapshot deletes a snapshot.
+func (s *StorageServiceOp) DeleteSnapshot(ctx context.Context, id string) (*Response, error) {
+	if id == "" {
+		return nil, &ValidationError{Field: "id", Reason: "must not be empty"}
+	}
+
+	path := fmt.Sprintf("%s/%s", storageSnapPath, id)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,174 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// StorageActionsService is an interface for interfacing with the
+// storage actions endpoints of the Digital Ocean API.
+type StorageActionsService interface {
+	Attach(ctx context.Context, volumeID string, dropletID int) (*Action, *Response, error)
+	AttachByName(ctx context.Context, name, region string, dropletID int) (*Action, *Response, error)
+	DetachByDropletID(ctx context.Context, volumeID string, dropletID int) (*Action, *Response, error)
+	DetachByName(ctx context.Context, name, region string, dropletID int) (*Action, *Response, error)
+	Get(ctx context.Context, volumeID string, actionID int) (*Action, *Response, error)
+	List(ctx context.Context, volumeID string, opt *ListOptions) ([]Action, *Response, error)
+	ListAll(ctx context.Context, volumeID string, opt *ListAllOptions) ([]Action, *Response, error)
+	Resize(ctx context.Context, volumeID string, sizeGigabytes int, regionSlug string) (*Action, *Response, error)
+}
+
+// StorageActionsServiceOp handles communication with the storage volumes
+// action related methods of the DigitalOcean API.
+type StorageActionsServiceOp struct {
+	client *Client
+}
+
+var _ StorageActionsService = &StorageActionsServiceOp{}
+
+// Attach a storage volume to a Droplet.
+func (s *StorageActionsServiceOp) Attach(ctx context.Context, volumeID string, dropletID int) (*Action, *Response, error) {
+	if dropletID < 1 {
+		return nil, nil, &ValidationError{Field: "dropletID", Reason: "must be positive"}
+	}
+
+	return s.doAction(ctx, volumeID, ActionRequest{"type": "attach", "droplet_id": dropletID})
+}
+
+// AttachByName attaches the storage volume with the given name in region to
+// a Droplet.
+func (s *StorageActionsServiceOp) AttachByName(ctx context.Context, name, region string, dropletID int) (*Action, *Response, error) {
+	if dropletID < 1 {
+		return nil, nil, &ValidationError{Field: "dropletID", Reason: "must be positive"}
+	}
+
+	return s.doActionByName(ctx, name, region, ActionRequest{"type": "attach", "droplet_id": dropletID})
+}
+
+// DetachByDropletID a storage volume from a Droplet by Droplet ID.
+func (s *StorageActionsServiceOp) DetachByDropletID(ctx context.Context, volumeID string, dropletID int) (*Action, *Response, error) {
+	if dropletID < 1 {
+		return nil, nil, &ValidationError{Field: "dropletID", Reason: "must be positive"}
+	}
+
+	return s.doAction(ctx, volumeID, ActionRequest{"type": "detach", "droplet_id": dropletID})
+}
+
+// DetachByName detaches the storage volume with the given name in region
+// from a Droplet.
+func (s *StorageActionsServiceOp) DetachByName(ctx context.Context, name, region string, dropletID int) (*Action, *Response, error) {
+	if dropletID < 1 {
+		return nil, nil, &ValidationError{Field: "dropletID", Reason: "must be positive"}
+	}
+
+	return s.doActionByName(ctx, name, region, ActionRequest{"type": "detach", "droplet_id": dropletID})
+}
+
+// Get an action for a particular storage volume by id.
+func (s *StorageActionsServiceOp) Get(ctx context.Context, volumeID string, actionID int) (*Action, *Response, error) {
+	if volumeID == "" {
+		return nil, nil, &ValidationError{Field: "volumeID", Reason: "must not be empty"}
+	}
+	if actionID < 1 {
+		return nil, nil, &ValidationError{Field: "actionID", Reason: "must be positive"}
+	}
+
+	path := fmt.Sprintf("%s/%d", storageAllocationActionPath(volumeID), actionID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	action, resp, err := DoEnvelope[Action](ctx, s.client, req, "action")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return action, resp, err
+}
+
+// List the actions for a particular storage volume.
+func (s *StorageActionsServiceOp) List(ctx context.Context, volumeID string, opt *ListOptions) ([]Action, *Response, error) {
+	if volumeID == "" {
+		return nil, nil, &ValidationError{Field: "volumeID", Reason: "must not be empty"}
+	}
+
+	path, err := addOptions(storageAllocationActionPath(volumeID), opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	actions, resp, err := DoEnvelope[[]Action](ctx, s.client, req, "actions")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *actions, resp, err
+}
+
+// ListAll the actions of a storage volume across all pages
+func (s *StorageActionsServiceOp) ListAll(ctx context.Context, volumeID string, opt *ListAllOptions) ([]Action, *Response, error) {
+	return ListAll[Action](ctx, func(ctx context.Context, opt *ListOptions) ([]Action, *Response, error) {
+		return s.List(ctx, volumeID, opt)
+	}, opt)
+}
+
+// Resize a storage volume. Volumes can only grow.
+func (s *StorageActionsServiceOp) Resize(ctx context.Context, volumeID string, sizeGigabytes int, regionSlug string) (*Action, *Response, error) {
+	if sizeGigabytes < 1 {
+		return nil, nil, &ValidationError{Field: "sizeGigabytes", Reason: "must be positive"}
+	}
+
+	request := ActionRequest{
+		"type":           "resize",
+		"size_gigabytes": sizeGigabytes,
+		"region":         regionSlug,
+	}
+	return s.doAction(ctx, volumeID, request)
+}
+
+func (s *StorageActionsServiceOp) doAction(ctx context.Context, volumeID string, request ActionRequest) (*Action, *Response, error) {
+	if volumeID == "" {
+		return nil, nil, &ValidationError{Field: "volumeID", Reason: "must not be empty"}
+	}
+
+	return s.post(ctx, storageAllocationActionPath(volumeID), request)
+}
+
+func (s *StorageActionsServiceOp) doActionByName(ctx context.Context, name, region string, request ActionRequest) (*Action, *Response, error) {
+	if name == "" {
+		return nil, nil, &ValidationError{Field: "name", Reason: "must not be empty"}
+	}
+	if region == "" {
+		return nil, nil, &ValidationError{Field: "region", Reason: "must not be empty"}
+	}
+
+	request["volume_name"] = name
+	request["region"] = region
+	return s.post(ctx, storageAllocPath+"/actions", request)
+}
+
+func (s *StorageActionsServiceOp) post(ctx context.Context, path string, request ActionRequest) (*Action, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	action, resp, err := DoEnvelope[Action](ctx, s.client, req, "action")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return action, resp, err
+}
+
+func storageAllocationActionPath(volumeID string) string {
+	return fmt.Sprintf("%s/%s/actions", storageAllocPath, volumeID)
+}
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestStorageActions(t *testing.T) {
+	c, mux := setup(t)
+
+	var path string
+	var got ActionRequest
+	handle := func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		path, got = r.URL.Path, nil
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		fmt.Fprintf(w, `{"action":{"id":2,"status":"in-progress","type":%q,"resource_type":"volume"}}`, got["type"])
+	}
+	mux.HandleFunc("/v2/volumes/506f78a4/actions", handle)
+	mux.HandleFunc("/v2/volumes/actions", handle)
+
+	ctx := context.Background()
+	a := c.StorageActions
+	tests := []struct {
+		name string
+		call func() (*Action, *Response, error)
+		path string
+		want ActionRequest
+	}{
+		{"Attach", func() (*Action, *Response, error) { return a.Attach(ctx, "506f78a4", 1) },
+			"/v2/volumes/506f78a4/actions", ActionRequest{"type": "attach", "droplet_id": 1.0}},
+		{"DetachByDropletID", func() (*Action, *Response, error) { return a.DetachByDropletID(ctx, "506f78a4", 1) },
+			"/v2/volumes/506f78a4/actions", ActionRequest{"type": "detach", "droplet_id": 1.0}},
+		{"Resize", func() (*Action, *Response, error) { return a.Resize(ctx, "506f78a4", 100, "nyc3") },
+			"/v2/volumes/506f78a4/actions", ActionRequest{"type": "resize", "size_gigabytes": 100.0, "region": "nyc3"}},
+		{"AttachByName", func() (*Action, *Response, error) { return a.AttachByName(ctx, "example", "nyc3", 1) },
+			"/v2/volumes/actions", ActionRequest{"type": "attach", "droplet_id": 1.0, "volume_name": "example", "region": "nyc3"}},
+		{"DetachByName", func() (*Action, *Response, error) { return a.DetachByName(ctx, "example", "nyc3", 1) },
+			"/v2/volumes/actions", ActionRequest{"type": "detach", "droplet_id": 1.0, "volume_name": "example", "region": "nyc3"}},
+	}
+
+	for _, tt := range tests {
+		action, _, err := tt.call()
+		if err != nil {
+			t.Errorf("%s returned error: %v", tt.name, err)
+			continue
+		}
+		if path != tt.path || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: expected request %v to %s, got %v to %s", tt.name, tt.want, tt.path, got, path)
+		}
+		if action.ID != 2 || action.Type != tt.want["type"] {
+			t.Errorf("%s: got action %+v", tt.name, action)
+		}
+	}
+}
+
+func TestStorageActions_GetList(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/volumes/506f78a4/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"actions":[{"id":2,"type":"attach"},{"id":3,"type":"detach"}]}`)
+	})
+	mux.HandleFunc("/v2/volumes/506f78a4/actions/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"action":{"id":2,"status":"completed"}}`)
+	})
+
+	actions, _, err := c.StorageActions.List(context.Background(), "506f78a4", nil)
+	if err != nil {
+		t.Fatalf("StorageActions.List returned error: %v", err)
+	}
+	if len(actions) != 2 || actions[1].Type != "detach" {
+		t.Errorf("got actions %+v", actions)
+	}
+
+	action, _, err := c.StorageActions.Get(context.Background(), "506f78a4", 2)
+	if err != nil {
+		t.Fatalf("StorageActions.Get returned error: %v", err)
+	}
+	if action.Status != ActionCompleted {
+		t.Errorf("got action %+v", action)
+	}
+}
+
+func TestStorageActions_validation(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+	a := c.StorageActions
+
+	calls := map[string]func() error{
+		"attach droplet 0": func() error { _, _, err := a.Attach(ctx, "506f78a4", 0); return err },
+		"attach no volume": func() error { _, _, err := a.Attach(ctx, "", 1); return err },
+		"attach no name":   func() error { _, _, err := a.AttachByName(ctx, "", "nyc3", 1); return err },
+		"attach no region": func() error { _, _, err := a.AttachByName(ctx, "example", "", 1); return err },
+		"detach droplet 0": func() error { _, _, err := a.DetachByDropletID(ctx, "506f78a4", 0); return err },
+		"detach by name":   func() error { _, _, err := a.DetachByName(ctx, "example", "nyc3", 0); return err },
+		"resize to 0":      func() error { _, _, err := a.Resize(ctx, "506f78a4", 0, "nyc3"); return err },
+		"get no volume":    func() error { _, _, err := a.Get(ctx, "", 2); return err },
+		"get action 0":     func() error { _, _, err := a.Get(ctx, "506f78a4", 0); return err },
+		"list no volume":   func() error { _, _, err := a.List(ctx, "", nil); return err },
+	}
+	for name, call := range calls {
+		var verr *ValidationError
+		if err := call(); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
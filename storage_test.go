@@ -0,0 +1,181 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestStorage_ListVolumes(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/volumes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("region") != "nyc3" {
+			t.Errorf("expected volumes filtered by region, got %q", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"volumes":[{"id":"506f78a4","name":"example","size_gigabytes":10,"droplet_ids":[3],"created_at":"2024-01-02T03:04:05Z"}]}`)
+	})
+
+	volumes, _, err := c.Storage.ListVolumes(context.Background(), &VolumeListOptions{Region: "nyc3"})
+	if err != nil {
+		t.Fatalf("Storage.ListVolumes returned error: %v", err)
+	}
+	if len(volumes) != 1 || volumes[0].SizeGigaBytes != 10 || volumes[0].CreatedAt.Year() != 2024 {
+		t.Errorf("got volumes %+v", volumes)
+	}
+}
+
+func TestStorage_ListAllVolumes(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/volumes", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("region") != "nyc3" {
+			t.Errorf("expected the region on every page, got %q", r.URL.RawQuery)
+		}
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"volumes":[{"id":"b"}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"volumes":[{"id":"a"}],"links":{"pages":{"next":"https://api.example.com/v2/volumes?page=2&region=nyc3"}}}`)
+	})
+
+	volumes, _, err := c.Storage.ListAllVolumes(context.Background(), &VolumeListAllOptions{Region: "nyc3"})
+	if err != nil {
+		t.Fatalf("Storage.ListAllVolumes returned error: %v", err)
+	}
+	if len(volumes) != 2 || volumes[1].ID != "b" {
+		t.Errorf("got volumes %+v", volumes)
+	}
+}
+
+func TestStorage_volumes(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+
+	mux.HandleFunc("/v2/volumes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var req VolumeCreateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if req.Name != "example" || req.SizeGigaBytes != 10 || req.FilesystemType != FilesystemExt4 {
+			t.Errorf("got request %+v", req)
+		}
+		fmt.Fprint(w, `{"volume":{"id":"506f78a4","name":"example"}}`)
+	})
+	mux.HandleFunc("/v2/volumes/506f78a4", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"volume":{"id":"506f78a4","name":"example","filesystem_type":"ext4"}}`)
+	})
+
+	volume, _, err := c.Storage.CreateVolume(ctx, &VolumeCreateRequest{Region: "nyc3", Name: "example", SizeGigaBytes: 10, FilesystemType: FilesystemExt4})
+	if err != nil {
+		t.Fatalf("Storage.CreateVolume returned error: %v", err)
+	}
+	if volume.ID != "506f78a4" {
+		t.Errorf("got volume %+v", volume)
+	}
+	if volume, _, err = c.Storage.GetVolume(ctx, "506f78a4"); err != nil || volume.FilesystemType != FilesystemExt4 {
+		t.Errorf("Storage.GetVolume returned %+v, %v", volume, err)
+	}
+	if _, err := c.Storage.DeleteVolume(ctx, "506f78a4"); err != nil {
+		t.Errorf("Storage.DeleteVolume returned error: %v", err)
+	}
+}
+
+func TestStorage_snapshots(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+
+	mux.HandleFunc("/v2/volumes/506f78a4/snapshots", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			var req SnapshotCreateRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("decoding request body: %v", err)
+			}
+			fmt.Fprintf(w, `{"snapshot":{"id":"8fa70202","name":%q,"resource_id":%q}}`, req.Name, req.VolumeID)
+			return
+		}
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"snapshots":[{"id":"8fa70202","resource_type":"volume"}]}`)
+	})
+	mux.HandleFunc("/v2/snapshots/8fa70202", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		fmt.Fprint(w, `{"snapshot":{"id":"8fa70202","size_gigabytes":1.5}}`)
+	})
+
+	snapshots, _, err := c.Storage.ListSnapshots(ctx, "506f78a4", nil)
+	if err != nil {
+		t.Fatalf("Storage.ListSnapshots returned error: %v", err)
+	}
+	if len(snapshots) != 1 || snapshots[0].ResourceType != "volume" {
+		t.Errorf("got snapshots %+v", snapshots)
+	}
+
+	snapshot, _, err := c.Storage.CreateSnapshot(ctx, &SnapshotCreateRequest{VolumeID: "506f78a4", Name: "nightly"})
+	if err != nil {
+		t.Fatalf("Storage.CreateSnapshot returned error: %v", err)
+	}
+	if snapshot.Name != "nightly" || snapshot.ResourceID != "506f78a4" {
+		t.Errorf("got snapshot %+v", snapshot)
+	}
+
+	if snapshot, _, err = c.Storage.GetSnapshot(ctx, "8fa70202"); err != nil || snapshot.SizeGigaBytes != 1.5 {
+		t.Errorf("Storage.GetSnapshot returned %+v, %v", snapshot, err)
+	}
+	if _, err := c.Storage.DeleteSnapshot(ctx, "8fa70202"); err != nil {
+		t.Errorf("Storage.DeleteSnapshot returned error: %v", err)
+	}
+}
+
+func TestStorage_validation(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+	s := c.Storage
+
+	calls := map[string]func() error{
+		"create nil":        func() error { _, _, err := s.CreateVolume(ctx, nil); return err },
+		"create empty name": func() error { _, _, err := s.CreateVolume(ctx, &VolumeCreateRequest{SizeGigaBytes: 10}); return err },
+		"create no size":    func() error { _, _, err := s.CreateVolume(ctx, &VolumeCreateRequest{Name: "example"}); return err },
+		"create filesystem": func() error {
+			_, _, err := s.CreateVolume(ctx, &VolumeCreateRequest{Name: "example", SizeGigaBytes: 10, FilesystemType: "ntfs"})
+			return err
+		},
+		"get volume":      func() error { _, _, err := s.GetVolume(ctx, ""); return err },
+		"delete volume":   func() error { _, err := s.DeleteVolume(ctx, ""); return err },
+		"list snapshots":  func() error { _, _, err := s.ListSnapshots(ctx, "", nil); return err },
+		"snapshot nil":    func() error { _, _, err := s.CreateSnapshot(ctx, nil); return err },
+		"snapshot volume": func() error { _, _, err := s.CreateSnapshot(ctx, &SnapshotCreateRequest{Name: "nightly"}); return err },
+		"get snapshot":    func() error { _, _, err := s.GetSnapshot(ctx, ""); return err },
+		"delete snapshot": func() error { _, err := s.DeleteSnapshot(ctx, ""); return err },
+	}
+	for name, call := range calls {
+		var verr *ValidationError
+		if err := call(); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
+
+func TestStorage_CreateVolume_fromSnapshot(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/volumes", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"volume":{"id":"506f78a4"}}`)
+	})
+
+	if _, _, err := c.Storage.CreateVolume(context.Background(), &VolumeCreateRequest{Name: "example", SnapshotID: "8fa70202"}); err != nil {
+		t.Errorf("expected the size optional with a snapshot, got %v", err)
+	}
+}
@@ -0,0 +1,131 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	headerRetryAfter = "Retry-After"
+
+	// defaultRateLimitRetryDelay is used when a rate limited response carries
+	// no hint about when to retry.
+	defaultRateLimitRetryDelay = time.Second
+)
+
+// RateLimitStrategy decides how Do handles requests rejected by the API with
+// 429 Too Many Requests.
+type RateLimitStrategy struct {
+	// MaxRetries is the number of times a rate limited request is retried.
+	// Zero returns the RateLimitError right away.
+	MaxRetries int
+
+	// Backoff is the delay before the first retry, doubled for every further
+	// retry. When zero, Do waits until the time given by the Retry-After or
+	// RateLimit-Reset headers instead.
+	Backoff time.Duration
+}
+
+var (
+	// RateLimitFailFast returns the RateLimitError without retrying. It is the
+	// default strategy.
+	RateLimitFailFast = RateLimitStrategy{}
+
+	// RateLimitWaitAndRetryOnce waits until the rate limit resets and retries
+	// the request once.
+	RateLimitWaitAndRetryOnce = RateLimitStrategy{MaxRetries: 1}
+)
+
+// RateLimitRetryWithBackoff retries a rate limited request up to maxRetries
+// times, waiting backoff before the first retry and doubling it after each.
+func RateLimitRetryWithBackoff(maxRetries int, backoff time.Duration) RateLimitStrategy {
+	return RateLimitStrategy{MaxRetries: maxRetries, Backoff: backoff}
+}
+
+// SetRateLimitStrategy is a client option for setting how requests rejected
+// with 429 Too Many Requests are handled.
+func SetRateLimitStrategy(strategy RateLimitStrategy) ClientOpt {
+	return func(c *Client) error {
+		if strategy.MaxRetries < 0 || strategy.Backoff < 0 {
+			return fmt.Errorf("invalid rate limit strategy %+v", strategy)
+		}
+		c.rateLimitStrategy = strategy
+		return nil
+	}
+}
+
+type rateLimitStrategyContextKey struct{}
+
+// WithRateLimitStrategy returns a copy of ctx which overrides the rate limit
+// strategy of the client for requests made with it.
+func WithRateLimitStrategy(ctx context.Context, strategy RateLimitStrategy) context.Context {
+	return context.WithValue(ctx, rateLimitStrategyContextKey{}, strategy)
+}
+
+// rateLimitStrategyFor returns the strategy set on ctx, if any, or the one of
+// the client.
+func (c *Client) rateLimitStrategyFor(ctx context.Context) RateLimitStrategy {
+	if strategy, ok := ctx.Value(rateLimitStrategyContextKey{}).(RateLimitStrategy); ok {
+		return strategy
+	}
+	return c.rateLimitStrategy
+}
+
+type attemptContextKey struct{}
+
+// withAttempt returns a copy of ctx recording the zero based attempt number
+// of the request sent with it.
+func withAttempt(ctx context.Context, attempt int) context.Context {
+	if attempt == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, attemptContextKey{}, attempt)
+}
+
+// RequestAttempt returns the zero based attempt number of a request sent by
+// Do, so middleware can tell retries from first attempts.
+func RequestAttempt(req *http.Request) int {
+	attempt, _ := req.Context().Value(attemptContextKey{}).(int)
+	return attempt
+}
+
+// delay returns how long to wait before retrying a request which failed with
+// err on the given zero based attempt.
+func (s RateLimitStrategy) delay(attempt int, err *RateLimitError) time.Duration {
+	if s.Backoff > 0 {
+		return s.Backoff << uint(attempt)
+	}
+
+	if after := err.Response.Header.Get(headerRetryAfter); after != "" {
+		if seconds, perr := strconv.Atoi(after); perr == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second
+		}
+	}
+	if reset := err.Rate.Reset; !reset.IsZero() {
+		if d := time.Until(reset.Time); d > 0 {
+			return d
+		}
+		return 0
+	}
+	return defaultRateLimitRetryDelay
+}
+
+// sleep pauses for d or until ctx is done, whichever happens first.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRateLimitStrategy_delay(t *testing.T) {
+	rateLimited := func(header http.Header, rate Rate) *RateLimitError {
+		return &RateLimitError{Rate: rate, Response: &http.Response{Header: header}}
+	}
+
+	backoff := RateLimitRetryWithBackoff(3, 100*time.Millisecond)
+	for attempt, want := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond} {
+		if got := backoff.delay(attempt, rateLimited(http.Header{}, Rate{})); got != want {
+			t.Errorf("backoff attempt %d: got %v, want %v", attempt, got, want)
+		}
+	}
+
+	wait := RateLimitWaitAndRetryOnce
+	if got := wait.delay(0, rateLimited(http.Header{headerRetryAfter: {"7"}}, Rate{})); got != 7*time.Second {
+		t.Errorf("Retry-After: got %v, want 7s", got)
+	}
+
+	reset := Rate{Reset: Timestamp{time.Now().Add(time.Minute)}}
+	if got := wait.delay(0, rateLimited(http.Header{}, reset)); got <= 50*time.Second || got > time.Minute {
+		t.Errorf("RateLimit-Reset: got %v, want about a minute", got)
+	}
+
+	past := Rate{Reset: Timestamp{time.Now().Add(-time.Minute)}}
+	if got := wait.delay(0, rateLimited(http.Header{}, past)); got != 0 {
+		t.Errorf("past reset: got %v, want 0", got)
+	}
+
+	if got := wait.delay(0, rateLimited(http.Header{}, Rate{})); got != defaultRateLimitRetryDelay {
+		t.Errorf("no hint: got %v, want %v", got, defaultRateLimitRetryDelay)
+	}
+}
+
+func TestSetRateLimitStrategy_invalid(t *testing.T) {
+	for _, strategy := range []RateLimitStrategy{{MaxRetries: -1}, {Backoff: -time.Second}} {
+		if _, err := New(nil, SetRateLimitStrategy(strategy)); err == nil {
+			t.Errorf("expected an error for %+v", strategy)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// bodyDecoder is implemented by values passed to Client.Do which decode the
+// response body themselves while it is being read.
+type bodyDecoder interface {
+	decodeBody(body io.Reader, resp *Response) error
+}
+
+// DoStream sends an API request whose response wraps a list in a root key,
+// e.g. {"tags": [...]}, and calls fn with every element of the list as soon
+// as it is decoded, instead of materializing the whole page in memory. Links
+// and Meta returned next to the list are set on the Response. Returning an
+// error from fn stops decoding and DoStream returns that error.
+func DoStream[T any](ctx context.Context, c *Client, req *http.Request, key string, fn func(T) error) (*Response, error) {
+	return c.Do(ctx, req, &streamDecoder[T]{key: key, fn: fn})
+}
+
+// streamDecoder decodes the elements of the list stored under key one by one.
+type streamDecoder[T any] struct {
+	key string
+	fn  func(T) error
+}
+
+func (d *streamDecoder[T]) decodeBody(body io.Reader, resp *Response) error {
+	dec := json.NewDecoder(body)
+	if err := expectDelim(dec, '{'); err != nil {
+		if err == io.EOF {
+			return nil
+		}
+		return err
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, _ := tok.(string)
+
+		switch key {
+		case d.key:
+			if err := d.decodeList(dec); err != nil {
+				return err
+			}
+		case envelopeLinksKey:
+			links := new(Links)
+			if err := dec.Decode(links); err != nil {
+				return err
+			}
+			resp.Links = links
+		case envelopeMetaKey:
+			meta := new(Meta)
+			if err := dec.Decode(meta); err != nil {
+				return err
+			}
+			resp.Meta = meta
+		default:
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return err
+			}
+		}
+	}
+
+	return expectDelim(dec, '}')
+}
+
+// decodeList decodes the elements of a JSON array and passes them to fn.
+func (d *streamDecoder[T]) decodeList(dec *json.Decoder) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		// null list
+		return nil
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected %q to hold a JSON array, got %v", d.key, tok)
+	}
+
+	for dec.More() {
+		var item T
+		if err := dec.Decode(&item); err != nil {
+			return err
+		}
+		if err := d.fn(item); err != nil {
+			return err
+		}
+	}
+
+	return expectDelim(dec, ']')
+}
+
+// expectDelim reads the next token from dec and checks it is delim.
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != delim {
+		return fmt.Errorf("expected JSON %v, got %v", delim, tok)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestTags_ListStream(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/tags", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("page"); got != "2" {
+			t.Errorf("expected page 2 requested, got %q", got)
+		}
+		fmt.Fprint(w, `{
+			"ignored": {"nested": [1, 2]},
+			"tags": [{"name": "a"}, {"name": "b"}],
+			"links": {"pages": {"prev": "https://api.example.com/v2/tags?page=1", "next": "https://api.example.com/v2/tags?page=3"}},
+			"meta": {"total": 5}
+		}`)
+	})
+
+	var names []string
+	resp, err := c.Tags.ListStream(context.Background(), &ListOptions{Page: 2}, func(tag Tag) error {
+		names = append(names, tag.Name)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ListStream returned error: %v", err)
+	}
+	if fmt.Sprint(names) != "[a b]" {
+		t.Errorf("got tags %v", names)
+	}
+	if resp.Meta == nil || resp.Meta.Total != 5 {
+		t.Errorf("expected meta total 5, got %+v", resp.Meta)
+	}
+	if page, _ := resp.Links.CurrentPage(); page != 2 {
+		t.Errorf("expected the links decoded, got page %d", page)
+	}
+}
+
+func TestTags_ListStream_stop(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/tags", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"tags": [{"name": "a"}, {"name": "b"}, {"name": "c"}]}`)
+	})
+
+	stop := errors.New("stop")
+	var names []string
+	_, err := c.Tags.ListStream(context.Background(), nil, func(tag Tag) error {
+		names = append(names, tag.Name)
+		if tag.Name == "b" {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) {
+		t.Errorf("expected the error of fn, got %v", err)
+	}
+	if fmt.Sprint(names) != "[a b]" {
+		t.Errorf("expected decoding stopped after b, got %v", names)
+	}
+}
+
+func TestDoStream_emptyAndNull(t *testing.T) {
+	c, mux := setup(t)
+
+	bodies := map[string]string{"/v2/empty": "", "/v2/null": `{"items": null}`}
+	for path, body := range bodies {
+		body := body
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, body)
+		})
+	}
+
+	for path := range bodies {
+		req, err := c.NewRequest(context.Background(), http.MethodGet, path, nil)
+		if err != nil {
+			t.Fatalf("NewRequest returned error: %v", err)
+		}
+		_, err = DoStream(context.Background(), c, req, "items", func(item int) error {
+			t.Errorf("%s: unexpected item %d", path, item)
+			return nil
+		})
+		if err != nil {
+			t.Errorf("%s: DoStream returned error: %v", path, err)
+		}
+	}
+}
+
+func TestDoStream_notAList(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/items", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"items": {"id": 1}}`)
+	})
+
+	req, err := c.NewRequest(context.Background(), http.MethodGet, "/v2/items", nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	if _, err := DoStream(context.Background(), c, req, "items", func(item int) error { return nil }); err == nil {
+		t.Error("expected an error for an object instead of a list")
+	}
+}
@@ -2,14 +2,36 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 )
 
 const (
 	tagsBasePath = "v2/tags"
 )
 
+// tagsSortFields are the fields tags can be sorted by.
+var tagsSortFields = []string{"name"}
+
+// maxTagNameLength is the maximum length of tag names accepted by the API.
+const maxTagNameLength = 255
+
+// maxTagResourcesBatch is the number of resources tagged or untagged with a
+// single request by the bulk helpers.
+const maxTagResourcesBatch = 50
+
+// taggedResourceTypes are the types of resources which can be listed by tag,
+// in the order Tags.Resources lists them.
+var taggedResourceTypes = []ResourceType{DropletResourceType, ImageResourceType, VolumeResourceType}
+
+// tagNamePattern matches the characters allowed in tag names.
+var tagNamePattern = regexp.MustCompile(`^[a-zA-Z0-9_\-:]+$`)
+
 /*  Objects */
 type ResourceType string
 
@@ -18,16 +40,166 @@ const (
 	DropletResourceType ResourceType = "droplet"
 	// ImageResourceType holds the string representing our ResourceType of Image.
 	ImageResourceType ResourceType = "image"
+	// VolumeResourceType holds the string representing our ResourceType of Volume.
+	VolumeResourceType ResourceType = "volume"
+	// VolumeSnapshotResourceType holds the string representing our ResourceType for storage Snapshots.
+	VolumeSnapshotResourceType ResourceType = "volume_snapshot"
+	// DatabaseResourceType holds the string representing our ResourceType of Database.
+	DatabaseResourceType ResourceType = "database"
+	// ReservedIPResourceType holds the string representing our ResourceType of ReservedIP.
+	ReservedIPResourceType ResourceType = "reservedip"
+	// KubernetesResourceType holds the string representing our ResourceType of Kubernetes cluster.
+	KubernetesResourceType ResourceType = "kubernetes"
 )
 
+// urnPrefix is the namespace of resource URNs, e.g. "do:droplet:13457723".
+const urnPrefix = "do"
+
+// urnResourceTypes maps the collections of URNs to the types of the resources
+// they identify, which differ for some, e.g. databases.
+var urnResourceTypes = map[string]ResourceType{
+	"droplet":    DropletResourceType,
+	"image":      ImageResourceType,
+	"volume":     VolumeResourceType,
+	"dbaas":      DatabaseResourceType,
+	"reservedip": ReservedIPResourceType,
+	"floatingip": ReservedIPResourceType,
+	"kubernetes": KubernetesResourceType,
+}
+
+// resourceTypeURNCollections maps the types of resources to the collections
+// of their URNs where the two differ.
+var resourceTypeURNCollections = map[ResourceType]string{
+	DatabaseResourceType: "dbaas",
+}
+
 type Resource struct {
 	ID   string       `json:"resource_id,omitempty"`
 	Type ResourceType `json:"resource_type,omitempty"`
 }
 
+// ResourceFromURN returns the resource identified by urn, e.g.
+// "do:droplet:13457723", see URN.Resource.
+func ResourceFromURN(urn string) (Resource, error) {
+	u, err := ParseURN(urn)
+	if err != nil {
+		return Resource{}, err
+	}
+	return u.Resource()
+}
+
+// URN returns the uniform resource name of the resource.
+func (r Resource) URN() string {
+	collection, ok := resourceTypeURNCollections[r.Type]
+	if !ok {
+		collection = string(r.Type)
+	}
+	return fmt.Sprintf("%s:%s:%s", urnPrefix, collection, r.ID)
+}
+
+// URN is the uniform resource name of a resource, e.g. "do:droplet:13457723"
+// or "do:volume:506f78a4-e098-11e5-ad9f-000f53306ae1", made of the "do"
+// namespace, the collection of the resource and its ID.
+type URN string
+
+// ParseURN parses s as a URN, checking its namespace and that the collection
+// and ID are not empty.
+func ParseURN(s string) (URN, error) {
+	parts := strings.SplitN(s, ":", 3)
+	if len(parts) != 3 || parts[0] != urnPrefix || parts[1] == "" || parts[2] == "" {
+		return "", &ValidationError{Field: "urn", Value: s, Reason: "must have the form do:<collection>:<id>"}
+	}
+	return URN(s), nil
+}
+
+// Collection returns the collection of the resource, e.g. "droplet".
+func (u URN) Collection() string {
+	return u.part(1)
+}
+
+// ID returns the ID of the resource within its collection.
+func (u URN) ID() string {
+	return u.part(2)
+}
+
+// Resource returns the resource identified by the URN, e.g. a resource of
+// type DatabaseResourceType for "do:dbaas:<id>". URNs of collections which are
+// not resources that can be tagged, e.g. apps, are rejected.
+func (u URN) Resource() (Resource, error) {
+	typ, ok := urnResourceTypes[u.Collection()]
+	if !ok {
+		return Resource{}, &ValidationError{Field: "urn", Value: string(u), Reason: fmt.Sprintf("collection %q is not a resource type", u.Collection())}
+	}
+	return Resource{ID: u.ID(), Type: typ}, nil
+}
+
+func (u URN) part(i int) string {
+	parts := strings.SplitN(string(u), ":", 3)
+	if len(parts) != 3 {
+		return ""
+	}
+	return parts[i]
+}
+
+// Tag represent DigitalOcean tag
 type Tag struct {
-	Name      string      `json:"name,omitempty"`
-	Resources []*Resource `json:"resources,omitempty"`
+	Name      string           `json:"name,omitempty"`
+	Resources *TaggedResources `json:"resources,omitempty"`
+}
+
+// TaggedResources represent the set of resources a tag is attached to
+type TaggedResources struct {
+	Count           int                  `json:"count"`
+	LastTaggedURI   string               `json:"last_tagged_uri,omitempty"`
+	Droplets        *TaggedResourcesData `json:"droplets,omitempty"`
+	Images          *TaggedResourcesData `json:"images,omitempty"`
+	Volumes         *TaggedResourcesData `json:"volumes,omitempty"`
+	VolumeSnapshots *TaggedResourcesData `json:"volume_snapshots,omitempty"`
+	Databases       *TaggedResourcesData `json:"databases,omitempty"`
+}
+
+// TaggedResourcesData represent the number of resources of one type a tag is
+// attached to
+type TaggedResourcesData struct {
+	Count         int    `json:"count,omitempty"`
+	LastTaggedURI string `json:"last_tagged_uri,omitempty"`
+}
+
+// TagCreateRequest represents the request to create a new tag.
+type TagCreateRequest struct {
+	Name string `json:"name"`
+}
+
+// TagUpdateRequest represents the request to update a tag.
+type TagUpdateRequest struct {
+	Name string `json:"name"`
+}
+
+// TagResourcesRequest represents the request to tag resources. Resources
+// can be given as URNs, which are tagged in addition to Resources.
+type TagResourcesRequest struct {
+	Resources []Resource `json:"resources"`
+	URNs      []URN      `json:"-"`
+}
+
+// UntagResourcesRequest represents the request to untag resources, see
+// TagResourcesRequest.
+type UntagResourcesRequest struct {
+	Resources []Resource `json:"resources"`
+	URNs      []URN      `json:"-"`
+}
+
+// TagResult is the outcome of a bulk operation for one tag.
+type TagResult struct {
+	Name string
+	Tag  *Tag
+	Err  error
+}
+
+// ResourceResult is the outcome of a bulk operation for one resource.
+type ResourceResult struct {
+	Resource Resource
+	Err      error
 }
 
 type TagsReply struct {
@@ -41,9 +213,20 @@ type TagsReply struct {
 // endpoints of the DigitalOcean API
 type TagsService interface {
 	List(context.Context, *ListOptions) ([]Tag, *Response, error)
+	ListAll(context.Context, *ListAllOptions) ([]Tag, *Response, error)
+	ListStream(context.Context, *ListOptions, func(Tag) error) (*Response, error)
+	Count(context.Context) (int, *Response, error)
 	Get(context.Context, string) (*Tag, *Response, error)
-	Create(context.Context, string) (*Tag, *Response, error)
+	Create(context.Context, *TagCreateRequest) (*Tag, *Response, error)
 	Delete(context.Context, string) (*Response, error)
+	Update(context.Context, string, *TagUpdateRequest) (*Tag, *Response, error)
+	Resources(context.Context, string, *ListOptions, ResourceType) ([]Resource, *Response, error)
+	TagResources(context.Context, string, *TagResourcesRequest) (*Response, error)
+	UntagResources(context.Context, string, *UntagResourcesRequest) (*Response, error)
+	Ensure(context.Context, string) (*Tag, *Response, error)
+	EnsureAll(context.Context, []string) ([]TagResult, error)
+	TagResourcesBulk(context.Context, string, []Resource) ([]ResourceResult, error)
+	UntagResourcesBulk(context.Context, string, []Resource) ([]ResourceResult, error)
 }
 
 // TagsServiceOp handles communication with tag related method of the
@@ -56,6 +239,10 @@ var _ TagsService = &TagsServiceOp{}
 
 // List all tags
 func (s *TagsServiceOp) List(ctx context.Context, opt *ListOptions) ([]Tag, *Response, error) {
+	if err := opt.validateSort(tagsSortFields...); err != nil {
+		return nil, nil, err
+	}
+
 	path := tagsBasePath
 	path, err := addOptions(path, opt)
 
@@ -68,24 +255,50 @@ func (s *TagsServiceOp) List(ctx context.Context, opt *ListOptions) ([]Tag, *Res
 		return nil, nil, err
 	}
 
-	root := new(tagsRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	tags, resp, err := DoEnvelope[[]Tag](ctx, s.client, req, "tags")
 	if err != nil {
 		return nil, resp, err
 	}
-	if l := root.Links; l != nil {
-		resp.Links = l
+
+	return *tags, resp, err
+}
+
+// ListAll tags across all pages
+func (s *TagsServiceOp) ListAll(ctx context.Context, opt *ListAllOptions) ([]Tag, *Response, error) {
+	return ListAll[Tag](ctx, s.List, opt)
+}
+
+// ListStream calls fn with each tag of a page as it is decoded, without
+// holding the whole page in memory
+func (s *TagsServiceOp) ListStream(ctx context.Context, opt *ListOptions, fn func(Tag) error) (*Response, error) {
+	if err := opt.validateSort(tagsSortFields...); err != nil {
+		return nil, err
 	}
-	if m := root.Meta; m != nil {
-		resp.Meta = m
+
+	path, err := addOptions(tagsBasePath, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return root.Tags, resp, err
+	return DoStream(ctx, s.client, req, "tags", fn)
 }
 
-// Get a single tag
+// Count returns the total number of tags
+func (s *TagsServiceOp) Count(ctx context.Context) (int, *Response, error) {
+	return Count[Tag](ctx, s.List, nil)
+}
 
+// Get a single tag. Errors for tags which do not exist match ErrNotFound.
 func (s *TagsServiceOp) Get(ctx context.Context, name string) (*Tag, *Response, error) {
+	if err := validateTagName(name); err != nil {
+		return nil, nil, err
+	}
+
 	path := fmt.Sprintf("%s/%s", tagsBasePath, name)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -93,21 +306,384 @@ func (s *TagsServiceOp) Get(ctx context.Context, name string) (*Tag, *Response,
 		return nil, nil, err
 	}
 
-	root := new(tagRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	tag, resp, err := DoEnvelope[Tag](ctx, s.client, req, "tag")
 	if err != nil {
 		return nil, resp, err
 	}
 
-	return root.Tag, resp, err
+	return tag, resp, err
 }
 
 // Create a new tag
-func (s *TagsServiceOp) Create(ctx context.Context, some string) (*Tag, *Response, error) {
-	return nil, nil, nil
+func (s *TagsServiceOp) Create(ctx context.Context, createRequest *TagCreateRequest) (*Tag, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, &ValidationError{Field: "createRequest", Reason: "cannot be nil"}
+	}
+	if err := validateTagName(createRequest.Name); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, tagsBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tag, resp, err := DoEnvelope[Tag](ctx, s.client, req, "tag")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return tag, resp, err
+}
+
+// Delete an existing tag. Errors for tags which do not exist match
+// ErrNotFound; the Response is returned in either case.
+func (s *TagsServiceOp) Delete(ctx context.Context, name string) (*Response, error) {
+	if err := validateTagName(name); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("%s/%s", tagsBasePath, name)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
 }
 
-// Delete an existing tag
-func (s *TagsServiceOp) Delete(ctx context.Context, some string) (*Response, error) {
-	return nil, nil
+// Update renames a tag. When the API does not support renaming tags in place
+// and responds 405 Method Not Allowed, the tag is renamed with RenameTag.
+func (s *TagsServiceOp) Update(ctx context.Context, name string, updateRequest *TagUpdateRequest) (*Tag, *Response, error) {
+	if err := validateTagName(name); err != nil {
+		return nil, nil, err
+	}
+	if updateRequest == nil {
+		return nil, nil, &ValidationError{Field: "updateRequest", Reason: "cannot be nil"}
+	}
+	if err := validateTagName(updateRequest.Name); err != nil {
+		return nil, nil, err
+	}
+
+	path := fmt.Sprintf("%s/%s", tagsBasePath, name)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, updateRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tag, resp, err := DoEnvelope[Tag](ctx, s.client, req, "tag")
+	if resp != nil && resp.StatusCode == http.StatusMethodNotAllowed {
+		return RenameTag(ctx, s.client, name, updateRequest.Name)
+	}
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return tag, resp, err
+}
+
+// RenameTag renames the tag oldName to newName for APIs which do not support
+// renaming in place: it creates the new tag, tags the resources of the old
+// tag with it and deletes the old tag. The operation is not atomic, and when
+// it fails part way the returned error names the step which failed; both tags
+// may then exist. The Response is the one of the last request made.
+//
+// Only droplets, images, volumes and databases can be listed by tag, so tags
+// carrying other resources, e.g. volume snapshots, are not renamed.
+func RenameTag(ctx context.Context, c *Client, oldName, newName string) (*Tag, *Response, error) {
+	resources, resp, err := taggedResources(ctx, c, oldName)
+	if err != nil {
+		return nil, resp, fmt.Errorf("renaming tag %q: listing its resources: %w", oldName, err)
+	}
+
+	tag, resp, err := c.Tags.Create(ctx, &TagCreateRequest{Name: newName})
+	if err != nil {
+		return nil, resp, fmt.Errorf("renaming tag %q: creating %q: %w", oldName, newName, err)
+	}
+
+	if len(resources) > 0 {
+		if _, err := c.Tags.TagResourcesBulk(ctx, newName, resources); err != nil {
+			return tag, resp, fmt.Errorf("renaming tag %q: tagging resources with %q: %w", oldName, newName, err)
+		}
+	}
+
+	resp, err = c.Tags.Delete(ctx, oldName)
+	if err != nil {
+		return tag, resp, fmt.Errorf("renaming tag %q: deleting it: %w", oldName, err)
+	}
+
+	return tag, resp, nil
+}
+
+// taggedResources lists the resources carrying the named tag with
+// Tags.Resources and the databases filtered by it, and checks them against
+// the resource count of the tag.
+func taggedResources(ctx context.Context, c *Client, name string) ([]Resource, *Response, error) {
+	tag, resp, err := c.Tags.Get(ctx, name)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resources, resp, err := ListAll[Resource](ctx, func(ctx context.Context, opt *ListOptions) ([]Resource, *Response, error) {
+		return c.Tags.Resources(ctx, name, opt, "")
+	}, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	databases, resp, err := ListAll[Database](ctx, func(ctx context.Context, opt *ListOptions) ([]Database, *Response, error) {
+		var o ListOptions
+		if opt != nil {
+			o = *opt
+		}
+		o.TagName = name
+		return c.Databases.List(ctx, &o)
+	}, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+	for _, d := range databases {
+		resources = append(resources, Resource{ID: d.ID, Type: DatabaseResourceType})
+	}
+
+	if tag.Resources != nil && len(resources) < tag.Resources.Count {
+		return nil, resp, fmt.Errorf("tag %q carries %d resources but only %d droplets, images, volumes and databases can be listed by tag",
+			name, tag.Resources.Count, len(resources))
+	}
+	return resources, resp, nil
+}
+
+// Resources lists a page of the droplets, images and volumes carrying the
+// named tag. The API has no endpoint listing the resources of a tag, so they
+// are listed from the endpoints of each type filtering by tag, and opt selects
+// the same page of each. When typeFilter is not empty only resources of that
+// type are listed; otherwise the Response is one of a type with further
+// pages, so that a Paginator walks every type to its end.
+func (s *TagsServiceOp) Resources(ctx context.Context, name string, opt *ListOptions, typeFilter ResourceType) ([]Resource, *Response, error) {
+	if err := validateTagName(name); err != nil {
+		return nil, nil, err
+	}
+
+	types := taggedResourceTypes
+	if typeFilter != "" {
+		if !slices.Contains(taggedResourceTypes, typeFilter) {
+			return nil, nil, &ValidationError{Field: "typeFilter", Value: string(typeFilter), Reason: fmt.Sprintf("expected one of %q", taggedResourceTypes)}
+		}
+		types = []ResourceType{typeFilter}
+	}
+
+	var o ListOptions
+	if opt != nil {
+		o = *opt
+	}
+
+	var resources []Resource
+	var resp *Response
+	for _, typ := range types {
+		page, typeResp, err := s.taggedResourcesOfType(ctx, name, o, typ)
+		if err != nil {
+			return nil, typeResp, err
+		}
+		resources = append(resources, page...)
+		if resp == nil || resp.Links.IsLastPage() {
+			resp = typeResp
+		}
+	}
+
+	return resources, resp, nil
+}
+
+// taggedResourcesOfType lists a page of the resources of one type carrying
+// the named tag.
+func (s *TagsServiceOp) taggedResourcesOfType(ctx context.Context, name string, opt ListOptions, typ ResourceType) ([]Resource, *Response, error) {
+	var resources []Resource
+	switch typ {
+	case DropletResourceType:
+		droplets, resp, err := s.client.Droplets.ListByTag(ctx, name, &opt)
+		for _, d := range droplets {
+			resources = append(resources, Resource{ID: strconv.Itoa(d.ID), Type: typ})
+		}
+		return resources, resp, err
+	case ImageResourceType:
+		images, resp, err := s.client.Images.ListByTag(ctx, name, &opt)
+		for _, i := range images {
+			resources = append(resources, Resource{ID: strconv.Itoa(i.ID), Type: typ})
+		}
+		return resources, resp, err
+	default:
+		opt.TagName = name
+		volumes, resp, err := s.client.Storage.ListVolumes(ctx, &VolumeListOptions{ListOptions: opt})
+		for _, v := range volumes {
+			resources = append(resources, Resource{ID: v.ID, Type: typ})
+		}
+		return resources, resp, err
+	}
+}
+
+// TagResources associates resources with a given Tag.
+func (s *TagsServiceOp) TagResources(ctx context.Context, name string, tagRequest *TagResourcesRequest) (*Response, error) {
+	if err := validateTagName(name); err != nil {
+		return nil, err
+	}
+	if tagRequest == nil {
+		return nil, &ValidationError{Field: "tagRequest", Reason: "cannot be nil"}
+	}
+	resources, err := resourcesWithURNs(tagRequest.Resources, tagRequest.URNs)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("%s/%s/resources", tagsBasePath, name)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, &TagResourcesRequest{Resources: resources})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// UntagResources dissociates resources from a given Tag.
+func (s *TagsServiceOp) UntagResources(ctx context.Context, name string, untagRequest *UntagResourcesRequest) (*Response, error) {
+	if err := validateTagName(name); err != nil {
+		return nil, err
+	}
+	if untagRequest == nil {
+		return nil, &ValidationError{Field: "untagRequest", Reason: "cannot be nil"}
+	}
+	resources, err := resourcesWithURNs(untagRequest.Resources, untagRequest.URNs)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("%s/%s/resources", tagsBasePath, name)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, &UntagResourcesRequest{Resources: resources})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// EnsureAll makes sure the named tags exist, creating the missing ones. It
+// reports the result for each name in order, and returns an error joining the
+// errors of all names which failed.
+func (s *TagsServiceOp) EnsureAll(ctx context.Context, names []string) ([]TagResult, error) {
+	results := make([]TagResult, len(names))
+	runBounded(ctx, len(names), bulkConcurrency, func(i int) {
+		tag, _, err := s.Ensure(ctx, names[i])
+		results[i] = TagResult{Name: names[i], Tag: tag, Err: err}
+	}, func(i int, err error) {
+		results[i] = TagResult{Name: names[i], Err: err}
+	})
+
+	errs := make([]error, 0, len(results))
+	for _, r := range results {
+		if r.Err != nil {
+			errs = append(errs, fmt.Errorf("tag %q: %w", r.Name, r.Err))
+		}
+	}
+	return results, errors.Join(errs...)
+}
+
+// Ensure returns the named tag, creating it if it does not exist. A 422
+// response because the tag was created concurrently is treated as success.
+func (s *TagsServiceOp) Ensure(ctx context.Context, name string) (*Tag, *Response, error) {
+	tag, resp, err := s.Get(ctx, name)
+	if !errors.Is(err, ErrNotFound) {
+		return tag, resp, err
+	}
+
+	tag, resp, err = s.Create(ctx, &TagCreateRequest{Name: name})
+	if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity {
+		return s.Get(ctx, name)
+	}
+	return tag, resp, err
+}
+
+// TagResourcesBulk tags any number of resources with the named tag, batching
+// them into requests sent concurrently. It reports the result for each
+// resource in order, and returns an error joining the errors of all batches
+// which failed.
+func (s *TagsServiceOp) TagResourcesBulk(ctx context.Context, name string, resources []Resource) ([]ResourceResult, error) {
+	return bulkResources(ctx, resources, func(batch []Resource) error {
+		_, err := s.TagResources(ctx, name, &TagResourcesRequest{Resources: batch})
+		return err
+	})
+}
+
+// UntagResourcesBulk untags any number of resources from the named tag, see
+// TagResourcesBulk.
+func (s *TagsServiceOp) UntagResourcesBulk(ctx context.Context, name string, resources []Resource) ([]ResourceResult, error) {
+	return bulkResources(ctx, resources, func(batch []Resource) error {
+		_, err := s.UntagResources(ctx, name, &UntagResourcesRequest{Resources: batch})
+		return err
+	})
+}
+
+// bulkResources calls fn with batches of resources and reports the error of
+// each batch for all of its resources.
+func bulkResources(ctx context.Context, resources []Resource, fn func([]Resource) error) ([]ResourceResult, error) {
+	results := make([]ResourceResult, len(resources))
+	for i, r := range resources {
+		results[i].Resource = r
+	}
+
+	b := batches(resources, maxTagResourcesBatch)
+	errs := make([]error, len(b))
+	runBounded(ctx, len(b), bulkConcurrency, func(i int) {
+		errs[i] = fn(b[i])
+	}, func(i int, err error) {
+		errs[i] = err
+	})
+
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+		for j := range b[i] {
+			results[i*maxTagResourcesBatch+j].Err = err
+		}
+	}
+	return results, errors.Join(errs...)
+}
+
+// resourcesWithURNs returns resources followed by the resources identified
+// by urns, which are validated with ParseURN.
+func resourcesWithURNs(resources []Resource, urns []URN) ([]Resource, error) {
+	if len(urns) == 0 {
+		return resources, nil
+	}
+
+	all := make([]Resource, 0, len(resources)+len(urns))
+	all = append(all, resources...)
+	for _, urn := range urns {
+		u, err := ParseURN(string(urn))
+		if err != nil {
+			return nil, err
+		}
+		r, err := u.Resource()
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, r)
+	}
+	return all, nil
+}
+
+// validateTagName checks name against the rules of the API before it is sent,
+// returning a *ValidationError if it breaks them.
+func validateTagName(name string) error {
+	switch {
+	case name == "":
+		return &ValidationError{Field: "tag name", Reason: "must not be empty"}
+	case len(name) > maxTagNameLength:
+		return &ValidationError{Field: "tag name", Reason: fmt.Sprintf("exceeds %d characters", maxTagNameLength)}
+	case !tagNamePattern.MatchString(name):
+		return &ValidationError{Field: "tag name", Value: name, Reason: "may only contain letters, numbers, colons, dashes and underscores"}
+	}
+	return nil
 }
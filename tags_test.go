@@ -0,0 +1,720 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// setupTaggedResources registers handlers for a tag "old" carrying two
+// droplets, an image, a volume and a database, plus extra resources which can
+// not be listed by tag.
+func setupTaggedResources(t *testing.T, mux *http.ServeMux, extra int) {
+	t.Helper()
+
+	mux.HandleFunc("/v2/tags/old", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			fmt.Fprint(w, `{"id":"method_not_allowed","message":"not allowed"}`)
+		case http.MethodGet:
+			fmt.Fprintf(w, `{"tag":{"name":"old","resources":{"count":%d}}}`, 5+extra)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("tag_name"); got != "old" {
+			t.Errorf("expected droplets listed by tag old, got %q", got)
+		}
+		fmt.Fprint(w, `{"droplets":[{"id":1},{"id":2}]}`)
+	})
+	mux.HandleFunc("/v2/images", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("tag_name"); got != "old" {
+			t.Errorf("expected images listed by tag old, got %q", got)
+		}
+		fmt.Fprint(w, `{"images":[{"id":3}]}`)
+	})
+	mux.HandleFunc("/v2/volumes", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("tag_name"); got != "old" {
+			t.Errorf("expected volumes listed by tag old, got %q", got)
+		}
+		fmt.Fprint(w, `{"volumes":[{"id":"vol-1"}]}`)
+	})
+	mux.HandleFunc("/v2/databases", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("tag_name"); got != "old" {
+			t.Errorf("expected databases listed by tag old, got %q", got)
+		}
+		fmt.Fprint(w, `{"databases":[{"id":"db-1"}]}`)
+	})
+}
+
+func TestTags_Update_renameFallback(t *testing.T) {
+	c, mux := setup(t)
+	setupTaggedResources(t, mux, 0)
+
+	var tagged []string
+	mux.HandleFunc("/v2/tags", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"tag":{"name":"new"}}`)
+	})
+	mux.HandleFunc("/v2/tags/new/resources", func(w http.ResponseWriter, r *http.Request) {
+		var req TagResourcesRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decoding request: %v", err)
+		}
+		for _, res := range req.Resources {
+			tagged = append(tagged, string(res.Type)+":"+res.ID)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	tag, _, err := c.Tags.Update(context.Background(), "old", &TagUpdateRequest{Name: "new"})
+	if err != nil {
+		t.Fatalf("Tags.Update returned error: %v", err)
+	}
+	if tag.Name != "new" {
+		t.Errorf("expected tag new, got %q", tag.Name)
+	}
+
+	sort.Strings(tagged)
+	want := []string{"database:db-1", "droplet:1", "droplet:2", "image:3", "volume:vol-1"}
+	if fmt.Sprint(tagged) != fmt.Sprint(want) {
+		t.Errorf("expected %v tagged, got %v", want, tagged)
+	}
+}
+
+func TestRenameTag_unlistableResources(t *testing.T) {
+	c, mux := setup(t)
+	setupTaggedResources(t, mux, 1)
+
+	mux.HandleFunc("/v2/tags", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("expected no tag to be created")
+	})
+
+	_, _, err := RenameTag(context.Background(), c, "old", "new")
+	if err == nil || !strings.Contains(err.Error(), "carries 6 resources") {
+		t.Errorf("expected an error about unlisted resources, got %v", err)
+	}
+}
+
+func TestTags_Resources(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("tag_name"); got != "web" {
+			t.Errorf("expected droplets listed by tag web, got %q", got)
+		}
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"droplets":[{"id":3}],"links":{"pages":{"prev":"https://api.example.com/v2/droplets?page=1"}}}`)
+			return
+		}
+		fmt.Fprint(w, `{"droplets":[{"id":1},{"id":2}],"links":{"pages":{"next":"https://api.example.com/v2/droplets?page=2"}}}`)
+	})
+	mux.HandleFunc("/v2/images", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"images":[]}`)
+			return
+		}
+		fmt.Fprint(w, `{"images":[{"id":10}]}`)
+	})
+	mux.HandleFunc("/v2/volumes", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("tag_name"); got != "web" {
+			t.Errorf("expected volumes listed by tag web, got %q", got)
+		}
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"volumes":[]}`)
+			return
+		}
+		fmt.Fprint(w, `{"volumes":[{"id":"vol-1"}]}`)
+	})
+
+	resources, resp, err := c.Tags.Resources(context.Background(), "web", nil, "")
+	if err != nil {
+		t.Fatalf("Tags.Resources returned error: %v", err)
+	}
+	want := []Resource{
+		{ID: "1", Type: DropletResourceType},
+		{ID: "2", Type: DropletResourceType},
+		{ID: "10", Type: ImageResourceType},
+		{ID: "vol-1", Type: VolumeResourceType},
+	}
+	if fmt.Sprint(resources) != fmt.Sprint(want) {
+		t.Errorf("expected %v, got %v", want, resources)
+	}
+	if resp.Links.IsLastPage() {
+		t.Error("expected the response of the droplets, which have a further page")
+	}
+
+	all, _, err := ListAll[Resource](context.Background(), func(ctx context.Context, opt *ListOptions) ([]Resource, *Response, error) {
+		return c.Tags.Resources(ctx, "web", opt, "")
+	}, nil)
+	if err != nil {
+		t.Fatalf("ListAll returned error: %v", err)
+	}
+	if len(all) != 5 || all[4] != (Resource{ID: "3", Type: DropletResourceType}) {
+		t.Errorf("expected the second page of droplets after the first pages, got %v", all)
+	}
+
+	volumes, _, err := c.Tags.Resources(context.Background(), "web", nil, VolumeResourceType)
+	if err != nil {
+		t.Fatalf("Tags.Resources returned error: %v", err)
+	}
+	if fmt.Sprint(volumes) != fmt.Sprint([]Resource{{ID: "vol-1", Type: VolumeResourceType}}) {
+		t.Errorf("expected only the volume, got %v", volumes)
+	}
+
+	if _, _, err := c.Tags.Resources(context.Background(), "web", nil, KubernetesResourceType); err == nil {
+		t.Error("expected an error for a type which can not be listed by tag")
+	} else if _, ok := err.(*ValidationError); !ok {
+		t.Errorf("expected *ValidationError, got %v", err)
+	}
+}
+
+func TestURN_Resource(t *testing.T) {
+	tests := []struct {
+		urn  string
+		want Resource
+	}{
+		{"do:droplet:13457723", Resource{ID: "13457723", Type: DropletResourceType}},
+		{"do:volume:506f78a4-e098-11e5-ad9f-000f53306ae1", Resource{ID: "506f78a4-e098-11e5-ad9f-000f53306ae1", Type: VolumeResourceType}},
+		{"do:dbaas:9cc10173", Resource{ID: "9cc10173", Type: DatabaseResourceType}},
+		{"do:kubernetes:bd5f5959", Resource{ID: "bd5f5959", Type: KubernetesResourceType}},
+		{"do:floatingip:192.0.2.1", Resource{ID: "192.0.2.1", Type: ReservedIPResourceType}},
+	}
+	for _, tt := range tests {
+		got, err := ResourceFromURN(tt.urn)
+		if err != nil {
+			t.Errorf("ResourceFromURN(%q) returned error: %v", tt.urn, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ResourceFromURN(%q) = %+v, want %+v", tt.urn, got, tt.want)
+		}
+	}
+
+	for _, urn := range []string{"do:app:c2a93513", "do:droplet", "droplet:1"} {
+		if _, err := ResourceFromURN(urn); err == nil {
+			t.Errorf("ResourceFromURN(%q): expected an error", urn)
+		}
+	}
+}
+
+func TestParseURN(t *testing.T) {
+	u, err := ParseURN("do:volume:506f78a4-e098-11e5-ad9f-000f53306ae1")
+	if err != nil {
+		t.Fatalf("ParseURN returned error: %v", err)
+	}
+	if u.Collection() != "volume" || u.ID() != "506f78a4-e098-11e5-ad9f-000f53306ae1" {
+		t.Errorf("got collection %q and ID %q", u.Collection(), u.ID())
+	}
+	if u, err := ParseURN("do:reservedip:2604:a880::1"); err != nil || u.ID() != "2604:a880::1" {
+		t.Errorf("expected the ID to keep its colons, got %q, %v", u.ID(), err)
+	}
+
+	for _, s := range []string{"", "do", "do::1", "do:droplet:", "aws:droplet:1"} {
+		var verr *ValidationError
+		if _, err := ParseURN(s); !errors.As(err, &verr) {
+			t.Errorf("ParseURN(%q): expected a *ValidationError, got %v", s, err)
+		}
+	}
+	if URN("droplet").ID() != "" {
+		t.Error("expected no ID for an invalid URN")
+	}
+}
+
+func TestResource_URN(t *testing.T) {
+	r := Resource{ID: "9cc10173", Type: DatabaseResourceType}
+	if got := r.URN(); got != "do:dbaas:9cc10173" {
+		t.Errorf("expected do:dbaas:9cc10173, got %s", got)
+	}
+	if got, err := ResourceFromURN(r.URN()); err != nil || got != r {
+		t.Errorf("expected %+v to round trip, got %+v, %v", r, got, err)
+	}
+}
+
+func TestTags_Count(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/tags", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("per_page"); got != "1" {
+			t.Errorf("expected a single tag requested, got per_page %q", got)
+		}
+		fmt.Fprint(w, `{"tags":[{"name":"a"}],"meta":{"total":12}}`)
+	})
+
+	n, _, err := c.Tags.Count(context.Background())
+	if err != nil {
+		t.Fatalf("Tags.Count returned error: %v", err)
+	}
+	if n != 12 {
+		t.Errorf("expected 12 tags, got %d", n)
+	}
+}
+
+func TestTags_List_sort(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/tags", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("sort") != "name" || q.Get("sort_direction") != "desc" {
+			t.Errorf("expected tags sorted by name descending, got %q", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"tags":[{"name":"b"},{"name":"a"}]}`)
+	})
+
+	if _, _, err := c.Tags.List(context.Background(), &ListOptions{SortBy: "name", SortDirection: SortDescending}); err != nil {
+		t.Fatalf("Tags.List returned error: %v", err)
+	}
+	if _, _, err := c.Tags.List(context.Background(), &ListOptions{SortBy: "created_at"}); err == nil {
+		t.Error("expected an error sorting tags by an unsupported field")
+	}
+}
+
+func TestTags_Get(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/tags/prod", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET, got %s", r.Method)
+		}
+		fmt.Fprint(w, `{"tag":{"name":"prod","resources":{"count":2}}}`)
+	})
+	mux.HandleFunc("/v2/tags/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"id":"not_found","message":"The resource you were accessing could not be found."}`)
+	})
+
+	tag, _, err := c.Tags.Get(context.Background(), "prod")
+	if err != nil {
+		t.Fatalf("Tags.Get returned error: %v", err)
+	}
+	if tag.Name != "prod" || tag.Resources == nil || tag.Resources.Count != 2 {
+		t.Errorf("got tag %+v", tag)
+	}
+
+	_, resp, err := c.Tags.Get(context.Background(), "missing")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected the 404 response returned, got %v", resp)
+	}
+}
+
+func TestTags_Create(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/tags", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		var req TagCreateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if req.Name != "prod" {
+			t.Errorf("expected tag prod created, got %+v", req)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"tag":{"name":"prod","resources":{"count":0}}}`)
+	})
+
+	tag, resp, err := c.Tags.Create(context.Background(), &TagCreateRequest{Name: "prod"})
+	if err != nil {
+		t.Fatalf("Tags.Create returned error: %v", err)
+	}
+	if tag.Name != "prod" || resp.StatusCode != http.StatusCreated {
+		t.Errorf("got tag %+v with status %d", tag, resp.StatusCode)
+	}
+
+	var verr *ValidationError
+	if _, _, err := c.Tags.Create(context.Background(), nil); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for a nil request, got %v", err)
+	}
+}
+
+func TestTags_Delete(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/tags/prod", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE, got %s", r.Method)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/v2/tags/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"id":"not_found","message":"The resource you were accessing could not be found."}`)
+	})
+
+	resp, err := c.Tags.Delete(context.Background(), "prod")
+	if err != nil {
+		t.Fatalf("Tags.Delete returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("expected 204, got %d", resp.StatusCode)
+	}
+
+	resp, err = c.Tags.Delete(context.Background(), "missing")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected the 404 response returned, got %v", resp)
+	}
+}
+
+func TestTags_TagResources(t *testing.T) {
+	c, mux := setup(t)
+
+	var got []string
+	mux.HandleFunc("/v2/tags/prod/resources", func(w http.ResponseWriter, r *http.Request) {
+		var req TagResourcesRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		got = append(got, fmt.Sprintf("%s %v", r.Method, req.Resources))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resources := []Resource{{ID: "1", Type: DropletResourceType}, {ID: "vol-1", Type: VolumeResourceType}}
+	if _, err := c.Tags.TagResources(context.Background(), "prod", &TagResourcesRequest{Resources: resources}); err != nil {
+		t.Fatalf("Tags.TagResources returned error: %v", err)
+	}
+	if _, err := c.Tags.UntagResources(context.Background(), "prod", &UntagResourcesRequest{Resources: resources[:1]}); err != nil {
+		t.Fatalf("Tags.UntagResources returned error: %v", err)
+	}
+
+	want := "[POST [{1 droplet} {vol-1 volume}] DELETE [{1 droplet}]]"
+	if fmt.Sprint(got) != want {
+		t.Errorf("expected %s, got %v", want, got)
+	}
+
+	var verr *ValidationError
+	if _, err := c.Tags.TagResources(context.Background(), "prod", nil); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for a nil request, got %v", err)
+	}
+	if _, err := c.Tags.UntagResources(context.Background(), "prod", nil); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for a nil request, got %v", err)
+	}
+}
+
+func TestTags_TagResources_URNs(t *testing.T) {
+	c, mux := setup(t)
+
+	var got []string
+	mux.HandleFunc("/v2/tags/prod/resources", func(w http.ResponseWriter, r *http.Request) {
+		var req TagResourcesRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		got = append(got, fmt.Sprintf("%s %v", r.Method, req.Resources))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	tagRequest := &TagResourcesRequest{
+		Resources: []Resource{{ID: "1", Type: DropletResourceType}},
+		URNs:      []URN{"do:dbaas:9cc10173", "do:kubernetes:bd5f5959"},
+	}
+	if _, err := c.Tags.TagResources(ctx, "prod", tagRequest); err != nil {
+		t.Fatalf("Tags.TagResources returned error: %v", err)
+	}
+	if _, err := c.Tags.UntagResources(ctx, "prod", &UntagResourcesRequest{URNs: []URN{"do:volume:vol-1"}}); err != nil {
+		t.Fatalf("Tags.UntagResources returned error: %v", err)
+	}
+
+	want := "[POST [{1 droplet} {9cc10173 database} {bd5f5959 kubernetes}] DELETE [{vol-1 volume}]]"
+	if fmt.Sprint(got) != want {
+		t.Errorf("expected %s, got %v", want, got)
+	}
+
+	for _, urn := range []URN{"droplet:1", "do:app:c2a93513"} {
+		var verr *ValidationError
+		if _, err := c.Tags.TagResources(ctx, "prod", &TagResourcesRequest{URNs: []URN{urn}}); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", urn, err)
+		}
+	}
+	if len(got) != 2 {
+		t.Errorf("expected no request sent for invalid URNs, got %v", got)
+	}
+}
+
+func TestTags_Update(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/tags/old", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("expected PUT, got %s", r.Method)
+		}
+		var req TagUpdateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		fmt.Fprintf(w, `{"tag":{"name":%q}}`, req.Name)
+	})
+	mux.HandleFunc("/v2/tags", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("expected the tag renamed in place")
+	})
+
+	tag, _, err := c.Tags.Update(context.Background(), "old", &TagUpdateRequest{Name: "new"})
+	if err != nil {
+		t.Fatalf("Tags.Update returned error: %v", err)
+	}
+	if tag.Name != "new" {
+		t.Errorf("expected tag new, got %q", tag.Name)
+	}
+
+	var verr *ValidationError
+	if _, _, err := c.Tags.Update(context.Background(), "old", nil); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for a nil request, got %v", err)
+	}
+	if _, _, err := c.Tags.Update(context.Background(), "old", &TagUpdateRequest{Name: "bad name"}); !errors.As(err, &verr) {
+		t.Errorf("expected a *ValidationError for an invalid new name, got %v", err)
+	}
+}
+
+func TestTags_TagResourcesBulk(t *testing.T) {
+	c, mux := setup(t)
+
+	var mu sync.Mutex
+	var batchSizes []int
+	mux.HandleFunc("/v2/tags/prod/resources", func(w http.ResponseWriter, r *http.Request) {
+		var req TagResourcesRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decoding request body: %v", err)
+		}
+		mu.Lock()
+		batchSizes = append(batchSizes, len(req.Resources))
+		mu.Unlock()
+		if req.Resources[0].ID == "50" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"id":"unprocessable_entity","message":"invalid resource"}`)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resources := make([]Resource, 120)
+	for i := range resources {
+		resources[i] = Resource{ID: strconv.Itoa(i), Type: DropletResourceType}
+	}
+
+	results, err := c.Tags.TagResourcesBulk(context.Background(), "prod", resources)
+	if err == nil {
+		t.Fatal("expected the error of the failed batch")
+	}
+	sort.Ints(batchSizes)
+	if fmt.Sprint(batchSizes) != "[20 50 50]" {
+		t.Errorf("expected batches of at most 50 resources, got %v", batchSizes)
+	}
+	if len(results) != len(resources) {
+		t.Fatalf("expected a result for each resource, got %d", len(results))
+	}
+	for i, r := range results {
+		if r.Resource != resources[i] {
+			t.Errorf("result %d: expected resource %v, got %v", i, resources[i], r.Resource)
+		}
+		if failed := i >= 50 && i < 100; failed != (r.Err != nil) {
+			t.Errorf("result %d: expected failed %v, got %v", i, failed, r.Err)
+		}
+	}
+}
+
+func TestTags_UntagResourcesBulk(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/tags/prod/resources", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE, got %s", r.Method)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	results, err := c.Tags.UntagResourcesBulk(context.Background(), "prod", []Resource{{ID: "1", Type: DropletResourceType}})
+	if err != nil {
+		t.Fatalf("Tags.UntagResourcesBulk returned error: %v", err)
+	}
+	if len(results) != 1 || results[0].Err != nil {
+		t.Errorf("got results %+v", results)
+	}
+}
+
+func TestTags_Ensure(t *testing.T) {
+	c, mux := setup(t)
+
+	var mu sync.Mutex
+	existing := map[string]bool{"exists": true}
+	var created []string
+	mux.HandleFunc("/v2/tags/", func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, "/v2/tags/")
+		mu.Lock()
+		defer mu.Unlock()
+		if !existing[name] {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"id":"not_found","message":"not found"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"tag":{"name":%q}}`, name)
+	})
+	mux.HandleFunc("/v2/tags", func(w http.ResponseWriter, r *http.Request) {
+		var req TagCreateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decoding request body: %v", err)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		created = append(created, req.Name)
+		if req.Name == "racing" {
+			// created concurrently by someone else
+			existing[req.Name] = true
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"id":"unprocessable_entity","message":"tag already exists"}`)
+			return
+		}
+		if req.Name == "failing" {
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `{"id":"server_error","message":"oops"}`)
+			return
+		}
+		existing[req.Name] = true
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"tag":{"name":%q}}`, req.Name)
+	})
+
+	for _, name := range []string{"exists", "new", "racing"} {
+		tag, _, err := c.Tags.Ensure(context.Background(), name)
+		if err != nil {
+			t.Fatalf("Tags.Ensure(%q) returned error: %v", name, err)
+		}
+		if tag.Name != name {
+			t.Errorf("expected tag %q, got %q", name, tag.Name)
+		}
+	}
+	sort.Strings(created)
+	if fmt.Sprint(created) != "[new racing]" {
+		t.Errorf("expected only missing tags created, got %v", created)
+	}
+
+	results, err := c.Tags.EnsureAll(context.Background(), []string{"exists", "failing", "other"})
+	if err == nil || !strings.Contains(err.Error(), `tag "failing"`) {
+		t.Errorf("expected an error naming the failed tag, got %v", err)
+	}
+	var got []string
+	for _, r := range results {
+		got = append(got, fmt.Sprintf("%s:%v", r.Name, r.Err == nil && r.Tag != nil && r.Tag.Name == r.Name))
+	}
+	if fmt.Sprint(got) != "[exists:true failing:false other:true]" {
+		t.Errorf("got results %v", got)
+	}
+}
+
+func TestValidateTagName(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{name: "prod"},
+		{name: "k8s:cluster-1_web"},
+		{name: strings.Repeat("a", maxTagNameLength)},
+		{name: "", wantErr: true},
+		{name: strings.Repeat("a", maxTagNameLength+1), wantErr: true},
+		{name: "with space", wantErr: true},
+		{name: "slash/ed", wantErr: true},
+		{name: "ünïcode", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		err := validateTagName(tt.name)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%q: expected error %v, got %v", tt.name, tt.wantErr, err)
+		}
+		var verr *ValidationError
+		if err != nil && !errors.As(err, &verr) {
+			t.Errorf("%q: expected a *ValidationError, got %T", tt.name, err)
+		}
+	}
+}
+
+func TestTags_invalidNameIsNotSent(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+
+	ctx := context.Background()
+	const bad = "bad/name"
+	calls := map[string]func() error{
+		"Get": func() error { _, _, err := c.Tags.Get(ctx, bad); return err },
+		"Create": func() error {
+			_, _, err := c.Tags.Create(ctx, &TagCreateRequest{Name: bad})
+			return err
+		},
+		"Delete": func() error { _, err := c.Tags.Delete(ctx, bad); return err },
+		"Update": func() error {
+			_, _, err := c.Tags.Update(ctx, bad, &TagUpdateRequest{Name: "ok"})
+			return err
+		},
+		"Resources": func() error { _, _, err := c.Tags.Resources(ctx, bad, nil, ""); return err },
+		"TagResources": func() error {
+			_, err := c.Tags.TagResources(ctx, bad, &TagResourcesRequest{})
+			return err
+		},
+		"UntagResources": func() error {
+			_, err := c.Tags.UntagResources(ctx, bad, &UntagResourcesRequest{})
+			return err
+		},
+	}
+	for name, call := range calls {
+		var verr *ValidationError
+		if err := call(); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
+
+func TestTags_List_resourceCounts(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/tags", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"tags":[{
+			"name": "prod",
+			"resources": {
+				"count": 5,
+				"last_tagged_uri": "https://api.example.com/v2/droplets/2",
+				"droplets": {"count": 2, "last_tagged_uri": "https://api.example.com/v2/droplets/2"},
+				"images": {"count": 1},
+				"volumes": {"count": 0},
+				"volume_snapshots": {"count": 1},
+				"databases": {"count": 1}
+			}
+		}]}`)
+	})
+
+	tags, _, err := c.Tags.List(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Tags.List returned error: %v", err)
+	}
+	if len(tags) != 1 || tags[0].Resources == nil {
+		t.Fatalf("got tags %+v", tags)
+	}
+	r := tags[0].Resources
+	if r.Count != 5 || r.LastTaggedURI != "https://api.example.com/v2/droplets/2" {
+		t.Errorf("got resources %+v", r)
+	}
+	counts := fmt.Sprint(r.Droplets.Count, r.Images.Count, r.Volumes.Count, r.VolumeSnapshots.Count, r.Databases.Count)
+	if counts != "2 1 0 1 1" {
+		t.Errorf("expected counts by type 2 1 0 1 1, got %s", counts)
+	}
+	if r.Droplets.LastTaggedURI != "https://api.example.com/v2/droplets/2" {
+		t.Errorf("expected the last tagged droplet, got %q", r.Droplets.LastTaggedURI)
+	}
+}
@@ -0,0 +1,118 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"time"
+)
+
+// ConnTrace holds the connection level timings of a request, which help to
+// tell slow networks apart from slow API responses.
+type ConnTrace struct {
+	// DNS, Connect and TLSHandshake are zero when an existing connection was
+	// reused.
+	DNS          time.Duration
+	Connect      time.Duration
+	TLSHandshake time.Duration
+
+	// TimeToFirstByte is the time from obtaining a connection until the first
+	// byte of the response was received.
+	TimeToFirstByte time.Duration
+
+	// Reused reports whether the request was sent on a pooled connection.
+	Reused bool
+}
+
+// SetConnTrace is a client option for recording the connection timings of
+// requests with net/http/httptrace. They are reported on Response.ConnTrace
+// and to middleware through RequestConnTrace.
+func SetConnTrace(enabled bool) ClientOpt {
+	return func(c *Client) error {
+		c.connTrace = enabled
+		return nil
+	}
+}
+
+type connTraceContextKey struct{}
+
+// RequestConnTrace returns the connection timings recorded so far for req,
+// which is complete once the response headers have been received. It reports
+// false if connection tracing is not enabled.
+func RequestConnTrace(req *http.Request) (ConnTrace, bool) {
+	t, ok := req.Context().Value(connTraceContextKey{}).(*connTracer)
+	if !ok {
+		return ConnTrace{}, false
+	}
+	return t.trace(), true
+}
+
+// connTracer records a ConnTrace from httptrace callbacks, which may be
+// called from the goroutines dialing connections.
+type connTracer struct {
+	mu sync.Mutex
+	t  ConnTrace
+
+	dnsStart     time.Time
+	connectStart time.Time
+	tlsStart     time.Time
+	gotConn      time.Time
+}
+
+// withConnTrace returns a copy of ctx tracing the connection of requests sent
+// with it.
+func withConnTrace(ctx context.Context) (context.Context, *connTracer) {
+	t := new(connTracer)
+	ctx = context.WithValue(ctx, connTraceContextKey{}, t)
+	return httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		DNSStart: func(httptrace.DNSStartInfo) {
+			t.mu.Lock()
+			t.dnsStart = time.Now()
+			t.mu.Unlock()
+		},
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			t.mu.Lock()
+			t.t.DNS = time.Since(t.dnsStart)
+			t.mu.Unlock()
+		},
+		ConnectStart: func(string, string) {
+			t.mu.Lock()
+			t.connectStart = time.Now()
+			t.mu.Unlock()
+		},
+		ConnectDone: func(string, string, error) {
+			t.mu.Lock()
+			t.t.Connect = time.Since(t.connectStart)
+			t.mu.Unlock()
+		},
+		TLSHandshakeStart: func() {
+			t.mu.Lock()
+			t.tlsStart = time.Now()
+			t.mu.Unlock()
+		},
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			t.mu.Lock()
+			t.t.TLSHandshake = time.Since(t.tlsStart)
+			t.mu.Unlock()
+		},
+		GotConn: func(info httptrace.GotConnInfo) {
+			t.mu.Lock()
+			t.gotConn = time.Now()
+			t.t.Reused = info.Reused
+			t.mu.Unlock()
+		},
+		GotFirstResponseByte: func() {
+			t.mu.Lock()
+			t.t.TimeToFirstByte = time.Since(t.gotConn)
+			t.mu.Unlock()
+		},
+	}), t
+}
+
+func (t *connTracer) trace() ConnTrace {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.t
+}
@@ -0,0 +1,75 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestSetConnTrace(t *testing.T) {
+	var traced []bool
+	c, mux := setup(t,
+		SetConnTrace(true),
+		SetMiddleware(func(next Doer) Doer {
+			return DoerFunc(func(req *http.Request) (*http.Response, error) {
+				resp, err := next.Do(req)
+				_, ok := RequestConnTrace(req)
+				traced = append(traced, ok)
+				return resp, err
+			})
+		}))
+
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"account":{}}`)
+	})
+
+	_, first, err := c.Account.Get(context.Background())
+	if err != nil {
+		t.Fatalf("Account.Get returned error: %v", err)
+	}
+	_, second, err := c.Account.Get(context.Background())
+	if err != nil {
+		t.Fatalf("Account.Get returned error: %v", err)
+	}
+
+	if first.ConnTrace == nil || second.ConnTrace == nil {
+		t.Fatalf("expected connection timings on the responses, got %v and %v", first.ConnTrace, second.ConnTrace)
+	}
+	if first.ConnTrace.Reused || first.ConnTrace.Connect <= 0 || first.ConnTrace.TimeToFirstByte <= 0 {
+		t.Errorf("expected a new connection for the first request, got %+v", *first.ConnTrace)
+	}
+	if !second.ConnTrace.Reused || second.ConnTrace.Connect != 0 || second.ConnTrace.TimeToFirstByte <= 0 {
+		t.Errorf("expected the connection reused by the second request, got %+v", *second.ConnTrace)
+	}
+	// the test server listens on an IP address and without TLS
+	if first.ConnTrace.DNS != 0 || first.ConnTrace.TLSHandshake != 0 {
+		t.Errorf("expected no DNS lookup or TLS handshake, got %+v", *first.ConnTrace)
+	}
+	if fmt.Sprint(traced) != "[true true]" {
+		t.Errorf("expected the timings available to middleware, got %v", traced)
+	}
+}
+
+func TestSetConnTrace_disabled(t *testing.T) {
+	c, mux := setup(t, SetMiddleware(func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			if _, ok := RequestConnTrace(req); ok {
+				t.Error("expected no connection timings when tracing is disabled")
+			}
+			return next.Do(req)
+		})
+	}))
+
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"account":{}}`)
+	})
+
+	_, resp, err := c.Account.Get(context.Background())
+	if err != nil {
+		t.Fatalf("Account.Get returned error: %v", err)
+	}
+	if resp.ConnTrace != nil {
+		t.Errorf("expected no connection timings, got %+v", *resp.ConnTrace)
+	}
+}
@@ -0,0 +1,425 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const uptimeChecksBasePath = "v2/uptime/checks"
+
+// Types of uptime checks.
+const (
+	UptimeCheckPing  = "ping"
+	UptimeCheckHTTP  = "http"
+	UptimeCheckHTTPS = "https"
+)
+
+// Types of uptime alerts.
+const (
+	UptimeAlertLatency    = "latency"
+	UptimeAlertDown       = "down"
+	UptimeAlertDownGlobal = "down_global"
+	UptimeAlertSSLExpiry  = "ssl_expiry"
+)
+
+// UptimeCheck represents a DigitalOcean UptimeCheck configuration.
+type UptimeCheck struct {
+	ID      string   `json:"id"`
+	Name    string   `json:"name"`
+	Type    string   `json:"type"`
+	Target  string   `json:"target"`
+	Regions []string `json:"regions"`
+	Enabled bool     `json:"enabled"`
+}
+
+// UptimeCheckState represents a DigitalOcean Uptime Check's state
+// configuration, the status and uptime of the check by region.
+type UptimeCheckState struct {
+	Regions        map[string]UptimeRegion `json:"regions"`
+	PreviousOutage UptimePreviousOutage    `json:"previous_outage"`
+}
+
+// UptimeRegion represents the state of an uptime check in a region.
+type UptimeRegion struct {
+	Status                    string    `json:"status"`
+	StatusChangedAt           time.Time `json:"status_changed_at"`
+	ThirtyDayUptimePercentage float32   `json:"thirty_day_uptime_percentage"`
+}
+
+// UptimePreviousOutage represents a DigitalOcean Uptime Check's previous outage configuration.
+type UptimePreviousOutage struct {
+	Region          string    `json:"region"`
+	StartedAt       time.Time `json:"started_at"`
+	EndedAt         time.Time `json:"ended_at"`
+	DurationSeconds int       `json:"duration_seconds"`
+}
+
+// CreateUptimeCheckRequest represents the request to create a new uptime check.
+type CreateUptimeCheckRequest struct {
+	Name    string   `json:"name"`
+	Type    string   `json:"type"`
+	Target  string   `json:"target"`
+	Regions []string `json:"regions"`
+	Enabled bool     `json:"enabled"`
+}
+
+// UpdateUptimeCheckRequest represents the request to update uptime check information.
+type UpdateUptimeCheckRequest struct {
+	Name    string   `json:"name"`
+	Type    string   `json:"type"`
+	Target  string   `json:"target"`
+	Regions []string `json:"regions"`
+	Enabled bool     `json:"enabled"`
+}
+
+// UptimeAlert represents a DigitalOcean Uptime Alert configuration. A latency
+// alert fires when the latency in milliseconds compares to Threshold as given
+// by Comparison for Period. An SSL expiry alert fires Threshold days before
+// the certificate expires.
+type UptimeAlert struct {
+	ID            string          `json:"id"`
+	Name          string          `json:"name"`
+	Type          string          `json:"type"`
+	Threshold     int             `json:"threshold"`
+	Comparison    UptimeAlertComp `json:"comparison"`
+	Notifications *Notifications  `json:"notifications"`
+	Period        string          `json:"period"`
+}
+
+// UptimeAlertComp represents an uptime alert comparison operation
+type UptimeAlertComp string
+
+// Comparisons of uptime alerts.
+const (
+	UptimeAlertGreaterThan UptimeAlertComp = "greater_than"
+	UptimeAlertLessThan    UptimeAlertComp = "less_than"
+)
+
+// Notifications represents a DigitalOcean Notifications configuration.
+type Notifications struct {
+	Email []string       `json:"email"`
+	Slack []SlackDetails `json:"slack"`
+}
+
+// CreateUptimeAlertRequest represents the request to create a new Uptime Alert.
+type CreateUptimeAlertRequest struct {
+	Name          string          `json:"name"`
+	Type          string          `json:"type"`
+	Threshold     int             `json:"threshold,omitempty"`
+	Comparison    UptimeAlertComp `json:"comparison,omitempty"`
+	Notifications *Notifications  `json:"notifications"`
+	Period        string          `json:"period"`
+}
+
+// UpdateUptimeAlertRequest represents the request to update an alert.
+type UpdateUptimeAlertRequest struct {
+	Name          string          `json:"name"`
+	Type          string          `json:"type"`
+	Threshold     int             `json:"threshold,omitempty"`
+	Comparison    UptimeAlertComp `json:"comparison,omitempty"`
+	Notifications *Notifications  `json:"notifications"`
+	Period        string          `json:"period"`
+}
+
+/* SERVICE */
+
+// UptimeChecksService is an interface for creating and managing Uptime checks with the DigitalOcean API.
+// See: https://docs.digitalocean.com/reference/api/api-reference/#tag/Uptime
+type UptimeChecksService interface {
+	List(context.Context, *ListOptions) ([]UptimeCheck, *Response, error)
+	ListAll(context.Context, *ListAllOptions) ([]UptimeCheck, *Response, error)
+	Get(context.Context, string) (*UptimeCheck, *Response, error)
+	GetState(context.Context, string) (*UptimeCheckState, *Response, error)
+	Create(context.Context, *CreateUptimeCheckRequest) (*UptimeCheck, *Response, error)
+	Update(context.Context, string, *UpdateUptimeCheckRequest) (*UptimeCheck, *Response, error)
+	Delete(context.Context, string) (*Response, error)
+
+	GetAlert(context.Context, string, string) (*UptimeAlert, *Response, error)
+	ListAlerts(context.Context, string, *ListOptions) ([]UptimeAlert, *Response, error)
+	CreateAlert(context.Context, string, *CreateUptimeAlertRequest) (*UptimeAlert, *Response, error)
+	UpdateAlert(context.Context, string, string, *UpdateUptimeAlertRequest) (*UptimeAlert, *Response, error)
+	DeleteAlert(context.Context, string, string) (*Response, error)
+}
+
+// UptimeChecksServiceOp handles communication with Uptime Check methods of the DigitalOcean API.
+type UptimeChecksServiceOp struct {
+	client *Client
+}
+
+var _ UptimeChecksService = &UptimeChecksServiceOp{}
+
+// List Checks.
+func (s *UptimeChecksServiceOp) List(ctx context.Context, opt *ListOptions) ([]UptimeCheck, *Response, error) {
+	path, err := addOptions(uptimeChecksBasePath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	checks, resp, err := DoEnvelope[[]UptimeCheck](ctx, s.client, req, "checks")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *checks, resp, err
+}
+
+// ListAll uptime checks across all pages
+func (s *UptimeChecksServiceOp) ListAll(ctx context.Context, opt *ListAllOptions) ([]UptimeCheck, *Response, error) {
+	return ListAll[UptimeCheck](ctx, s.List, opt)
+}
+
+// GetState of uptime check.
+func (s *UptimeChecksServiceOp) GetState(ctx context.Context, uptimeCheckID string) (*UptimeCheckState, *Response, error) {
+	path, err := uptimeCheckPath(uptimeCheckID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path+"/state", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return DoEnvelope[UptimeCheckState](ctx, s.client, req, "state")
+}
+
+// Get retrieves a single uptime check by its ID.
+func (s *UptimeChecksServiceOp) Get(ctx context.Context, uptimeCheckID string) (*UptimeCheck, *Response, error) {
+	path, err := uptimeCheckPath(uptimeCheckID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doCheck(ctx, req)
+}
+
+// Create a new uptime check.
+func (s *UptimeChecksServiceOp) Create(ctx context.Context, cr *CreateUptimeCheckRequest) (*UptimeCheck, *Response, error) {
+	if cr == nil {
+		return nil, nil, &ValidationError{Field: "createRequest", Reason: "cannot be nil"}
+	}
+	if err := validateUptimeCheck(cr.Name, cr.Type, cr.Target); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, uptimeChecksBasePath, cr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doCheck(ctx, req)
+}
+
+// Update an uptime check.
+func (s *UptimeChecksServiceOp) Update(ctx context.Context, uptimeCheckID string, ur *UpdateUptimeCheckRequest) (*UptimeCheck, *Response, error) {
+	path, err := uptimeCheckPath(uptimeCheckID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ur == nil {
+		return nil, nil, &ValidationError{Field: "updateRequest", Reason: "cannot be nil"}
+	}
+	if err := validateUptimeCheck(ur.Name, ur.Type, ur.Target); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, ur)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doCheck(ctx, req)
+}
+
+// Delete an existing uptime check.
+func (s *UptimeChecksServiceOp) Delete(ctx context.Context, uptimeCheckID string) (*Response, error) {
+	path, err := uptimeCheckPath(uptimeCheckID)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListAlerts lists alerts for a check.
+func (s *UptimeChecksServiceOp) ListAlerts(ctx context.Context, uptimeCheckID string, opt *ListOptions) ([]UptimeAlert, *Response, error) {
+	path, err := uptimeCheckPath(uptimeCheckID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	path, err = addOptions(path+"/alerts", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	alerts, resp, err := DoEnvelope[[]UptimeAlert](ctx, s.client, req, "alerts")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *alerts, resp, err
+}
+
+// CreateAlert creates a new check alert.
+func (s *UptimeChecksServiceOp) CreateAlert(ctx context.Context, uptimeCheckID string, cr *CreateUptimeAlertRequest) (*UptimeAlert, *Response, error) {
+	path, err := uptimeCheckPath(uptimeCheckID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if cr == nil {
+		return nil, nil, &ValidationError{Field: "createRequest", Reason: "cannot be nil"}
+	}
+	if err := validateUptimeAlert(cr.Name, cr.Type, cr.Comparison); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path+"/alerts", cr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doAlert(ctx, req)
+}
+
+// GetAlert retrieves a single uptime check alert by its ID.
+func (s *UptimeChecksServiceOp) GetAlert(ctx context.Context, uptimeCheckID, alertID string) (*UptimeAlert, *Response, error) {
+	path, err := uptimeAlertPath(uptimeCheckID, alertID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doAlert(ctx, req)
+}
+
+// UpdateAlert updates a check's alert.
+func (s *UptimeChecksServiceOp) UpdateAlert(ctx context.Context, uptimeCheckID, alertID string, ur *UpdateUptimeAlertRequest) (*UptimeAlert, *Response, error) {
+	path, err := uptimeAlertPath(uptimeCheckID, alertID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ur == nil {
+		return nil, nil, &ValidationError{Field: "updateRequest", Reason: "cannot be nil"}
+	}
+	if err := validateUptimeAlert(ur.Name, ur.Type, ur.Comparison); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, ur)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doAlert(ctx, req)
+}
+
+// DeleteAlert deletes an existing check's alert.
+func (s *UptimeChecksServiceOp) DeleteAlert(ctx context.Context, uptimeCheckID, alertID string) (*Response, error) {
+	path, err := uptimeAlertPath(uptimeCheckID, alertID)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *UptimeChecksServiceOp) doCheck(ctx context.Context, req *http.Request) (*UptimeCheck, *Response, error) {
+	check, resp, err := DoEnvelope[UptimeCheck](ctx, s.client, req, "check")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return check, resp, err
+}
+
+func (s *UptimeChecksServiceOp) doAlert(ctx context.Context, req *http.Request) (*UptimeAlert, *Response, error) {
+	alert, resp, err := DoEnvelope[UptimeAlert](ctx, s.client, req, "alert")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return alert, resp, err
+}
+
+// uptimeCheckPath returns the path of an uptime check.
+func uptimeCheckPath(uptimeCheckID string) (string, error) {
+	if uptimeCheckID == "" {
+		return "", &ValidationError{Field: "uptimeCheckID", Reason: "must not be empty"}
+	}
+	return fmt.Sprintf("%s/%s", uptimeChecksBasePath, uptimeCheckID), nil
+}
+
+// uptimeAlertPath returns the path of an alert of an uptime check.
+func uptimeAlertPath(uptimeCheckID, alertID string) (string, error) {
+	path, err := uptimeCheckPath(uptimeCheckID)
+	if err != nil {
+		return "", err
+	}
+	if alertID == "" {
+		return "", &ValidationError{Field: "alertID", Reason: "must not be empty"}
+	}
+	return fmt.Sprintf("%s/alerts/%s", path, alertID), nil
+}
+
+func validateUptimeCheck(name, typ, target string) error {
+	if name == "" {
+		return &ValidationError{Field: "name", Reason: "must not be empty"}
+	}
+	switch typ {
+	case UptimeCheckPing, UptimeCheckHTTP, UptimeCheckHTTPS:
+	default:
+		return &ValidationError{Field: "type", Value: typ, Reason: "must be ping, http or https"}
+	}
+	if target == "" {
+		return &ValidationError{Field: "target", Reason: "must not be empty"}
+	}
+	return nil
+}
+
+func validateUptimeAlert(name, typ string, comparison UptimeAlertComp) error {
+	if name == "" {
+		return &ValidationError{Field: "name", Reason: "must not be empty"}
+	}
+	switch typ {
+	case UptimeAlertLatency:
+		if comparison != UptimeAlertGreaterThan && comparison != UptimeAlertLessThan {
+			return &ValidationError{Field: "comparison", Value: string(comparison), Reason: "must be greater_than or less_than for latency alerts"}
+		}
+	case UptimeAlertDown, UptimeAlertDownGlobal, UptimeAlertSSLExpiry:
+	default:
+		return &ValidationError{Field: "type", Value: typ, Reason: "must be latency, down, down_global or ssl_expiry"}
+	}
+	return nil
+}
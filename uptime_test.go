@@ -0,0 +1,228 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestUptimeChecks_List(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/uptime/checks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"checks":[{"id":"5a4981aa","name":"Landing page","type":"https","target":"https://www.landingpage.com","regions":["us_east","eu_west"],"enabled":true}]}`)
+	})
+
+	checks, _, err := c.UptimeChecks.List(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("UptimeChecks.List returned error: %v", err)
+	}
+	if len(checks) != 1 || checks[0].Type != UptimeCheckHTTPS || len(checks[0].Regions) != 2 || !checks[0].Enabled {
+		t.Errorf("got checks %+v", checks)
+	}
+}
+
+func TestUptimeChecks_CreateUpdateGetDelete(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+
+	mux.HandleFunc("/v2/uptime/checks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var req CreateUptimeCheckRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if req.Name != "Landing page" || req.Type != UptimeCheckHTTPS || req.Target != "https://www.landingpage.com" {
+			t.Errorf("got request %+v", req)
+		}
+		fmt.Fprint(w, `{"check":{"id":"5a4981aa","name":"Landing page"}}`)
+	})
+
+	var methods []string
+	mux.HandleFunc("/v2/uptime/checks/5a4981aa", func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		switch r.Method {
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodPut:
+			var req UpdateUptimeCheckRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("decoding request body: %v", err)
+			}
+			if req.Type != UptimeCheckPing || req.Enabled {
+				t.Errorf("got request %+v", req)
+			}
+			fmt.Fprint(w, `{"check":{"id":"5a4981aa","type":"ping"}}`)
+		default:
+			fmt.Fprint(w, `{"check":{"id":"5a4981aa","type":"ping"}}`)
+		}
+	})
+
+	check, _, err := c.UptimeChecks.Create(ctx, &CreateUptimeCheckRequest{Name: "Landing page", Type: UptimeCheckHTTPS, Target: "https://www.landingpage.com", Enabled: true})
+	if err != nil {
+		t.Fatalf("UptimeChecks.Create returned error: %v", err)
+	}
+	if check.ID != "5a4981aa" {
+		t.Errorf("got check %+v", check)
+	}
+	if _, _, err := c.UptimeChecks.Update(ctx, "5a4981aa", &UpdateUptimeCheckRequest{Name: "Landing page", Type: UptimeCheckPing, Target: "www.landingpage.com"}); err != nil {
+		t.Fatalf("UptimeChecks.Update returned error: %v", err)
+	}
+	if _, _, err := c.UptimeChecks.Get(ctx, "5a4981aa"); err != nil {
+		t.Fatalf("UptimeChecks.Get returned error: %v", err)
+	}
+	if _, err := c.UptimeChecks.Delete(ctx, "5a4981aa"); err != nil {
+		t.Fatalf("UptimeChecks.Delete returned error: %v", err)
+	}
+
+	if fmt.Sprint(methods) != "[PUT GET DELETE]" {
+		t.Errorf("got methods %v", methods)
+	}
+}
+
+func TestUptimeChecks_GetState(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/uptime/checks/5a4981aa/state", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"state":{"regions":{"us_east":{"status":"UP","status_changed_at":"2022-03-17T22:28:51Z","thirty_day_uptime_percentage":97.99}},"previous_outage":{"region":"us_east","duration_seconds":120}}}`)
+	})
+
+	state, _, err := c.UptimeChecks.GetState(context.Background(), "5a4981aa")
+	if err != nil {
+		t.Fatalf("UptimeChecks.GetState returned error: %v", err)
+	}
+	if region, ok := state.Regions["us_east"]; !ok || region.Status != "UP" || region.ThirtyDayUptimePercentage != 97.99 {
+		t.Errorf("got regions %+v", state.Regions)
+	}
+	if state.PreviousOutage.DurationSeconds != 120 {
+		t.Errorf("got previous outage %+v", state.PreviousOutage)
+	}
+}
+
+func TestUptimeChecks_alerts(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+
+	mux.HandleFunc("/v2/uptime/checks/5a4981aa/alerts", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `{"alerts":[{"id":"17f0f0ae","name":"Landing page degraded performance","type":"latency","threshold":300,"comparison":"greater_than","period":"2m","notifications":{"email":["bob@example.com"]}}]}`)
+			return
+		}
+		testMethod(t, r, http.MethodPost)
+		var req CreateUptimeAlertRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if req.Type != UptimeAlertLatency || req.Threshold != 300 || req.Comparison != UptimeAlertGreaterThan {
+			t.Errorf("got request %+v", req)
+		}
+		fmt.Fprint(w, `{"alert":{"id":"17f0f0ae"}}`)
+	})
+
+	var methods []string
+	mux.HandleFunc("/v2/uptime/checks/5a4981aa/alerts/17f0f0ae", func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		switch r.Method {
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodPut:
+			var req map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("decoding request body: %v", err)
+			}
+			if _, ok := req["comparison"]; ok || req["type"] != UptimeAlertDown {
+				t.Errorf("expected a down alert without comparison, got %v", req)
+			}
+			fmt.Fprint(w, `{"alert":{"id":"17f0f0ae","type":"down"}}`)
+		default:
+			fmt.Fprint(w, `{"alert":{"id":"17f0f0ae","type":"down"}}`)
+		}
+	})
+
+	alerts, _, err := c.UptimeChecks.ListAlerts(ctx, "5a4981aa", nil)
+	if err != nil {
+		t.Fatalf("UptimeChecks.ListAlerts returned error: %v", err)
+	}
+	if len(alerts) != 1 || alerts[0].Notifications == nil || len(alerts[0].Notifications.Email) != 1 {
+		t.Errorf("got alerts %+v", alerts)
+	}
+
+	notifications := &Notifications{Email: []string{"bob@example.com"}}
+	_, _, err = c.UptimeChecks.CreateAlert(ctx, "5a4981aa", &CreateUptimeAlertRequest{
+		Name:          "Landing page degraded performance",
+		Type:          UptimeAlertLatency,
+		Threshold:     300,
+		Comparison:    UptimeAlertGreaterThan,
+		Notifications: notifications,
+		Period:        "2m",
+	})
+	if err != nil {
+		t.Fatalf("UptimeChecks.CreateAlert returned error: %v", err)
+	}
+	alert, _, err := c.UptimeChecks.UpdateAlert(ctx, "5a4981aa", "17f0f0ae", &UpdateUptimeAlertRequest{Name: "Landing page down", Type: UptimeAlertDown, Notifications: notifications, Period: "2m"})
+	if err != nil {
+		t.Fatalf("UptimeChecks.UpdateAlert returned error: %v", err)
+	}
+	if alert.Type != UptimeAlertDown {
+		t.Errorf("got alert %+v", alert)
+	}
+	if _, _, err := c.UptimeChecks.GetAlert(ctx, "5a4981aa", "17f0f0ae"); err != nil {
+		t.Fatalf("UptimeChecks.GetAlert returned error: %v", err)
+	}
+	if _, err := c.UptimeChecks.DeleteAlert(ctx, "5a4981aa", "17f0f0ae"); err != nil {
+		t.Fatalf("UptimeChecks.DeleteAlert returned error: %v", err)
+	}
+
+	if fmt.Sprint(methods) != "[PUT GET DELETE]" {
+		t.Errorf("got methods %v", methods)
+	}
+}
+
+func TestUptimeChecks_validation(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+	s := c.UptimeChecks
+
+	calls := map[string]func() error{
+		"get empty id":    func() error { _, _, err := s.Get(ctx, ""); return err },
+		"state empty id":  func() error { _, _, err := s.GetState(ctx, ""); return err },
+		"delete empty id": func() error { _, err := s.Delete(ctx, ""); return err },
+		"create nil":      func() error { _, _, err := s.Create(ctx, nil); return err },
+		"create no name": func() error {
+			_, _, err := s.Create(ctx, &CreateUptimeCheckRequest{Type: UptimeCheckPing, Target: "example.com"})
+			return err
+		},
+		"create unknown type": func() error {
+			_, _, err := s.Create(ctx, &CreateUptimeCheckRequest{Name: "check", Type: "tcp", Target: "example.com"})
+			return err
+		},
+		"create no target": func() error {
+			_, _, err := s.Create(ctx, &CreateUptimeCheckRequest{Name: "check", Type: UptimeCheckPing})
+			return err
+		},
+		"update nil":       func() error { _, _, err := s.Update(ctx, "5a4981aa", nil); return err },
+		"alerts empty id":  func() error { _, _, err := s.ListAlerts(ctx, "", nil); return err },
+		"get empty alert":  func() error { _, _, err := s.GetAlert(ctx, "5a4981aa", ""); return err },
+		"create alert nil": func() error { _, _, err := s.CreateAlert(ctx, "5a4981aa", nil); return err },
+		"create latency no comparison": func() error {
+			_, _, err := s.CreateAlert(ctx, "5a4981aa", &CreateUptimeAlertRequest{Name: "slow", Type: UptimeAlertLatency, Threshold: 300})
+			return err
+		},
+		"update unknown alert type": func() error {
+			_, _, err := s.UpdateAlert(ctx, "5a4981aa", "17f0f0ae", &UpdateUptimeAlertRequest{Name: "slow", Type: "timeout"})
+			return err
+		},
+		"delete empty alert": func() error { _, err := s.DeleteAlert(ctx, "5a4981aa", ""); return err },
+	}
+	for name, call := range calls {
+		var verr *ValidationError
+		if err := call(); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+// Package util provides helpers for common tasks built on the API client.
+package util
+
+import (
+	"context"
+
+	"client"
+)
+
+// WaitForActionCompleted blocks until the action completed or errored,
+// polling it as configured by opts, and returns its final state. An errored
+// action is reported as a *client.ActionError, exceeding opts.Timeout as a
+// *client.WaitTimeoutError.
+func WaitForActionCompleted(ctx context.Context, c *client.Client, actionID int, opts client.WaitOptions) (*client.Action, error) {
+	return client.Poll(ctx, opts, func(ctx context.Context) (*client.Action, error) {
+		action, _, err := c.Actions.Get(ctx, actionID)
+		return action, err
+	}, func(action *client.Action) (bool, string, error) {
+		switch action.Status {
+		case client.ActionCompleted:
+			return true, action.Status, nil
+		case client.ActionErrored:
+			return false, action.Status, &client.ActionError{Action: action}
+		}
+		return false, action.Status, nil
+	})
+}
@@ -0,0 +1,88 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"client"
+)
+
+// setup returns a client sending its requests to a test server serving mux.
+func setup(t *testing.T) (*client.Client, *http.ServeMux) {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	c, err := client.New(nil)
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+	c.BaseURL, _ = url.Parse(server.URL + "/")
+	return c, mux
+}
+
+func TestWaitForActionCompleted(t *testing.T) {
+	c, mux := setup(t)
+
+	polls := 0
+	mux.HandleFunc("/v2/actions/12", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET, got %s", r.Method)
+		}
+		polls++
+		status := client.ActionInProgress
+		if polls == 3 {
+			status = client.ActionCompleted
+		}
+		fmt.Fprintf(w, `{"action":{"id":12,"status":%q}}`, status)
+	})
+
+	action, err := WaitForActionCompleted(context.Background(), c, 12, client.WaitOptions{PollInterval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("WaitForActionCompleted returned error: %v", err)
+	}
+	if action.Status != client.ActionCompleted || polls != 3 {
+		t.Errorf("got action %+v after %d polls", action, polls)
+	}
+}
+
+func TestWaitForActionCompleted_errored(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/actions/12", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"action":{"id":12,"status":"errored"}}`)
+	})
+
+	var aerr *client.ActionError
+	if _, err := WaitForActionCompleted(context.Background(), c, 12, client.WaitOptions{PollInterval: time.Millisecond}); !errors.As(err, &aerr) {
+		t.Fatalf("expected *client.ActionError, got %v", err)
+	}
+	if aerr.Action.ID != 12 {
+		t.Errorf("got action %+v", aerr.Action)
+	}
+}
+
+func TestWaitForActionCompleted_timeout(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/actions/12", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"action":{"id":12,"status":"in-progress"}}`)
+	})
+
+	var terr *client.WaitTimeoutError
+	_, err := WaitForActionCompleted(context.Background(), c, 12, client.WaitOptions{PollInterval: time.Millisecond, Timeout: 20 * time.Millisecond})
+	if !errors.As(err, &terr) {
+		t.Fatalf("expected *client.WaitTimeoutError, got %v", err)
+	}
+	if terr.LastStatus != client.ActionInProgress {
+		t.Errorf("got last status %q", terr.LastStatus)
+	}
+}
@@ -0,0 +1,26 @@
+package util
+
+import (
+	"context"
+
+	"client"
+)
+
+// CreateKubernetesCluster creates a Kubernetes cluster, waits until it is
+// running and returns it with its kubeconfig, which can be loaded with
+// clientcmd.RESTConfigFromKubeConfig. If fetching the kubeconfig fails, the
+// running cluster is returned with the error so it can be retried with
+// client.Kubernetes.GetKubeConfig.
+func CreateKubernetesCluster(ctx context.Context, c *client.Client, createRequest *client.KubernetesClusterCreateRequest, opts client.WaitOptions) (*client.KubernetesCluster, []byte, error) {
+	cluster, _, err := c.Kubernetes.CreateAndWait(ctx, createRequest, opts)
+	if err != nil {
+		return cluster, nil, err
+	}
+
+	config, _, err := c.Kubernetes.GetKubeConfig(ctx, cluster.ID)
+	if err != nil {
+		return cluster, nil, err
+	}
+
+	return cluster, config.KubeconfigYAML, nil
+}
@@ -0,0 +1,55 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"client"
+)
+
+func TestCreateKubernetesCluster(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/kubernetes/clusters", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"kubernetes_cluster":{"id":"bd5f5959","status":{"state":"provisioning"}}}`)
+	})
+	mux.HandleFunc("/v2/kubernetes/clusters/bd5f5959", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"kubernetes_cluster":{"id":"bd5f5959","status":{"state":"running"}}}`)
+	})
+	kubeconfig := "apiVersion: v1\nkind: Config\n"
+	healthy := false
+	mux.HandleFunc("/v2/kubernetes/clusters/bd5f5959/kubeconfig", func(w http.ResponseWriter, r *http.Request) {
+		if !healthy {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"id":"not_found","message":"kubeconfig not ready"}`)
+			return
+		}
+		fmt.Fprint(w, kubeconfig)
+	})
+
+	createRequest := &client.KubernetesClusterCreateRequest{
+		Name:        "prod",
+		RegionSlug:  "nyc1",
+		VersionSlug: "1.29.1-do.0",
+		NodePools:   []*client.KubernetesNodePoolCreateRequest{{Name: "workers", Size: "s-1vcpu-2gb", Count: 3}},
+	}
+	opts := client.WaitOptions{PollInterval: time.Millisecond}
+
+	// a failed kubeconfig fetch still returns the running cluster
+	cluster, config, err := CreateKubernetesCluster(context.Background(), c, createRequest, opts)
+	if err == nil || cluster == nil || cluster.ID != "bd5f5959" || config != nil {
+		t.Errorf("expected the running cluster with the error, got %+v, %q, %v", cluster, config, err)
+	}
+
+	healthy = true
+	cluster, config, err = CreateKubernetesCluster(context.Background(), c, createRequest, opts)
+	if err != nil {
+		t.Fatalf("CreateKubernetesCluster returned error: %v", err)
+	}
+	if cluster.Status.State != client.KubernetesClusterStatusRunning || string(config) != kubeconfig {
+		t.Errorf("got cluster %+v and kubeconfig %q", cluster, config)
+	}
+}
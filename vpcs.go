@@ -0,0 +1,218 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const vpcsBasePath = "v2/vpcs"
+
+// VPC represents a DigitalOcean Virtual Private Cloud configuration.
+type VPC struct {
+	ID          string    `json:"id,omitempty"`
+	URN         string    `json:"urn"`
+	Name        string    `json:"name,omitempty"`
+	Description string    `json:"description,omitempty"`
+	IPRange     string    `json:"ip_range,omitempty"`
+	RegionSlug  string    `json:"region,omitempty"`
+	CreatedAt   time.Time `json:"created_at,omitempty"`
+
+	// Default reports whether droplets created in the region without a
+	// VPC are placed in this VPC. Every region has one default VPC.
+	Default bool `json:"default,omitempty"`
+}
+
+// VPCCreateRequest represents a request to create a Virtual Private Cloud.
+type VPCCreateRequest struct {
+	Name        string `json:"name,omitempty"`
+	RegionSlug  string `json:"region,omitempty"`
+	Description string `json:"description,omitempty"`
+	IPRange     string `json:"ip_range,omitempty"`
+}
+
+// VPCUpdateRequest represents a request to update a Virtual Private Cloud.
+type VPCUpdateRequest struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+
+	// Default makes the VPC the default of its region when true, replacing
+	// the previous default. The default can only be changed by making
+	// another VPC the default, so false is rejected.
+	Default *bool `json:"default,omitempty"`
+}
+
+// VPCMember represents a resource placed in a VPC.
+type VPCMember struct {
+	URN       string    `json:"urn,omitempty"`
+	Name      string    `json:"name,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// vpcMemberListOptions adds the resource type filter to ListOptions.
+type vpcMemberListOptions struct {
+	ListOptions
+	ResourceType ResourceType `url:"resource_type,omitempty"`
+}
+
+/* SERVICE */
+
+// VPCsService is an interface for managing Virtual Private Cloud configurations with the
+// DigitalOcean API.
+type VPCsService interface {
+	List(context.Context, *ListOptions) ([]VPC, *Response, error)
+	ListAll(context.Context, *ListAllOptions) ([]VPC, *Response, error)
+	Get(context.Context, string) (*VPC, *Response, error)
+	Create(context.Context, *VPCCreateRequest) (*VPC, *Response, error)
+	Update(context.Context, string, *VPCUpdateRequest) (*VPC, *Response, error)
+	Delete(context.Context, string) (*Response, error)
+	ListMembers(context.Context, string, ResourceType, *ListOptions) ([]VPCMember, *Response, error)
+}
+
+// VPCsServiceOp interfaces with VPC endpoints in the DigitalOcean API.
+type VPCsServiceOp struct {
+	client *Client
+}
+
+var _ VPCsService = &VPCsServiceOp{}
+
+// List returns a list of the caller's VPCs, with optional pagination.
+func (s *VPCsServiceOp) List(ctx context.Context, opt *ListOptions) ([]VPC, *Response, error) {
+	path, err := addOptions(vpcsBasePath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vpcs, resp, err := DoEnvelope[[]VPC](ctx, s.client, req, "vpcs")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *vpcs, resp, err
+}
+
+// ListAll VPCs across all pages
+func (s *VPCsServiceOp) ListAll(ctx context.Context, opt *ListAllOptions) ([]VPC, *Response, error) {
+	return ListAll[VPC](ctx, s.List, opt)
+}
+
+// Get returns the details of a Virtual Private Cloud.
+func (s *VPCsServiceOp) Get(ctx context.Context, id string) (*VPC, *Response, error) {
+	if id == "" {
+		return nil, nil, &ValidationError{Field: "id", Reason: "must not be empty"}
+	}
+
+	path := fmt.Sprintf("%s/%s", vpcsBasePath, id)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doVPC(ctx, req)
+}
+
+// Create creates a new Virtual Private Cloud.
+func (s *VPCsServiceOp) Create(ctx context.Context, createRequest *VPCCreateRequest) (*VPC, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, &ValidationError{Field: "createRequest", Reason: "cannot be nil"}
+	}
+	if createRequest.Name == "" {
+		return nil, nil, &ValidationError{Field: "name", Reason: "must not be empty"}
+	}
+	if createRequest.RegionSlug == "" {
+		return nil, nil, &ValidationError{Field: "region", Reason: "must not be empty"}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, vpcsBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doVPC(ctx, req)
+}
+
+// Update updates a Virtual Private Cloud's properties.
+func (s *VPCsServiceOp) Update(ctx context.Context, id string, updateRequest *VPCUpdateRequest) (*VPC, *Response, error) {
+	if id == "" {
+		return nil, nil, &ValidationError{Field: "id", Reason: "must not be empty"}
+	}
+	if updateRequest == nil {
+		return nil, nil, &ValidationError{Field: "updateRequest", Reason: "cannot be nil"}
+	}
+	if updateRequest.Default != nil && !*updateRequest.Default {
+		return nil, nil, &ValidationError{Field: "default", Reason: "can only be set to true, make another VPC the default instead"}
+	}
+
+	path := fmt.Sprintf("%s/%s", vpcsBasePath, id)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, updateRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doVPC(ctx, req)
+}
+
+// Delete deletes a Virtual Private Cloud. The default VPC of a region and
+// VPCs which still have members can not be deleted.
+func (s *VPCsServiceOp) Delete(ctx context.Context, id string) (*Response, error) {
+	if id == "" {
+		return nil, &ValidationError{Field: "id", Reason: "must not be empty"}
+	}
+
+	path := fmt.Sprintf("%s/%s", vpcsBasePath, id)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListMembers lists the resources placed in a Virtual Private Cloud. When
+// resourceType is not empty only members of that type are listed.
+func (s *VPCsServiceOp) ListMembers(ctx context.Context, id string, resourceType ResourceType, opt *ListOptions) ([]VPCMember, *Response, error) {
+	if id == "" {
+		return nil, nil, &ValidationError{Field: "id", Reason: "must not be empty"}
+	}
+
+	o := vpcMemberListOptions{ResourceType: resourceType}
+	if opt != nil {
+		o.ListOptions = *opt
+	}
+
+	path := fmt.Sprintf("%s/%s/members", vpcsBasePath, id)
+	path, err := addOptions(path, &o)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	members, resp, err := DoEnvelope[[]VPCMember](ctx, s.client, req, "members")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *members, resp, err
+}
+
+func (s *VPCsServiceOp) doVPC(ctx context.Context, req *http.Request) (*VPC, *Response, error) {
+	vpc, resp, err := DoEnvelope[VPC](ctx, s.client, req, "vpc")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return vpc, resp, err
+}
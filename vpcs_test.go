@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestVPCs_List(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/vpcs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"vpcs":[{"id":"5a4981aa","urn":"do:vpc:5a4981aa","region":"nyc3","ip_range":"10.10.10.0/24","default":true}]}`)
+	})
+
+	vpcs, _, err := c.VPCs.List(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("VPCs.List returned error: %v", err)
+	}
+	if len(vpcs) != 1 || vpcs[0].RegionSlug != "nyc3" || vpcs[0].IPRange != "10.10.10.0/24" || !vpcs[0].Default {
+		t.Errorf("got VPCs %+v", vpcs)
+	}
+}
+
+func TestVPCs_CreateUpdateGetDelete(t *testing.T) {
+	c, mux := setup(t)
+	ctx := context.Background()
+
+	mux.HandleFunc("/v2/vpcs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var req VPCCreateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if req.Name != "env.prod" || req.RegionSlug != "nyc3" {
+			t.Errorf("got request %+v", req)
+		}
+		fmt.Fprint(w, `{"vpc":{"id":"5a4981aa","name":"env.prod"}}`)
+	})
+
+	var methods []string
+	mux.HandleFunc("/v2/vpcs/5a4981aa", func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		switch r.Method {
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodPut:
+			var req map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("decoding request body: %v", err)
+			}
+			if req["default"] != true || req["name"] != "env.staging" {
+				t.Errorf("got request %v", req)
+			}
+			fmt.Fprint(w, `{"vpc":{"id":"5a4981aa","name":"env.staging","default":true}}`)
+		default:
+			fmt.Fprint(w, `{"vpc":{"id":"5a4981aa","name":"env.staging"}}`)
+		}
+	})
+
+	if _, _, err := c.VPCs.Create(ctx, &VPCCreateRequest{Name: "env.prod", RegionSlug: "nyc3"}); err != nil {
+		t.Fatalf("VPCs.Create returned error: %v", err)
+	}
+	isDefault := true
+	vpc, _, err := c.VPCs.Update(ctx, "5a4981aa", &VPCUpdateRequest{Name: "env.staging", Default: &isDefault})
+	if err != nil {
+		t.Fatalf("VPCs.Update returned error: %v", err)
+	}
+	if !vpc.Default {
+		t.Errorf("got VPC %+v", vpc)
+	}
+	if _, _, err := c.VPCs.Get(ctx, "5a4981aa"); err != nil {
+		t.Fatalf("VPCs.Get returned error: %v", err)
+	}
+	if _, err := c.VPCs.Delete(ctx, "5a4981aa"); err != nil {
+		t.Fatalf("VPCs.Delete returned error: %v", err)
+	}
+
+	if fmt.Sprint(methods) != "[PUT GET DELETE]" {
+		t.Errorf("got methods %v", methods)
+	}
+}
+
+func TestVPCs_ListMembers(t *testing.T) {
+	c, mux := setup(t)
+
+	mux.HandleFunc("/v2/vpcs/5a4981aa/members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("resource_type") != "droplet" {
+			t.Errorf("expected members filtered by resource type, got %q", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"members":[{"urn":"do:droplet:1","name":"web-01"}]}`)
+	})
+
+	members, _, err := c.VPCs.ListMembers(context.Background(), "5a4981aa", DropletResourceType, nil)
+	if err != nil {
+		t.Fatalf("VPCs.ListMembers returned error: %v", err)
+	}
+	if len(members) != 1 || members[0].URN != "do:droplet:1" {
+		t.Errorf("got members %+v", members)
+	}
+}
+
+func TestVPCs_validation(t *testing.T) {
+	c, _ := setup(t)
+	ctx := context.Background()
+	s := c.VPCs
+	notDefault := false
+
+	calls := map[string]func() error{
+		"get empty id":     func() error { _, _, err := s.Get(ctx, ""); return err },
+		"create nil":       func() error { _, _, err := s.Create(ctx, nil); return err },
+		"create no name":   func() error { _, _, err := s.Create(ctx, &VPCCreateRequest{RegionSlug: "nyc3"}); return err },
+		"create no region": func() error { _, _, err := s.Create(ctx, &VPCCreateRequest{Name: "env.prod"}); return err },
+		"update empty id":  func() error { _, _, err := s.Update(ctx, "", &VPCUpdateRequest{}); return err },
+		"update nil":       func() error { _, _, err := s.Update(ctx, "5a4981aa", nil); return err },
+		"update no default": func() error {
+			_, _, err := s.Update(ctx, "5a4981aa", &VPCUpdateRequest{Default: &notDefault})
+			return err
+		},
+		"delete empty id":  func() error { _, err := s.Delete(ctx, ""); return err },
+		"members empty id": func() error { _, _, err := s.ListMembers(ctx, "", "", nil); return err },
+	}
+	for name, call := range calls {
+		var verr *ValidationError
+		if err := call(); !errors.As(err, &verr) {
+			t.Errorf("%s: expected a *ValidationError, got %v", name, err)
+		}
+	}
+}
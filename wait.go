@@ -0,0 +1,148 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DefaultPollInterval is how often waits poll the API when
+// WaitOptions.PollInterval is not set.
+const DefaultPollInterval = 5 * time.Second
+
+// WaitOptions configures how a wait polls the API. The zero value polls every
+// DefaultPollInterval until the context is done.
+type WaitOptions struct {
+	// PollInterval is the time between the first polls.
+	PollInterval time.Duration
+
+	// Backoff multiplies the time between polls after each poll. Values up to
+	// 1 keep it constant.
+	Backoff float64
+
+	// MaxPollInterval caps the time between polls grown by Backoff, if set.
+	MaxPollInterval time.Duration
+
+	// Timeout bounds the wait, if set. Exceeding it is reported as a
+	// *WaitTimeoutError.
+	Timeout time.Duration
+}
+
+// Delay returns the time to wait before the given zero based poll.
+func (o WaitOptions) Delay(attempt int) time.Duration {
+	d := o.PollInterval
+	if d <= 0 {
+		d = DefaultPollInterval
+	}
+	if o.Backoff <= 1 {
+		return d
+	}
+
+	for i := 0; i < attempt; i++ {
+		d = time.Duration(float64(d) * o.Backoff)
+		if o.MaxPollInterval > 0 && d >= o.MaxPollInterval {
+			return o.MaxPollInterval
+		}
+	}
+	return d
+}
+
+// WaitTimeoutError occurs when a wait does not finish within
+// WaitOptions.Timeout. It matches context.DeadlineExceeded with errors.Is.
+type WaitTimeoutError struct {
+	Timeout time.Duration
+
+	// LastStatus is the status of the resource when it was last polled.
+	LastStatus string
+}
+
+func (e *WaitTimeoutError) Error() string {
+	return fmt.Sprintf("wait timed out after %s, last status %q", e.Timeout, e.LastStatus)
+}
+
+func (e *WaitTimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// withDefaultPollInterval returns o polling every d unless its PollInterval
+// is set.
+func (o WaitOptions) withDefaultPollInterval(d time.Duration) WaitOptions {
+	if o.PollInterval <= 0 {
+		o.PollInterval = d
+	}
+	return o
+}
+
+// withDefaultTimeout returns o bounded by d unless its Timeout is set.
+func (o WaitOptions) withDefaultTimeout(d time.Duration) WaitOptions {
+	if o.Timeout <= 0 {
+		o.Timeout = d
+	}
+	return o
+}
+
+// context returns the context to wait with, bounded by the timeout if set.
+func (o WaitOptions) context(parent context.Context) (context.Context, context.CancelFunc) {
+	if o.Timeout <= 0 {
+		return context.WithCancel(parent)
+	}
+	return context.WithTimeout(parent, o.Timeout)
+}
+
+// timeoutError returns a *WaitTimeoutError in place of err if the wait was
+// stopped by its timeout rather than by the parent context.
+func (o WaitOptions) timeoutError(parent context.Context, err error, lastStatus string) error {
+	if o.Timeout > 0 && parent.Err() == nil && errors.Is(err, context.DeadlineExceeded) {
+		return &WaitTimeoutError{Timeout: o.Timeout, LastStatus: lastStatus}
+	}
+	return err
+}
+
+// PollCondition inspects a polled value. It reports whether the value is
+// final, and its status, e.g. "new", for a *WaitTimeoutError. A non-nil error
+// ends the poll.
+type PollCondition[T any] func(T) (done bool, status string, err error)
+
+// Poll calls get until cond reports the value it returned is final, waiting
+// between the calls as configured by opts, and returns the final value. It
+// can wait on arbitrary conditions, e.g. a DNS record being served. On an
+// error it returns the last value get returned, and exceeding opts.Timeout is
+// reported as a *WaitTimeoutError.
+func Poll[T any](ctx context.Context, opts WaitOptions, get func(context.Context) (T, error), cond PollCondition[T]) (T, error) {
+	var zero T
+	return poll(ctx, opts, zero, false, get, cond)
+}
+
+// pollFrom is Poll for a value which was already fetched, e.g. by a create
+// request, checking it before calling get.
+func pollFrom[T any](ctx context.Context, opts WaitOptions, v T, get func(context.Context) (T, error), cond PollCondition[T]) (T, error) {
+	return poll(ctx, opts, v, true, get, cond)
+}
+
+func poll[T any](ctx context.Context, opts WaitOptions, v T, fetched bool, get func(context.Context) (T, error), cond PollCondition[T]) (T, error) {
+	waitCtx, cancel := opts.context(ctx)
+	defer cancel()
+
+	var status string
+	for attempt := 0; ; {
+		if fetched {
+			done, s, err := cond(v)
+			if err != nil || done {
+				return v, err
+			}
+			status = s
+
+			if err := sleep(waitCtx, opts.Delay(attempt)); err != nil {
+				return v, opts.timeoutError(ctx, err, status)
+			}
+			attempt++
+		}
+
+		latest, err := get(waitCtx)
+		if err != nil {
+			return v, opts.timeoutError(ctx, err, status)
+		}
+		v, fetched = latest, true
+	}
+}
@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWaitOptions_Delay(t *testing.T) {
+	tests := []struct {
+		opts    WaitOptions
+		attempt int
+		want    time.Duration
+	}{
+		{WaitOptions{}, 3, DefaultPollInterval},
+		{WaitOptions{PollInterval: time.Second}, 3, time.Second},
+		{WaitOptions{PollInterval: time.Second, Backoff: 2}, 0, time.Second},
+		{WaitOptions{PollInterval: time.Second, Backoff: 2}, 3, 8 * time.Second},
+		{WaitOptions{PollInterval: time.Second, Backoff: 2, MaxPollInterval: 5 * time.Second}, 3, 5 * time.Second},
+	}
+	for _, tt := range tests {
+		if got := tt.opts.Delay(tt.attempt); got != tt.want {
+			t.Errorf("%+v.Delay(%d) = %v, want %v", tt.opts, tt.attempt, got, tt.want)
+		}
+	}
+}
+
+func TestWaitOptions_withDefaultTimeout(t *testing.T) {
+	if got := (WaitOptions{}).withDefaultTimeout(time.Minute).Timeout; got != time.Minute {
+		t.Errorf("expected the default timeout, got %v", got)
+	}
+	if got := (WaitOptions{Timeout: time.Second}).withDefaultTimeout(time.Minute).Timeout; got != time.Second {
+		t.Errorf("expected the given timeout kept, got %v", got)
+	}
+}
+
+func TestPoll(t *testing.T) {
+	calls := 0
+	v, err := Poll(context.Background(), WaitOptions{PollInterval: time.Millisecond}, func(ctx context.Context) (int, error) {
+		calls++
+		return calls, nil
+	}, func(v int) (bool, string, error) {
+		return v == 3, "", nil
+	})
+	if err != nil || v != 3 {
+		t.Errorf("got %d, %v", v, err)
+	}
+}
+
+func TestPoll_conditionError(t *testing.T) {
+	failed := errors.New("failed")
+	v, err := Poll(context.Background(), WaitOptions{PollInterval: time.Millisecond}, func(ctx context.Context) (string, error) {
+		return "errored", nil
+	}, func(v string) (bool, string, error) {
+		return false, v, failed
+	})
+	if err != failed || v != "errored" {
+		t.Errorf("got %q, %v", v, err)
+	}
+}
+
+func TestPoll_timeout(t *testing.T) {
+	v, err := Poll(context.Background(), WaitOptions{PollInterval: time.Millisecond, Timeout: 20 * time.Millisecond}, func(ctx context.Context) (string, error) {
+		return "pending", nil
+	}, func(v string) (bool, string, error) {
+		return false, v, nil
+	})
+
+	var terr *WaitTimeoutError
+	if !errors.As(err, &terr) {
+		t.Fatalf("expected *WaitTimeoutError, got %v", err)
+	}
+	if terr.LastStatus != "pending" || v != "pending" {
+		t.Errorf("got last status %q and value %q", terr.LastStatus, v)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Error("expected the error to match context.DeadlineExceeded")
+	}
+}
+
+func TestPoll_parentCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := Poll(ctx, WaitOptions{PollInterval: time.Millisecond, Timeout: time.Minute}, func(ctx context.Context) (string, error) {
+		return "pending", ctx.Err()
+	}, func(v string) (bool, string, error) {
+		return false, v, nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}